@@ -0,0 +1,121 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+// Package api implements the operations of the Arduino CLI (core and
+// library management, board discovery, compile and upload) as plain Go
+// functions. Every function takes a request struct, returns a result struct
+// and an error and never prints or exits: progress is reported through
+// callbacks. The cobra commands and the gRPC daemon are thin wrappers over
+// this package, that can be imported as a library by other Go programs.
+package api
+
+import (
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"go.bug.st/downloader"
+)
+
+// DownloadProgress reports the progress of a download. The first message
+// of a download has File, URL and TotalSize set, the following ones only
+// Downloaded, the last one has Completed set. A download of a resource
+// already available in the local cache is reported with a single message
+// with File and Completed set.
+type DownloadProgress struct {
+	URL        string
+	File       string
+	TotalSize  int64
+	Downloaded int64
+	Completed  bool
+}
+
+// DownloadProgressCB is called to report the progress of a download.
+type DownloadProgressCB func(progress *DownloadProgress)
+
+// TaskProgress reports the progress of a task: Name is set when a task
+// starts, Message carries an informative message and Completed is set
+// when the task ends.
+type TaskProgress struct {
+	Name      string
+	Message   string
+	Completed bool
+}
+
+// TaskProgressCB is called to report the progress of a task.
+type TaskProgressCB func(progress *TaskProgress)
+
+// download runs the downloader d reporting the progress to downloadCB.
+// A nil downloader means that the resource is already available in the
+// local cache.
+func download(d *downloader.Downloader, label string, downloadCB DownloadProgressCB) error {
+	if d == nil {
+		downloadCB(&DownloadProgress{File: label, Completed: true})
+		return nil
+	}
+	downloadCB(&DownloadProgress{
+		File:      label,
+		URL:       d.URL,
+		TotalSize: d.Size(),
+	})
+	err := d.RunAndPoll(func(downloaded int64) {
+		downloadCB(&DownloadProgress{Downloaded: downloaded})
+	}, 250*time.Millisecond)
+	if err != nil {
+		return err
+	}
+	downloadCB(&DownloadProgress{Completed: true})
+	return nil
+}
+
+// downloadItem is an archive to be downloaded by downloadAll, label names
+// it in the progress reports.
+type downloadItem struct {
+	label    string
+	resource *resources.DownloadResource
+}
+
+// downloadAll downloads the archives in parallel using the scheduler s. The
+// archives already available in the local cache are reported one by one,
+// the progress of the others is reported as a single download.
+func downloadAll(s *resources.DownloadScheduler, items []*downloadItem, downloadCB DownloadProgressCB) error {
+	labels := []string{}
+	for _, item := range items {
+		if cached, err := item.resource.TestLocalArchiveIntegrity(s.DownloadDir); err == nil && cached {
+			downloadCB(&DownloadProgress{File: item.label, Completed: true})
+			continue
+		}
+		if err := s.Add(item.resource); err != nil {
+			return &FailedPreconditionError{Message: "downloading " + item.label, Cause: err}
+		}
+		labels = append(labels, item.label)
+	}
+	if s.Len() == 0 {
+		return nil
+	}
+
+	label := strings.Join(labels, ", ")
+	downloadCB(&DownloadProgress{File: label, TotalSize: s.TotalSize()})
+	err := s.RunAndPoll(func(downloaded int64) {
+		downloadCB(&DownloadProgress{Downloaded: downloaded})
+	}, 250*time.Millisecond)
+	if err != nil {
+		return &NetworkError{Message: "downloading " + label, Cause: err}
+	}
+	downloadCB(&DownloadProgress{Completed: true})
+	return nil
+}
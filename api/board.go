@@ -0,0 +1,339 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// BoardDetailsReq is the request for BoardDetails.
+type BoardDetailsReq struct {
+	FQBN string
+}
+
+// BoardDetailsResult is the result of BoardDetails.
+type BoardDetailsResult struct {
+	Board         *cores.Board
+	ConfigOptions []*ConfigOption
+	RequiredTools []*cores.ToolDependency
+	// Programmers are the programmers that can be used with the board,
+	// sorted by ID.
+	Programmers []*Programmer
+	// USBIDs are the USB VID/PID pairs identifying the board.
+	USBIDs []*USBID
+	// UploadMethods are the ways the board can be uploaded: "serial",
+	// "network" and "programmer".
+	UploadMethods []string
+	// BuildProperties are the properties of the platform and of the board
+	// with the options selected by the FQBN applied, merged as the builder
+	// does: the platform providing the core, the platform of the board and
+	// then the board.
+	BuildProperties *properties.Map
+}
+
+// Programmer is a programmer defined by a platform.
+type Programmer struct {
+	ID   string
+	Name string
+}
+
+// USBID is a USB VID/PID pair.
+type USBID struct {
+	VID string
+	PID string
+}
+
+// ConfigOption is a menu option of a board, for example the cpu, with the
+// values that it may assume.
+type ConfigOption struct {
+	Option      string
+	OptionLabel string
+	Values      []*ConfigValue
+}
+
+// ConfigValue is a value of a ConfigOption. Selected is set on the value
+// selected by the FQBN, or on the default one if the FQBN doesn't select
+// any.
+type ConfigValue struct {
+	Value      string
+	ValueLabel string
+	Selected   bool
+	// Properties are the board properties set by the value.
+	Properties *properties.Map
+}
+
+// BoardDetails returns the config options, the required tools, the
+// programmers, the USB IDs, the upload methods and the build properties of
+// the board identified by the given FQBN.
+func BoardDetails(pm *packagemanager.PackageManager, req *BoardDetailsReq) (*BoardDetailsResult, error) {
+	fqbn, err := cores.ParseFQBN(req.FQBN)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "parsing fqbn", Cause: err}
+	}
+
+	_, _, board, buildProperties, buildPlatformRelease, err := pm.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &NotFoundError{Message: "loading board data", Cause: err}
+	}
+
+	allProperties := properties.NewMap()
+	if buildPlatformRelease != nil {
+		allProperties.Merge(buildPlatformRelease.Properties)
+	}
+	allProperties.Merge(board.PlatformRelease.Properties)
+	allProperties.Merge(board.PlatformRelease.RuntimeProperties())
+	allProperties.Merge(buildProperties)
+
+	details := &BoardDetailsResult{
+		Board:           board,
+		ConfigOptions:   []*ConfigOption{},
+		RequiredTools:   board.PlatformRelease.Dependencies,
+		Programmers:     boardProgrammers(board, buildPlatformRelease),
+		USBIDs:          boardUSBIDs(board),
+		BuildProperties: allProperties,
+	}
+	details.UploadMethods = boardUploadMethods(pm, board, buildProperties, len(details.Programmers) > 0)
+	options := board.GetConfigOptions()
+	for _, option := range options.Keys() {
+		configOption := &ConfigOption{}
+		configOption.Option = option
+		configOption.OptionLabel = options.Get(option)
+		selected, hasSelected := fqbn.Configs.GetOk(option)
+
+		values := board.GetConfigOptionValues(option)
+		for i, value := range values.Keys() {
+			configValue := &ConfigValue{}
+			if hasSelected && value == selected {
+				configValue.Selected = true
+			} else if !hasSelected && i == 0 {
+				configValue.Selected = true
+			}
+			configValue.Value = value
+			configValue.ValueLabel = values.Get(value)
+			configValue.Properties = board.Properties.SubTree("menu").SubTree(option).SubTree(value)
+			configOption.Values = append(configOption.Values, configValue)
+		}
+
+		details.ConfigOptions = append(details.ConfigOptions, configOption)
+	}
+	return details, nil
+}
+
+// boardProgrammers returns the programmers of the platform of the board and
+// of the platform providing its core, the ones usable by Upload.
+func boardProgrammers(board *cores.Board, buildPlatformRelease *cores.PlatformRelease) []*Programmer {
+	programmers := []*Programmer{}
+	found := map[string]bool{}
+	for _, platformRelease := range []*cores.PlatformRelease{board.PlatformRelease, buildPlatformRelease} {
+		if platformRelease == nil {
+			continue
+		}
+		for id, programmer := range platformRelease.Programmers {
+			if !found[id] {
+				found[id] = true
+				programmers = append(programmers, &Programmer{ID: id, Name: programmer.Get("name")})
+			}
+		}
+	}
+	sort.Slice(programmers, func(i, j int) bool {
+		return programmers[i].ID < programmers[j].ID
+	})
+	return programmers
+}
+
+// boardUSBIDs returns the USB IDs declared with the vid.N/pid.N properties
+// of the board, in the order of N.
+func boardUSBIDs(board *cores.Board) []*USBID {
+	ids := []*USBID{}
+	vids := board.Properties.SubTree("vid")
+	pids := board.Properties.SubTree("pid")
+	for _, n := range vids.Keys() {
+		if pid, ok := pids.GetOk(n); ok {
+			ids = append(ids, &USBID{VID: vids.Get(n), PID: pid})
+		}
+	}
+	return ids
+}
+
+// boardUploadMethods returns the upload methods supported by the board,
+// checking that the upload tools define the recipes used by Upload.
+func boardUploadMethods(pm *packagemanager.PackageManager, board *cores.Board, buildProperties *properties.Map,
+	hasProgrammers bool) []string {
+	hasRecipe := func(toolKey, recipeID string) bool {
+		toolID := buildProperties.Get(toolKey)
+		if toolID == "" {
+			return false
+		}
+		props := properties.NewMap()
+		if split := strings.Split(toolID, ":"); len(split) == 2 {
+			toolID = split[1]
+			if referencedPackage := pm.GetPackages().Packages[split[0]]; referencedPackage != nil {
+				referencedPlatform := referencedPackage.Platforms[board.PlatformRelease.Platform.Architecture]
+				if referencedPlatform != nil {
+					if release := pm.GetInstalledPlatformRelease(referencedPlatform); release != nil {
+						props.Merge(release.Properties)
+					}
+				}
+			}
+		}
+		props.Merge(board.PlatformRelease.Properties)
+		props.Merge(buildProperties)
+		props.Merge(props.SubTree("tools." + toolID))
+		return props.Get(recipeID) != ""
+	}
+
+	methods := []string{}
+	if hasRecipe("upload.tool", "upload.pattern") {
+		methods = append(methods, "serial")
+	}
+	networkToolKey := "upload.tool"
+	if _, ok := buildProperties.GetOk("upload.tool.network"); ok {
+		networkToolKey = "upload.tool.network"
+	}
+	if hasRecipe(networkToolKey, "upload.network_pattern") {
+		methods = append(methods, "network")
+	}
+	if hasProgrammers {
+		methods = append(methods, "programmer")
+	}
+	return methods
+}
+
+// NewDiscoveries returns the builtin serial and mDNS discoveries followed
+// by the pluggable discoveries declared by the installed platforms. The
+// discoveries are not started.
+func NewDiscoveries(pm *packagemanager.PackageManager) []discovery.Discovery {
+	discoveries := []discovery.Discovery{
+		discovery.NewSerialDiscovery(),
+		discovery.NewMDNSDiscovery(),
+	}
+	return append(discoveries, pm.LoadDiscoveries()...)
+}
+
+// StartDiscoveries starts the given discoveries and returns the ones
+// successfully started: a failing discovery is logged and skipped, so it
+// doesn't prevent the others from working.
+func StartDiscoveries(discoveries []discovery.Discovery) []discovery.Discovery {
+	started := []discovery.Discovery{}
+	for _, d := range discoveries {
+		if err := d.Start(); err != nil {
+			logrus.WithError(err).Warnf("Error starting discovery %s", d.ID())
+			d.Quit()
+			continue
+		}
+		started = append(started, d)
+	}
+	return started
+}
+
+// QuitDiscoveries terminates the given discoveries.
+func QuitDiscoveries(discoveries []discovery.Discovery) {
+	for _, d := range discoveries {
+		d.Quit()
+	}
+}
+
+// BoardListResult is the result of BoardList.
+type BoardListResult struct {
+	Ports []*DetectedPort
+}
+
+// DetectedPort is a port detected by a discovery. Boards are the installed
+// boards matching the port properties, empty if the board is unknown.
+type DetectedPort struct {
+	Port        *discovery.Port
+	DiscoveryID string
+	Boards      []*cores.Board
+}
+
+// BoardList returns the ports detected so far by the given discoveries,
+// that must have been started, together with the boards connected to them.
+// Discoveries failing to list the ports are logged and skipped.
+func BoardList(pm *packagemanager.PackageManager, discoveries []discovery.Discovery) (*BoardListResult, error) {
+	res := &BoardListResult{Ports: []*DetectedPort{}}
+	for _, d := range discoveries {
+		ports, err := d.List()
+		if err != nil {
+			logrus.WithError(err).Warnf("Error listing ports of discovery %s", d.ID())
+			continue
+		}
+		sort.Slice(ports, func(i, j int) bool {
+			return ports[i].Address < ports[j].Address
+		})
+		for _, port := range ports {
+			res.Ports = append(res.Ports, &DetectedPort{
+				Port:        port,
+				DiscoveryID: d.ID(),
+				Boards:      pm.IdentifyBoard(port),
+			})
+		}
+	}
+	return res, nil
+}
+
+// BoardListAllReq is the request for BoardListAll.
+type BoardListAllReq struct {
+	// SearchArgs, if not empty, selects only the boards whose name
+	// contains all the args (case insensitive).
+	SearchArgs []string
+}
+
+// BoardListAllResult is the result of BoardListAll.
+type BoardListAllResult struct {
+	Boards []*cores.Board
+}
+
+// BoardListAll returns all the boards provided by the installed platforms
+// sorted by name.
+func BoardListAll(pm *packagemanager.PackageManager, req *BoardListAllReq) (*BoardListAllResult, error) {
+	match := func(name string) bool {
+		name = strings.ToLower(name)
+		for _, term := range req.SearchArgs {
+			if !strings.Contains(name, strings.ToLower(term)) {
+				return false
+			}
+		}
+		return true
+	}
+
+	res := &BoardListAllResult{Boards: []*cores.Board{}}
+	for _, targetPackage := range pm.GetPackages().Packages {
+		for _, platform := range targetPackage.Platforms {
+			platformRelease := pm.GetInstalledPlatformRelease(platform)
+			if platformRelease == nil {
+				continue
+			}
+			for _, board := range platformRelease.Boards {
+				if match(board.Name()) {
+					res.Boards = append(res.Boards, board)
+				}
+			}
+		}
+	}
+	sort.Slice(res.Boards, func(i, j int) bool {
+		return res.Boards[i].Name() < res.Boards[j].Name()
+	})
+	return res, nil
+}
@@ -0,0 +1,86 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+var customHardware = paths.New("testdata", "custom_hardware")
+
+func TestBoardDetailsErrors(t *testing.T) {
+	pm := packagemanager.NewPackageManager(customHardware, customHardware, customHardware, customHardware)
+
+	_, err := api.BoardDetails(pm, &api.BoardDetailsReq{FQBN: "invalid"})
+	require.IsType(t, &api.InvalidArgumentError{}, err)
+
+	_, err = api.BoardDetails(pm, &api.BoardDetailsReq{FQBN: "arduino:avr:uno"})
+	require.IsType(t, &api.NotFoundError{}, err)
+}
+
+func TestBoardListAll(t *testing.T) {
+	pm := packagemanager.NewPackageManager(customHardware, customHardware, customHardware, customHardware)
+	pm.LoadHardwareFromDirectory(customHardware)
+
+	res, err := api.BoardListAll(pm, &api.BoardListAllReq{SearchArgs: []string{"genuino", "mega"}})
+	require.NoError(t, err)
+	require.Len(t, res.Boards, 1)
+	require.Equal(t, "arduino:avr:mega", res.Boards[0].FQBN())
+}
+
+func TestBoardDetails(t *testing.T) {
+	pm := packagemanager.NewPackageManager(customHardware, customHardware, customHardware, customHardware)
+	pm.LoadHardwareFromDirectory(customHardware)
+
+	res, err := api.BoardDetails(pm, &api.BoardDetailsReq{FQBN: "arduino:avr:mega:cpu=atmega1280"})
+	require.NoError(t, err)
+	require.Len(t, res.USBIDs, 6)
+	require.Equal(t, &api.USBID{VID: "0x2341", PID: "0x0010"}, res.USBIDs[0])
+	require.Equal(t, "atmega1280", res.BuildProperties.Get("build.mcu"))
+	require.Equal(t, "57600", res.BuildProperties.Get("upload.speed"))
+	require.Empty(t, res.Programmers)
+	// The platform has no platform.txt, the avrdude recipes are missing
+	require.Empty(t, res.UploadMethods)
+	cpu := res.ConfigOptions[0]
+	require.Equal(t, "cpu", cpu.Option)
+	require.Equal(t, "atmega2560", cpu.Values[0].Value)
+	require.Equal(t, "atmega2560", cpu.Values[0].Properties.Get("build.mcu"))
+
+	uploadHardware := paths.New("testdata", "upload_hardware")
+	pm = packagemanager.NewPackageManager(uploadHardware, uploadHardware, uploadHardware, uploadHardware)
+	require.NoError(t, pm.LoadHardwareFromDirectory(uploadHardware))
+
+	res, err = api.BoardDetails(pm, &api.BoardDetailsReq{FQBN: "test:avr:uno"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"serial", "network", "programmer"}, res.UploadMethods)
+	require.Equal(t, []*api.Programmer{{ID: "isp", Name: "Test ISP"}}, res.Programmers)
+	require.Empty(t, res.USBIDs)
+	// The properties of the platform are merged with the ones of the board
+	require.Equal(t, "{build.project_name}.hex", res.BuildProperties.Get("recipe.output.tmp_file"))
+	require.Equal(t, "fake", res.BuildProperties.Get("upload.tool"))
+	require.Equal(t, "Test Uno", res.BuildProperties.Get("name"))
+
+	res, err = api.BoardDetails(pm, &api.BoardDetailsReq{FQBN: "test:avr:wifi"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"serial", "network", "programmer"}, res.UploadMethods)
+}
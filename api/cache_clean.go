@@ -0,0 +1,238 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// CacheCleanReq is the request for CacheClean.
+type CacheCleanReq struct {
+	DryRun bool // Only compute what would be removed, without removing it.
+}
+
+// CacheCleanResult is the result of CacheClean.
+type CacheCleanResult struct {
+	RemovedTools    []*cores.ToolRelease
+	RemovedArchives paths.PathList
+	ReclaimedSize   int64 // The disk space freed, in bytes.
+}
+
+// CacheClean removes the installed tools that are not required by any
+// installed platform and the archives in the download cache that don't
+// match any entry of the package and libraries indexes, see staleArchives.
+// The tools of a packager having an installed platform that is not in the
+// indexes are kept. With req.DryRun nothing is removed, the result reports
+// what would be.
+func CacheClean(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, req *CacheCleanReq,
+	taskCB TaskProgressCB) (*CacheCleanResult, error) {
+	// The builtin ctags is required by the builder and not by a platform.
+	loadBuiltinCtagsMetadata(pm)
+
+	res := &CacheCleanResult{
+		RemovedTools:    []*cores.ToolRelease{},
+		RemovedArchives: paths.PathList{},
+	}
+
+	// The tools required by the installed platforms missing from the
+	// indexes are unknown, all the tools of their packagers are kept.
+	unindexed := map[string]bool{}
+	for _, targetPackage := range pm.GetPackages().Packages {
+		for _, platform := range targetPackage.Platforms {
+			for _, release := range platform.GetAllInstalled() {
+				if release.Resource == nil {
+					unindexed[targetPackage.Name] = true
+				}
+			}
+		}
+	}
+
+	for _, tool := range pm.GetAllInstalledToolsReleases() {
+		if tool.Tool.Package.Name == "builtin" || !pm.IsManagedToolRelease(tool) || pm.IsToolRequired(tool) {
+			continue
+		}
+		if unindexed[tool.Tool.Package.Name] {
+			continue
+		}
+		size, err := diskUsage(tool.InstallDir)
+		if err != nil {
+			return nil, fmt.Errorf("computing size of %s: %s", tool, err)
+		}
+		if !req.DryRun {
+			if err := uninstallToolRelease(pm, tool, taskCB); err != nil {
+				return nil, err
+			}
+		}
+		res.RemovedTools = append(res.RemovedTools, tool)
+		res.ReclaimedSize += size
+	}
+
+	archives, err := staleArchives(pm, lm)
+	if err != nil {
+		return nil, err
+	}
+	for _, archive := range archives {
+		info, err := archive.Stat()
+		if err != nil {
+			return nil, fmt.Errorf("reading archive %s: %s", archive, err)
+		}
+		if !req.DryRun {
+			if err := archive.Remove(); err != nil {
+				return nil, fmt.Errorf("removing archive %s: %s", archive, err)
+			}
+		}
+		res.RemovedArchives.Add(archive)
+		res.ReclaimedSize += info.Size()
+	}
+	return res, nil
+}
+
+// staleArchives returns the files in the download cache that don't match
+// the archive of a platform, tool or library listed in the indexes. The
+// archives of the platforms and tools are kept, as the packages they belong
+// to are unknown, if a package index in pm.IndexDir can't be loaded, the
+// archives of the libraries if the libraries index is not loaded. Files
+// outside the cache folders of the indexes are never removed.
+func staleArchives(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager) (paths.PathList, error) {
+	res := paths.PathList{}
+	if pm.DownloadDir == nil || !pm.DownloadDir.Exist() {
+		return res, nil
+	}
+
+	known := map[string]bool{}
+	addResource := func(r *resources.DownloadResource) {
+		if r != nil {
+			known[filepath.Join(r.CachePath, r.ArchiveFileName)] = true
+		}
+	}
+	addPackages := func(packages *cores.Packages) {
+		for _, targetPackage := range packages.Packages {
+			for _, platform := range targetPackage.Platforms {
+				for _, release := range platform.Releases {
+					addResource(release.Resource)
+				}
+			}
+			for _, tool := range targetPackage.Tools {
+				for _, release := range tool.Releases {
+					for _, flavor := range release.Flavors {
+						addResource(flavor.Resource)
+					}
+				}
+			}
+		}
+	}
+	addPackages(pm.GetPackages())
+
+	// The indexes of the URLs removed from the configuration, or that failed
+	// to load, are not in pm.
+	prunedDirs := []string{}
+	if indexesComplete, err := addIndexDirPackages(pm.IndexDir, addPackages); err != nil {
+		return nil, err
+	} else if indexesComplete {
+		prunedDirs = append(prunedDirs, "packages")
+	}
+	if lm != nil && lm.Index != nil {
+		for _, library := range lm.Index.Libraries {
+			for _, release := range library.Releases {
+				addResource(release.Resource)
+			}
+		}
+		prunedDirs = append(prunedDirs, "libraries")
+	}
+
+	for _, dir := range prunedDirs {
+		root := pm.DownloadDir.String()
+		cacheDir := filepath.Join(root, dir)
+		if _, err := os.Stat(cacheDir); os.IsNotExist(err) {
+			continue
+		}
+		err := filepath.Walk(cacheDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+			rel, err := filepath.Rel(root, path)
+			if err != nil {
+				return err
+			}
+			if !known[rel] {
+				res.Add(paths.New(path))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("reading download cache: %s", err)
+		}
+	}
+	return res, nil
+}
+
+// addIndexDirPackages loads the package indexes found in indexDir and
+// passes their packages to addPackages. It returns false if an index can't
+// be loaded.
+func addIndexDirPackages(indexDir *paths.Path, addPackages func(*cores.Packages)) (bool, error) {
+	if indexDir == nil || !indexDir.IsDir() {
+		return true, nil
+	}
+	files, err := indexDir.ReadDir()
+	if err != nil {
+		return false, fmt.Errorf("reading indexes dir: %s", err)
+	}
+	files.FilterPrefix("package_")
+	files.FilterSuffix(".json")
+	complete := true
+	for _, file := range files {
+		index, err := packageindex.LoadIndex(file)
+		if err != nil {
+			logrus.WithError(err).Warnf("Keeping all the platform and tool archives, %s can't be loaded", file)
+			complete = false
+			continue
+		}
+		packages := cores.NewPackages()
+		index.MergeIntoPackages(packages)
+		addPackages(packages)
+	}
+	return complete, nil
+}
+
+// diskUsage returns the total size of the files in dir.
+func diskUsage(dir *paths.Path) (int64, error) {
+	size := int64(0)
+	err := filepath.Walk(dir.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,128 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestCacheClean(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "cache_clean_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	packagesDir := tmp.Join("packages")
+	downloadDir := tmp.Join("staging")
+	pm := packagemanager.NewPackageManager(tmp, packagesDir, downloadDir, tmp)
+
+	installTool := func(name, version string) *cores.ToolRelease {
+		tool := pm.GetPackages().GetOrCreatePackage("test").GetOrCreateTool(name)
+		release := tool.GetOrCreateRelease(semver.ParseRelaxed(version))
+		release.InstallDir = packagesDir.Join("test", "tools", name, version)
+		require.NoError(t, release.InstallDir.MkdirAll())
+		require.NoError(t, release.InstallDir.Join("bin").WriteFile([]byte("0123456789")))
+		release.Flavors = []*cores.Flavor{{
+			OS:       "x86_64-pc-linux-gnu",
+			Resource: &resources.DownloadResource{CachePath: "packages", ArchiveFileName: name + "-" + version + ".tar.bz2"},
+		}}
+		return release
+	}
+	used := installTool("used", "1.0.0")
+	orphan := installTool("orphan", "1.0.0")
+	oldUsed := installTool("used", "0.9.0")
+
+	platform := pm.GetPackages().GetOrCreatePackage("test").GetOrCreatePlatform("avr")
+	platformRelease, err := platform.GetOrCreateRelease(semver.MustParse("1.0.0"))
+	require.NoError(t, err)
+	platformRelease.InstallDir = packagesDir.Join("test", "hardware", "avr", "1.0.0")
+	platformRelease.Resource = &resources.DownloadResource{CachePath: "packages", ArchiveFileName: "avr-1.0.0.tar.bz2"}
+	platformRelease.Dependencies = cores.ToolDependencies{
+		{ToolPackager: "test", ToolName: "used", ToolVersion: semver.ParseRelaxed("1.0.0")},
+	}
+
+	require.NoError(t, downloadDir.Join("packages").MkdirAll())
+	require.NoError(t, downloadDir.Join("packages", "used-1.0.0.tar.bz2").WriteFile([]byte("012")))
+	require.NoError(t, downloadDir.Join("packages", "stale-1.0.0.tar.bz2").WriteFile([]byte("01234")))
+
+	res, err := CacheClean(pm, nil, &CacheCleanReq{DryRun: true}, func(*TaskProgress) {})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*cores.ToolRelease{orphan, oldUsed}, res.RemovedTools)
+	require.Equal(t, paths.PathList{downloadDir.Join("packages", "stale-1.0.0.tar.bz2")}, res.RemovedArchives)
+	require.Equal(t, int64(25), res.ReclaimedSize)
+	require.True(t, orphan.InstallDir.Exist())
+	require.True(t, res.RemovedArchives[0].Exist())
+
+	res, err = CacheClean(pm, nil, &CacheCleanReq{}, func(*TaskProgress) {})
+	require.NoError(t, err)
+	require.Len(t, res.RemovedTools, 2)
+	require.Equal(t, int64(25), res.ReclaimedSize)
+	require.False(t, packagesDir.Join("test", "tools", "orphan").Join("1.0.0").Exist())
+	require.False(t, packagesDir.Join("test", "tools", "used", "0.9.0").Exist())
+	require.True(t, used.InstallDir.Exist())
+	require.True(t, downloadDir.Join("packages", "used-1.0.0.tar.bz2").Exist())
+	require.False(t, downloadDir.Join("packages", "stale-1.0.0.tar.bz2").Exist())
+}
+
+func TestCacheCleanKeepsUnindexed(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "cache_clean_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	packagesDir := tmp.Join("packages")
+	downloadDir := tmp.Join("staging")
+	pm := packagemanager.NewPackageManager(tmp, packagesDir, downloadDir, tmp)
+
+	// A platform installed by hand, or whose index failed to load, doesn't
+	// declare the tools it requires
+	tool := pm.GetPackages().GetOrCreatePackage("other").GetOrCreateTool("uploader")
+	toolRelease := tool.GetOrCreateRelease(semver.ParseRelaxed("1.0.0"))
+	toolRelease.InstallDir = packagesDir.Join("other", "tools", "uploader", "1.0.0")
+	require.NoError(t, toolRelease.InstallDir.MkdirAll())
+	platform := pm.GetPackages().GetOrCreatePackage("other").GetOrCreatePlatform("samd")
+	platformRelease, err := platform.GetOrCreateRelease(semver.MustParse("1.0.0"))
+	require.NoError(t, err)
+	platformRelease.InstallDir = packagesDir.Join("other", "hardware", "samd", "1.0.0")
+
+	// The index of a URL removed from the configuration is still on disk
+	index := `{"packages": [{"name": "removed", "platforms": [{"name": "Removed", "architecture": "avr",
+		"version": "1.0.0", "url": "https://example.com/removed-1.0.0.zip", "archiveFileName": "removed-1.0.0.zip",
+		"checksum": "SHA-256:00", "size": "3", "boards": [], "toolsDependencies": []}], "tools": []}]}`
+	require.NoError(t, tmp.Join("package_removed_index.json").WriteFile([]byte(index)))
+	require.NoError(t, downloadDir.Join("packages").MkdirAll())
+	require.NoError(t, downloadDir.Join("packages", "removed-1.0.0.zip").WriteFile([]byte("012")))
+	require.NoError(t, downloadDir.Join("packages", "stale-1.0.0.zip").WriteFile([]byte("012")))
+	require.NoError(t, downloadDir.Join("libraries").MkdirAll())
+	require.NoError(t, downloadDir.Join("libraries", "MyLib-1.0.0.zip").WriteFile([]byte("012")))
+
+	res, err := CacheClean(pm, nil, &CacheCleanReq{DryRun: true}, func(*TaskProgress) {})
+	require.NoError(t, err)
+	require.Empty(t, res.RemovedTools)
+	require.Equal(t, paths.PathList{downloadDir.Join("packages", "stale-1.0.0.zip")}, res.RemovedArchives,
+		"the archives of the libraries are kept without the libraries index")
+
+	// An index that can't be loaded may list any of the archives
+	require.NoError(t, tmp.Join("package_broken_index.json").WriteFile([]byte("{")))
+	res, err = CacheClean(pm, nil, &CacheCleanReq{DryRun: true}, func(*TaskProgress) {})
+	require.NoError(t, err)
+	require.Empty(t, res.RemovedArchives)
+}
@@ -0,0 +1,455 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	builder "github.com/arduino/arduino-builder"
+	"github.com/arduino/arduino-builder/i18n"
+	"github.com/arduino/arduino-builder/types"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// CompileReq is the request for Compile.
+type CompileReq struct {
+	SketchPath      *paths.Path // The sketch to compile.
+	FQBN            string      // Fully Qualified Board Name, if empty the one attached to the sketch is used.
+	ShowProperties  bool        // Dump the build properties instead of compiling.
+	Preprocess      bool        // Print the preprocessed code instead of compiling.
+	BuildCachePath  *paths.Path // Builds of 'core.a' are saved into this path to be cached and reused.
+	BuildPath       *paths.Path // Path where to save compiled files, if nil a temporary directory is used.
+	BuildProperties []string    // Custom build properties.
+	Warnings        string      // Used to tell gcc which warning level to use, defaults to "none".
+	Verbose         bool        // Turns on verbose output.
+	Debug           bool        // Turns on the debug output of the builder.
+	VidPid          string      // VID/PID specific build properties.
+	ExportFile      *paths.Path // The compiled binary is copied to this file, if nil it's copied in the sketch folder.
+	Locked          bool        // Refuse to build if the environment diverges from the sketch lockfile, see SketchLockInstall.
+}
+
+// CompileResult is the result of Compile.
+type CompileResult struct {
+	// BuildPath is the directory containing the build artifacts.
+	BuildPath *paths.Path
+	// BuildArtifacts are the files produced by the build in BuildPath
+	// (.elf, .hex, .bin, ...).
+	BuildArtifacts paths.PathList
+	// ExportedFiles are the copies of the compiled binaries made in the
+	// sketch folder (or as specified by CompileReq.ExportFile).
+	ExportedFiles paths.PathList
+	// UsedLibraries are the libraries compiled with the sketch.
+	UsedLibraries libraries.List
+	// BoardPlatform is the platform defining the board.
+	BoardPlatform *cores.PlatformRelease
+	// CorePlatform is the platform providing the core, it differs from
+	// BoardPlatform when the board references the core of another package.
+	CorePlatform *cores.PlatformRelease
+	// Tools are the tools required by the platform to build the sketch.
+	Tools []*cores.ToolRelease
+	// Size is the memory usage of the sketch, nil if the builder didn't
+	// report it: the platform doesn't define a size recipe or the board
+	// doesn't define upload.maximum_size.
+	Size *SizeReport
+}
+
+// Compile compiles a sketch. The output of the builder is written to
+// stdout and stderr. The ctags tool must be already installed, see
+// EnsureCtagsInstalled. If the sketch doesn't fit in the memory of the
+// board a FailedPreconditionError is returned together with the result.
+func Compile(pm *packagemanager.PackageManager, config *configs.Configuration, req *CompileReq,
+	stdout, stderr io.Writer) (*CompileResult, error) {
+	logrus.Info("Executing `arduino compile`")
+	ctx, err := newBuilderContext(pm, config, req, stdout, stderr)
+	if err != nil {
+		return nil, err
+	}
+	fqbn := ctx.FQBN
+
+	if req.Locked {
+		if err := verifySketchLock(pm, config, req, stdout, stderr); err != nil {
+			return nil, err
+		}
+	}
+
+	if req.ShowProperties {
+		err = builder.RunParseHardwareAndDumpBuildProperties(ctx)
+	} else if req.Preprocess {
+		err = builder.RunPreprocess(ctx)
+	} else {
+		err = builder.RunBuilder(ctx)
+		if size := newSizeReport(ctx.ExecutableSectionsSize); err != nil && size != nil &&
+			(size.ProgramExceeded() || size.DataExceeded()) {
+			// The builder fails also when the sketch is too big, report
+			// the memory usage in this case.
+			if res, resErr := newCompileResult(ctx); resErr == nil {
+				return res, &FailedPreconditionError{Message: "sketch too big"}
+			}
+		}
+	}
+	if err != nil {
+		if headers := findMissingIncludes(ctx); len(headers) > 0 {
+			return nil, &MissingIncludeError{Headers: headers, Architecture: fqbn.PlatformArch, Cause: err}
+		}
+		return nil, fmt.Errorf("compilation failed: %s", err)
+	}
+	if req.ShowProperties || req.Preprocess {
+		return &CompileResult{BuildPath: ctx.BuildPath}, nil
+	}
+	res, err := newCompileResult(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// FIXME: Make a function to obtain these info...
+	outputPath := ctx.BuildProperties.ExpandPropsInString("{build.path}/{recipe.output.tmp_file}")
+	ext := filepath.Ext(outputPath)
+
+	// FIXME: Make a function to produce a better name...
+	// Make the filename without the FQBN configs and platform version parts
+	fqbn.Configs = properties.NewMap()
+	fqbn.PlatformVersion = nil
+	fqbnSuffix := strings.Replace(fqbn.String(), ":", ".", -1)
+
+	var exportPath *paths.Path
+	var exportFile string
+	if req.ExportFile == nil {
+		exportPath = ctx.SketchLocation
+		exportFile = ctx.SketchLocation.Base() + "." + fqbnSuffix
+	} else {
+		exportPath = req.ExportFile.Parent()
+		exportFile = req.ExportFile.Base()
+		if strings.HasSuffix(exportFile, ext) {
+			exportFile = exportFile[:len(exportFile)-len(ext)]
+		}
+	}
+
+	// Copy .hex file to sketch directory
+	srcHex := paths.New(outputPath)
+	dstHex := exportPath.Join(exportFile + ext)
+	logrus.WithField("from", srcHex).WithField("to", dstHex).Print("copying sketch build output")
+	if err := srcHex.CopyTo(dstHex); err != nil {
+		return nil, fmt.Errorf("copying output file: %s", err)
+	}
+	res.ExportedFiles.Add(dstHex)
+
+	// Copy .elf file to sketch directory
+	srcElf := paths.New(outputPath[:len(outputPath)-3] + "elf")
+	dstElf := exportPath.Join(exportFile + ".elf")
+	logrus.WithField("from", srcElf).WithField("to", dstElf).Print("copying sketch build output")
+	if err := srcElf.CopyTo(dstElf); err != nil {
+		return nil, fmt.Errorf("copying elf file: %s", err)
+	}
+	res.ExportedFiles.Add(dstElf)
+	return res, nil
+}
+
+// newCompileResult collects the outcome of a completed build.
+func newCompileResult(ctx *types.Context) (*CompileResult, error) {
+	res := &CompileResult{
+		BuildPath:     ctx.BuildPath,
+		UsedLibraries: ctx.ImportedLibraries,
+		BoardPlatform: ctx.TargetPlatform,
+		CorePlatform:  ctx.ActualPlatform,
+		Tools:         ctx.RequiredTools,
+		Size:          newSizeReport(ctx.ExecutableSectionsSize),
+	}
+
+	files, err := ctx.BuildPath.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("reading build directory: %s", err)
+	}
+	projectName := ctx.BuildProperties.Get("build.project_name")
+	for _, file := range files {
+		if file.HasPrefix(projectName+".") && file.IsNotDir() {
+			res.BuildArtifacts.Add(file)
+		}
+	}
+	return res, nil
+}
+
+// sourceFileExtensions are the extensions of the files searched for
+// missing includes by findMissingIncludes.
+var sourceFileExtensions = []string{".c", ".cpp", ".S"}
+
+// findMissingIncludes runs the preprocessor, as the builder does while
+// detecting the libraries, on the sketch and on the libraries found so far
+// and returns the headers that can't be found. It's used after a failed
+// build to tell if some libraries are missing.
+func findMissingIncludes(ctx *types.Context) []string {
+	if ctx.BuildProperties == nil || ctx.SketchBuildPath == nil || !ctx.SketchBuildPath.IsDir() {
+		return nil
+	}
+	type sourceFolder struct {
+		dir      *paths.Path
+		recurse  bool
+		includes paths.PathList
+	}
+	folders := []sourceFolder{
+		{dir: ctx.SketchBuildPath, includes: ctx.IncludeFolders},
+		{dir: ctx.SketchBuildPath.Join("src"), recurse: true, includes: ctx.IncludeFolders},
+	}
+	for _, lib := range ctx.ImportedLibraries {
+		includes := ctx.IncludeFolders
+		if lib.UtilityDir != nil {
+			includes = append(includes.Clone(), lib.UtilityDir)
+		}
+		for _, sourceDir := range lib.SourceDirs() {
+			folders = append(folders, sourceFolder{dir: sourceDir.Dir, recurse: sourceDir.Recurse, includes: includes})
+		}
+	}
+
+	headers := []string{}
+	found := map[string]bool{}
+	for _, folder := range folders {
+		for _, file := range sourceFilesIn(folder.dir, folder.recurse) {
+			stderr, err := builder.GCCPreprocRunnerForDiscoveringIncludes(ctx, file, paths.NullPath(), folder.includes)
+			if err == nil {
+				continue
+			}
+			header := builder.IncludesFinderWithRegExp(ctx, string(stderr))
+			if header != "" && !found[header] {
+				logrus.WithField("file", file).WithField("header", header).Info("Missing include")
+				found[header] = true
+				headers = append(headers, header)
+			}
+		}
+	}
+	return headers
+}
+
+// sourceFilesIn returns the files with one of the sourceFileExtensions in
+// dir and, if recurse is set, in its subfolders.
+func sourceFilesIn(dir *paths.Path, recurse bool) paths.PathList {
+	files := paths.PathList{}
+	if !dir.IsDir() {
+		return files
+	}
+	filepath.Walk(dir.String(), func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			if !recurse && path != dir.String() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		files.Add(paths.New(path))
+		return nil
+	})
+	files.FilterSuffix(sourceFileExtensions...)
+	return files
+}
+
+// newBuilderContext prepares the arduino-builder context to build the
+// sketch as specified in the request.
+func newBuilderContext(pm *packagemanager.PackageManager, config *configs.Configuration, req *CompileReq,
+	stdout, stderr io.Writer) (*types.Context, error) {
+	if req.SketchPath == nil {
+		return nil, &InvalidArgumentError{Message: "missing sketch path"}
+	}
+	sketch, err := sketches.NewSketchFromPath(req.SketchPath)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "opening sketch", Cause: err}
+	}
+
+	// A missing or invalid lockfile is reported by verifySketchLock
+	var lock *sketches.Lockfile
+	if req.Locked {
+		lock, _ = sketches.LoadLockfile(req.SketchPath.Join(sketches.LockfileName))
+	}
+
+	fqbnIn := req.FQBN
+	if fqbnIn == "" && sketch != nil {
+		fqbnIn = sketch.Metadata.CPU.Fqbn
+	}
+	if fqbnIn == "" && lock != nil {
+		fqbnIn = lock.FQBN
+	}
+	if fqbnIn == "" {
+		return nil, &InvalidArgumentError{Message: "no Fully Qualified Board Name provided"}
+	}
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "incorrect FQBN", Cause: err}
+	}
+	if lock != nil && fqbn.PlatformVersion == nil {
+		// Build with the locked release of the platform even if a newer
+		// one is installed side by side
+		if locked := lock.Platform(fqbn.Package, fqbn.PlatformArch); locked != nil {
+			if version, err := semver.Parse(locked.Version); err == nil {
+				fqbn.PlatformVersion = version
+			}
+		}
+	}
+
+	targetPlatform := pm.FindPlatform(&packagemanager.PlatformReference{
+		Package:              fqbn.Package,
+		PlatformArchitecture: fqbn.PlatformArch,
+	})
+	installed := targetPlatform != nil && pm.GetInstalledPlatformRelease(targetPlatform) != nil
+	platformID := fqbn.Package + ":" + fqbn.PlatformArch
+	if installed && fqbn.PlatformVersion != nil {
+		pinned := targetPlatform.FindReleaseWithVersion(fqbn.PlatformVersion)
+		installed = pinned != nil && pinned.IsInstalled()
+		platformID += "@" + fqbn.PlatformVersion.String()
+	}
+	if !installed {
+		return nil, &FailedPreconditionError{
+			Message: fmt.Sprintf("platform %s is not installed", platformID),
+		}
+	}
+	_, _, board, _, _, err := pm.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, &NotFoundError{Message: "incorrect FQBN", Cause: err}
+	}
+	if _, err := pm.ResolveToolsForBoard(board); err != nil {
+		return nil, &FailedPreconditionError{Message: "resolving tools", Cause: err}
+	}
+
+	ctx := &types.Context{}
+	ctx.PackageManager = pm
+	ctx.FQBN = fqbn
+	ctx.SketchLocation = paths.New(sketch.FullPath)
+	ctx.SetLogger(&streamLogger{stdout: stdout, stderr: stderr})
+
+	// FIXME: This will be redundant when arduino-builder will be part of the cli
+	if packagesDir, err := config.HardwareDirectories(); err == nil {
+		ctx.HardwareDirs = packagesDir
+	} else {
+		return nil, &ConfigurationError{Message: "getting hardware directories", Cause: err}
+	}
+
+	if toolsDir, err := config.BundleToolsDirectories(); err == nil {
+		ctx.ToolsDirs = toolsDir
+	} else {
+		return nil, &ConfigurationError{Message: "getting bundled tools directories", Cause: err}
+	}
+
+	ctx.OtherLibrariesDirs = paths.NewPathList()
+	ctx.OtherLibrariesDirs.Add(config.LibrariesDir())
+
+	if req.BuildPath != nil {
+		ctx.BuildPath = req.BuildPath
+		if err := ctx.BuildPath.MkdirAll(); err != nil {
+			return nil, fmt.Errorf("creating the build directory: %s", err)
+		}
+	}
+
+	ctx.Verbose = req.Verbose
+
+	ctx.CoreBuildCachePath = paths.TempDir().Join("arduino-core-cache")
+
+	ctx.USBVidPid = req.VidPid
+	ctx.WarningsLevel = req.Warnings
+	if ctx.WarningsLevel == "" {
+		ctx.WarningsLevel = "none"
+	}
+
+	if req.Debug {
+		ctx.DebugLevel = 100
+	} else {
+		ctx.DebugLevel = 5
+	}
+
+	ctx.CustomBuildProperties = append(req.BuildProperties, "build.warn_data_percentage=75")
+
+	if req.BuildCachePath != nil {
+		ctx.BuildCachePath = req.BuildCachePath
+		if err := ctx.BuildCachePath.MkdirAll(); err != nil {
+			return nil, fmt.Errorf("creating the build cache directory: %s", err)
+		}
+	}
+
+	// Will be deprecated.
+	ctx.ArduinoAPIVersion = "10607"
+
+	// Check if Arduino IDE is installed and get it's libraries location.
+	preferencesTxt := config.DataDir.Join("preferences.txt")
+	ideProperties, err := properties.LoadFromPath(preferencesTxt)
+	if err == nil {
+		lastIdeSubProperties := ideProperties.SubTree("last").SubTree("ide")
+		// Preferences can contain records from previous IDE versions. Find the latest one.
+		var pathVariants []string
+		for k := range lastIdeSubProperties.AsMap() {
+			if strings.HasSuffix(k, ".hardwarepath") {
+				pathVariants = append(pathVariants, k)
+			}
+		}
+		if len(pathVariants) > 0 {
+			sort.Strings(pathVariants)
+			ideHardwarePath := lastIdeSubProperties.Get(pathVariants[len(pathVariants)-1])
+			ideLibrariesPath := filepath.Join(filepath.Dir(ideHardwarePath), "libraries")
+			ctx.BuiltInLibrariesDirs = paths.NewPathList(ideLibrariesPath)
+		}
+	}
+
+	return ctx, nil
+}
+
+// streamLogger is an i18n.Logger that redirects the builder output to the
+// given writers instead of the process stdout/stderr.
+type streamLogger struct {
+	stdout io.Writer
+	stderr io.Writer
+}
+
+func (s *streamLogger) writer(w io.Writer) io.Writer {
+	if w == os.Stderr {
+		return s.stderr
+	}
+	return s.stdout
+}
+
+func (s *streamLogger) Fprintln(w io.Writer, level string, format string, a ...interface{}) {
+	fmt.Fprintln(s.writer(w), i18n.Format(format, a...))
+}
+
+func (s *streamLogger) UnformattedFprintln(w io.Writer, str string) {
+	fmt.Fprintln(s.writer(w), str)
+}
+
+func (s *streamLogger) UnformattedWrite(w io.Writer, data []byte) {
+	s.writer(w).Write(data)
+}
+
+func (s *streamLogger) Println(level string, format string, a ...interface{}) {
+	s.Fprintln(nil, level, format, a...)
+}
+
+func (s *streamLogger) Flush() string {
+	return ""
+}
+
+func (s *streamLogger) Name() string {
+	return "stream"
+}
@@ -0,0 +1,52 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-builder/types"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindMissingIncludes(t *testing.T) {
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	tmp, err := paths.MkTempDir("", "missing_includes")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	require.NoError(t, tmp.Join("src", "util").MkdirAll())
+	require.NoError(t, tmp.Join("Blink.ino.cpp").WriteFile([]byte("#include <Foo.h>\n")))
+	require.NoError(t, tmp.Join("src", "util", "util.cpp").WriteFile([]byte("#include <Foo.h>\n")))
+	require.NoError(t, tmp.Join("Blink.ino.hex").WriteFile([]byte{}))
+
+	props := properties.NewMap()
+	props.Set("cmd", os.Args[0])
+	props.Set("recipe.preproc.macros", `"{cmd}" -test.run=TestHelperProcess -- --fail {source_file}:1:10: fatal error: Foo.h: No such file or directory`)
+	ctx := &types.Context{BuildProperties: props, SketchBuildPath: tmp}
+	require.Equal(t, []string{"Foo.h"}, findMissingIncludes(ctx))
+
+	props.Set("recipe.preproc.macros", `"{cmd}" -test.run=TestHelperProcess -- {source_file}`)
+	require.Empty(t, findMissingIncludes(ctx))
+
+	require.Empty(t, findMissingIncludes(&types.Context{}))
+}
@@ -0,0 +1,149 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"go.bug.st/downloader"
+)
+
+// UpdateIndex downloads the latest version of the package indexes of all
+// the URLs in the configuration. The detached signature of each index is
+// verified before saving it: indexes that are unsigned or whose signature
+// can't be verified are refused or reported to taskCB, as configured in
+// config.IndexSignaturePolicy.
+func UpdateIndex(config *configs.Configuration, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	for _, URL := range config.BoardManagerAdditionalUrls {
+		if err := updateIndex(config, URL, downloadCB, taskCB); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// packageIndexPath returns the path where the package index downloaded
+// from the given URL is saved, file:// URLs are used in place.
+func packageIndexPath(config *configs.Configuration, URL *url.URL) *paths.Path {
+	if localPath := resources.LocalFilePath(URL); localPath != nil {
+		return localPath
+	}
+	return config.IndexesDir().Join(path.Base(URL.Path))
+}
+
+// TODO: This should be in packagemanager......
+func updateIndex(config *configs.Configuration, URL *url.URL, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	logrus.WithField("url", URL).Print("Updating index")
+	if localPath := resources.LocalFilePath(URL); localPath != nil {
+		return checkLocalIndex(config, URL, localPath, taskCB, func(index *paths.Path) error {
+			_, err := packageindex.LoadIndex(index)
+			return err
+		})
+	}
+
+	tmpDir, err := paths.MkTempDir("", "index")
+	if err != nil {
+		return fmt.Errorf("creating temp dir for download: %s", err)
+	}
+	defer tmpDir.RemoveAll()
+
+	coreIndexPath := packageIndexPath(config, URL)
+	tmp := tmpDir.Join(coreIndexPath.Base())
+	if err := downloadIndex(URL, tmp, downloadCB); err != nil {
+		return err
+	}
+	tmpSig := indexSignaturePath(tmp)
+	signed, err := downloadIndexSignature(URL, tmpSig)
+	if err != nil {
+		return err
+	}
+	warnCB := func(msg string) { taskCB(&TaskProgress{Message: msg, Completed: true}) }
+	if err := checkIndexSignature(config, URL, tmp, tmpSig, warnCB); err != nil {
+		return err
+	}
+
+	if _, err := packageindex.LoadIndex(tmp); err != nil {
+		return fmt.Errorf("invalid package index in %s: %s", URL, err)
+	}
+
+	indexDirPath := config.IndexesDir()
+	if err := indexDirPath.MkdirAll(); err != nil {
+		return fmt.Errorf("creating data directory %s: %s", indexDirPath, err)
+	}
+	return saveIndex(URL, tmp, coreIndexPath, signed)
+}
+
+// checkLocalIndex verifies the signature of the index at a file:// URL and
+// validates it with the load function. The index is used in place, nothing
+// is copied.
+func checkLocalIndex(config *configs.Configuration, URL *url.URL, index *paths.Path, taskCB TaskProgressCB,
+	load func(*paths.Path) error) error {
+	if index.NotExist() {
+		return &NotFoundError{Message: fmt.Sprintf("index %s not found", URL)}
+	}
+	warnCB := func(msg string) { taskCB(&TaskProgress{Message: msg, Completed: true}) }
+	if err := checkIndexSignature(config, URL, index, indexSignaturePath(index), warnCB); err != nil {
+		return err
+	}
+	if err := load(index); err != nil {
+		return fmt.Errorf("invalid index in %s: %s", URL, err)
+	}
+	taskCB(&TaskProgress{Name: "Using local index " + index.String(), Completed: true})
+	return nil
+}
+
+// downloadIndex downloads the index at the given URL into target.
+func downloadIndex(URL *url.URL, target *paths.Path, downloadCB DownloadProgressCB) error {
+	d, err := downloader.Download(target.String(), URL.String(), downloader.NoResume)
+	if err != nil {
+		return &NetworkError{Message: "downloading index " + URL.String(), Cause: err}
+	}
+	if err := download(d, target.Base(), downloadCB); err != nil {
+		return &NetworkError{Message: "downloading index " + URL.String(), Cause: err}
+	}
+	return nil
+}
+
+// saveIndex copies a downloaded index, and its signature if signed, to
+// its final destination. A stale signature is removed if the index is not
+// signed anymore.
+func saveIndex(URL *url.URL, downloaded, index *paths.Path, signed bool) error {
+	if err := downloaded.CopyTo(index); err != nil {
+		return fmt.Errorf("saving downloaded index %s: %s", URL, err)
+	}
+	signature := indexSignaturePath(index)
+	if !signed {
+		if signature.Exist() {
+			if err := signature.Remove(); err != nil {
+				return fmt.Errorf("removing stale signature of index %s: %s", URL, err)
+			}
+		}
+		return nil
+	}
+	if err := indexSignaturePath(downloaded).CopyTo(signature); err != nil {
+		return fmt.Errorf("saving signature of index %s: %s", URL, err)
+	}
+	return nil
+}
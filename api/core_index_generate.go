@@ -0,0 +1,130 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// PlatformIndexGenerateReq is the request for PlatformIndexGenerate.
+type PlatformIndexGenerateReq struct {
+	// Descriptor is the JSON file describing the platform and tool
+	// archives, see packageindex.Descriptor.
+	Descriptor *paths.Path
+	// Index is the package index to create, if it exists the releases are
+	// merged into it replacing the ones with the same version.
+	Index *paths.Path
+}
+
+// PlatformIndexGenerateResult is the result of PlatformIndexGenerate.
+type PlatformIndexGenerateResult struct {
+	Platforms []*cores.PlatformRelease // The platform releases written in the index.
+	Tools     []*cores.ToolRelease     // The tool releases written in the index.
+	Merged    bool                     // True if an existing index has been updated.
+}
+
+// PlatformIndexGenerate writes in req.Index the package index entries of
+// the platform and tools described in req.Descriptor: the size and the
+// checksum of the archives are computed and the boards, with their USB
+// ids, are read from the boards.txt in the platform archive. The index
+// written is loaded back to check it's valid before replacing req.Index.
+func PlatformIndexGenerate(req *PlatformIndexGenerateReq) (*PlatformIndexGenerateResult, error) {
+	if req.Descriptor == nil || req.Index == nil {
+		return nil, &InvalidArgumentError{Message: "missing descriptor or index file"}
+	}
+	if !req.Descriptor.Exist() {
+		return nil, &NotFoundError{Message: fmt.Sprintf("descriptor %s not found", req.Descriptor)}
+	}
+	descriptor, err := packageindex.LoadDescriptor(req.Descriptor)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "loading descriptor", Cause: err}
+	}
+
+	tmp, err := paths.MkTempDir("", "index_generate")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp dir: %s", err)
+	}
+	defer tmp.RemoveAll()
+	logrus.WithField("descriptor", req.Descriptor).Info("Generating package index")
+	generated, err := packageindex.GenerateIndex(descriptor, tmp)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "generating index from " + req.Descriptor.String(), Cause: err}
+	}
+
+	res := &PlatformIndexGenerateResult{}
+	index := generated
+	if req.Index.Exist() {
+		index, err = packageindex.LoadIndex(req.Index)
+		if err != nil {
+			return nil, &InvalidArgumentError{Message: "loading index " + req.Index.String(), Cause: err}
+		}
+		index.Merge(generated)
+		res.Merged = true
+	}
+	if err := req.Index.Parent().MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating index directory: %s", err)
+	}
+	// The index is written in a temp file next to req.Index and replaces it
+	// only once loaded back, so a failure never leaves a broken index.
+	indexFile, err := paths.MkTempFile(req.Index.Parent(), req.Index.Base()+".")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp index file: %s", err)
+	}
+	indexTmp := paths.New(indexFile.Name())
+	defer indexTmp.Remove()
+	if err := indexFile.Close(); err != nil {
+		return nil, fmt.Errorf("creating temp index file: %s", err)
+	}
+	// Temp files are created readable only by the owner
+	if err := os.Chmod(indexTmp.String(), 0644); err != nil {
+		return nil, fmt.Errorf("creating temp index file: %s", err)
+	}
+	if err := index.SaveIndex(indexTmp); err != nil {
+		return nil, fmt.Errorf("writing index: %s", err)
+	}
+	if _, err := packageindex.LoadIndex(indexTmp); err != nil {
+		return nil, fmt.Errorf("invalid index written: %s", err)
+	}
+	if err := indexTmp.Rename(req.Index); err != nil {
+		return nil, fmt.Errorf("writing index: %s", err)
+	}
+
+	packages := cores.NewPackages()
+	generated.MergeIntoPackages(packages)
+	for _, targetPackage := range packages.Packages {
+		for _, platform := range targetPackage.Platforms {
+			for _, release := range platform.Releases {
+				res.Platforms = append(res.Platforms, release)
+			}
+		}
+		for _, tool := range targetPackage.Tools {
+			for _, release := range tool.Releases {
+				res.Tools = append(res.Tools, release)
+			}
+		}
+	}
+	sort.Slice(res.Tools, func(i, j int) bool { return res.Tools[i].String() < res.Tools[j].String() })
+	return res, nil
+}
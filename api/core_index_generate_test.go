@@ -0,0 +1,70 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformIndexGenerate(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "index_generate_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	archives := paths.New("testdata", "lock", "data", "staging", "packages")
+	require.NoError(t, archives.Join("avr-1.0.0.zip").CopyTo(tmp.Join("avr-1.0.0.zip")))
+	require.NoError(t, archives.Join("fake-1.0.0.zip").CopyTo(tmp.Join("fake-1.0.0.zip")))
+	descriptor := `{
+		"package": {"name": "locktest", "maintainer": "Arduino"},
+		"baseUrl": "https://example.com/",
+		"platform": {"architecture": "avr", "category": "Arduino", "archive": "avr-1.0.0.zip"},
+		"tools": [{"name": "fake", "version": "1.0.0", "systems": [{"host": "x86_64-pc-linux-gnu", "archive": "fake-1.0.0.zip"}]}]
+	}`
+	require.NoError(t, tmp.Join("descriptor.json").WriteFile([]byte(descriptor)))
+	indexDir := tmp.Join("index")
+	req := &PlatformIndexGenerateReq{
+		Descriptor: tmp.Join("descriptor.json"),
+		Index:      indexDir.Join("package_locktest_index.json"),
+	}
+
+	res, err := PlatformIndexGenerate(req)
+	require.NoError(t, err)
+	require.False(t, res.Merged)
+	require.Len(t, res.Platforms, 1)
+	require.Len(t, res.Tools, 1)
+	files, err := indexDir.ReadDir()
+	require.NoError(t, err)
+	require.Equal(t, paths.PathList{req.Index}, files, "the temp index file is renamed")
+
+	res, err = PlatformIndexGenerate(req)
+	require.NoError(t, err)
+	require.True(t, res.Merged)
+	files, err = indexDir.ReadDir()
+	require.NoError(t, err)
+	require.Equal(t, paths.PathList{req.Index}, files)
+
+	// An index that can't be loaded is left untouched
+	require.NoError(t, req.Index.WriteFile([]byte("{")))
+	_, err = PlatformIndexGenerate(req)
+	require.IsType(t, &InvalidArgumentError{}, err)
+	data, err := req.Index.ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "{", string(data))
+}
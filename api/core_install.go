@@ -0,0 +1,193 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+)
+
+// PlatformDownloadReq is the request for PlatformDownload. If the version
+// of the platform is not specified the latest is downloaded.
+type PlatformDownloadReq struct {
+	Platform *packagemanager.PlatformReference
+}
+
+// PlatformDownload downloads a platform and its tool dependencies without
+// installing them.
+func PlatformDownload(pm *packagemanager.PackageManager, req *PlatformDownloadReq, downloadCB DownloadProgressCB) error {
+	platform, tools, err := findPlatformReleaseDependencies(pm, req.Platform)
+	if err != nil {
+		return err
+	}
+	return downloadPlatformAndTools(pm, platform, tools, downloadCB)
+}
+
+func findPlatformReleaseDependencies(pm *packagemanager.PackageManager, ref *packagemanager.PlatformReference) (*cores.PlatformRelease, []*cores.ToolRelease, error) {
+	if ref == nil {
+		return nil, nil, &InvalidArgumentError{Message: "missing platform reference"}
+	}
+	platform, tools, err := pm.FindPlatformReleaseDependencies(ref)
+	if err != nil {
+		return nil, nil, &NotFoundError{Message: "finding platform dependencies", Cause: err}
+	}
+	return platform, tools, nil
+}
+
+// downloadPlatformAndTools downloads in parallel the platform, if not nil,
+// and the tools.
+func downloadPlatformAndTools(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, tools []*cores.ToolRelease,
+	downloadCB DownloadProgressCB) error {
+	items := []*downloadItem{}
+	for _, tool := range tools {
+		// Check if tool has a flavor available for the current OS
+		resource := tool.GetCompatibleFlavour()
+		if resource == nil {
+			return &NotFoundError{Message: fmt.Sprintf("tool %s is not available for the current OS", tool)}
+		}
+		items = append(items, &downloadItem{label: tool.String(), resource: resource})
+	}
+	if platformRelease != nil {
+		items = append(items, &downloadItem{label: platformRelease.String(), resource: platformRelease.Resource})
+	}
+	return downloadAll(pm.NewDownloadScheduler(), items, downloadCB)
+}
+
+func downloadTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease, downloadCB DownloadProgressCB) error {
+	return downloadPlatformAndTools(pm, nil, []*cores.ToolRelease{tool}, downloadCB)
+}
+
+// PlatformInstallReq is the request for PlatformInstall. If the version
+// of the platform is not specified the latest is installed.
+type PlatformInstallReq struct {
+	Platform *packagemanager.PlatformReference
+}
+
+// PlatformInstall downloads and installs a platform and its tool
+// dependencies. Other releases of the same platform already installed are
+// kept side by side, the highest one is used unless the FQBN pins a version.
+func PlatformInstall(pm *packagemanager.PackageManager, req *PlatformInstallReq,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	platform, tools, err := findPlatformReleaseDependencies(pm, req.Platform)
+	if err != nil {
+		return err
+	}
+	return installPlatform(pm, platform, tools, nil, downloadCB, taskCB)
+}
+
+// installPlatform installs a platform release replacing the release
+// replaced, or side by side with the installed releases if it's nil.
+func installPlatform(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, requiredTools []*cores.ToolRelease,
+	replaced *cores.PlatformRelease, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	log := pm.Log.WithField("platform", platformRelease)
+
+	// Prerequisite checks before install
+	if platformRelease.IsInstalled() {
+		log.Warn("Platform already installed")
+		taskCB(&TaskProgress{Name: "Platform " + platformRelease.String() + " already installed", Completed: true})
+		return nil
+	}
+	toolsToInstall := []*cores.ToolRelease{}
+	for _, tool := range requiredTools {
+		if tool.IsInstalled() {
+			log.WithField("tool", tool).Warn("Tool already installed")
+			taskCB(&TaskProgress{Name: "Tool " + tool.String() + " already installed", Completed: true})
+		} else {
+			toolsToInstall = append(toolsToInstall, tool)
+		}
+	}
+
+	// Package download
+	if err := downloadPlatformAndTools(pm, platformRelease, toolsToInstall, downloadCB); err != nil {
+		return err
+	}
+
+	tx := pm.NewPlatformInstallTransaction(platformRelease, replaced)
+	if err := tx.Stage(); err != nil {
+		log.WithError(err).Error("Cannot install platform")
+		return fmt.Errorf("installing platform: %s", err)
+	}
+	return commitPlatformInstall(pm, tx, toolsToInstall, taskCB)
+}
+
+// commitPlatformInstall installs the tools and commits the staged platform
+// of the transaction. If anything fails the transaction is rolled back
+// and the installed release, if any, is left untouched.
+func commitPlatformInstall(pm *packagemanager.PackageManager, tx *packagemanager.PlatformInstallTransaction,
+	tools []*cores.ToolRelease, taskCB TaskProgressCB) error {
+	platformRelease := tx.Release()
+	log := pm.Log.WithField("platform", platformRelease)
+	rollback := func(err error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			log.WithError(rbErr).Error("Error rolling-back changes.")
+			return fmt.Errorf("rolling-back changes: %s (after: %s)", rbErr, err)
+		}
+		return err
+	}
+
+	for _, tool := range tools {
+		if err := installToolRelease(pm, tool, tx.InstallTool, taskCB); err != nil {
+			return rollback(err)
+		}
+	}
+
+	// Are we installing or upgrading?
+	installed := tx.Previous()
+	if installed == nil {
+		log.Info("Installing platform")
+		taskCB(&TaskProgress{Name: "Installing " + platformRelease.String()})
+	} else {
+		log.Info("Updating platform " + installed.String())
+		taskCB(&TaskProgress{Name: "Updating " + installed.String() + " with " + platformRelease.String()})
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.WithError(err).Error("Cannot install platform")
+		return rollback(fmt.Errorf("installing platform: %s", err))
+	}
+
+	log.Info("Platform installed")
+	taskCB(&TaskProgress{Message: platformRelease.String() + " installed", Completed: true})
+	return nil
+}
+
+// installToolRelease installs a tool with the install function, either
+// PackageManager.InstallTool or PlatformInstallTransaction.InstallTool.
+func installToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease,
+	install func(*cores.ToolRelease) error, taskCB TaskProgressCB) error {
+	log := pm.Log.WithField("Tool", toolRelease)
+
+	if toolRelease.IsInstalled() {
+		log.Warn("Tool already installed")
+		taskCB(&TaskProgress{Name: "Tool " + toolRelease.String() + " already installed", Completed: true})
+		return nil
+	}
+
+	log.Info("Installing tool")
+	taskCB(&TaskProgress{Name: "Installing " + toolRelease.String()})
+	if err := install(toolRelease); err != nil {
+		log.WithError(err).Warn("Cannot install tool")
+		return fmt.Errorf("installing tool %s: %s", toolRelease, err)
+	}
+
+	log.Info("Tool installed")
+	taskCB(&TaskProgress{Message: toolRelease.String() + " installed", Completed: true})
+	return nil
+}
@@ -0,0 +1,65 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// PlatformLintReq is the request for PlatformLint.
+type PlatformLintReq struct {
+	PlatformPath *paths.Path // The folder containing boards.txt and platform.txt.
+}
+
+// PlatformLintResult is the result of PlatformLint.
+type PlatformLintResult struct {
+	Platform *cores.PlatformRelease
+	Results  []*lint.Result // Sorted from the most severe.
+}
+
+// Failed returns true if a problem at least as severe as minSeverity has
+// been found.
+func (res *PlatformLintResult) Failed(minSeverity lint.Severity) bool {
+	return lintFailed(res.Results, minSeverity)
+}
+
+// PlatformLint checks the platform in req.PlatformPath for problems in
+// boards.txt and platform.txt, see PackageManager.LintPlatform. The tools
+// are checked against the package indexes loaded in pm, the platform
+// doesn't need to be installed.
+func PlatformLint(pm *packagemanager.PackageManager, req *PlatformLintReq) (*PlatformLintResult, error) {
+	if req.PlatformPath == nil {
+		return nil, &InvalidArgumentError{Message: "missing platform path"}
+	}
+	if !req.PlatformPath.Join("boards.txt").Exist() {
+		return nil, &NotFoundError{Message: fmt.Sprintf("boards.txt not found in %s", req.PlatformPath)}
+	}
+
+	logrus.WithField("path", req.PlatformPath).Info("Linting platform")
+	platform, results, err := pm.LintPlatform(req.PlatformPath)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "loading platform " + req.PlatformPath.String(), Cause: err}
+	}
+	return &PlatformLintResult{Platform: platform, Results: results}, nil
+}
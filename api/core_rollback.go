@@ -0,0 +1,78 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+)
+
+// PlatformRollbackReq is the request for PlatformRollback. If the platform
+// reference specifies a version it selects the installed release swapped
+// with the backup.
+type PlatformRollbackReq struct {
+	Platform *packagemanager.PlatformReference
+}
+
+// PlatformRollback restores the release of a platform replaced by the last
+// install or upgrade. The installed release is kept as backup in its place,
+// so a second rollback undoes the first. The tools required by the
+// restored release are downloaded and installed if missing. If more releases
+// are installed side by side the one pinned by the reference is replaced, or
+// the default one if no version is given.
+func PlatformRollback(pm *packagemanager.PackageManager, req *PlatformRollbackReq,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	ref := req.Platform
+	if ref == nil {
+		return &InvalidArgumentError{Message: "missing platform reference"}
+	}
+	platform := pm.FindPlatform(ref)
+	if platform == nil {
+		return &NotFoundError{Message: fmt.Sprintf("platform %s not found", ref)}
+	}
+	var replaced *cores.PlatformRelease
+	if ref.PlatformVersion != nil {
+		if replaced = pm.FindInstalledPlatformRelease(platform, ref.PlatformVersion); replaced == nil {
+			return &FailedPreconditionError{Message: fmt.Sprintf("platform %s is not installed", ref)}
+		}
+	}
+
+	tx, err := pm.NewPlatformRollbackTransaction(platform, replaced)
+	if err != nil {
+		return &FailedPreconditionError{Message: "cannot rollback " + ref.String(), Cause: err}
+	}
+	tools := []*cores.ToolRelease{}
+	requiredTools, err := pm.GetPackages().GetDepsOfPlatformRelease(tx.Release())
+	if err == nil {
+		for _, tool := range requiredTools {
+			if !tool.IsInstalled() {
+				tools = append(tools, tool)
+			}
+		}
+		err = downloadPlatformAndTools(pm, nil, tools, downloadCB)
+	}
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("rolling-back changes: %s (after: %s)", rbErr, err)
+		}
+		return err
+	}
+	return commitPlatformInstall(pm, tx, tools, taskCB)
+}
@@ -0,0 +1,125 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+)
+
+// PlatformSearchReq is the request for PlatformSearch.
+type PlatformSearchReq struct {
+	// Query is matched against the name and architecture of the platforms
+	// and the names of the boards they provide. A query in the form
+	// "VID:PID" searches the platforms providing a board with that USB ID.
+	Query string
+}
+
+// PlatformSearchResult is the result of PlatformSearch.
+type PlatformSearchResult struct {
+	// Platforms contains the latest release of each matching platform.
+	Platforms []*cores.PlatformRelease
+}
+
+// PlatformSearch searches the package indexes for platforms matching the
+// query (case insensitive).
+func PlatformSearch(pm *packagemanager.PackageManager, req *PlatformSearchReq) (*PlatformSearchResult, error) {
+	search := strings.ToLower(req.Query)
+	res := []*cores.PlatformRelease{}
+	if isUsb, _ := regexp.MatchString("[0-9a-f]{4}:[0-9a-f]{4}", search); isUsb {
+		vid, pid := search[:4], search[5:]
+		res = pm.FindPlatformReleaseProvidingBoardsWithVidPid(vid, pid)
+	} else {
+		match := func(line string) bool {
+			return strings.Contains(strings.ToLower(line), search)
+		}
+		for _, targetPackage := range pm.GetPackages().Packages {
+			for _, platform := range targetPackage.Platforms {
+				platformRelease := platform.GetLatestRelease()
+				if platformRelease == nil {
+					continue
+				}
+				if match(platform.Name) || match(platform.Architecture) {
+					res = append(res, platformRelease)
+					continue
+				}
+				for _, board := range platformRelease.BoardsManifest {
+					if match(board.Name) {
+						res = append(res, platformRelease)
+						break
+					}
+				}
+			}
+		}
+	}
+	return &PlatformSearchResult{Platforms: res}, nil
+}
+
+// PlatformListReq is the request for PlatformList.
+type PlatformListReq struct {
+	// UpdatableOnly selects only the platforms with a newer release
+	// available.
+	UpdatableOnly bool
+}
+
+// PlatformListResult is the result of PlatformList.
+type PlatformListResult struct {
+	Platforms []*InstalledPlatform
+}
+
+// InstalledPlatform is an installed platform release together with the
+// latest release available in the package index (if any).
+type InstalledPlatform struct {
+	Installed *cores.PlatformRelease
+	Latest    *cores.PlatformRelease
+}
+
+// PlatformList returns the installed platforms, one entry for every release
+// installed side by side. With req.UpdatableOnly only the default releases
+// (see PackageManager.GetInstalledPlatformRelease) are considered.
+func PlatformList(pm *packagemanager.PackageManager, req *PlatformListReq) (*PlatformListResult, error) {
+	res := &PlatformListResult{Platforms: []*InstalledPlatform{}}
+	for _, targetPackage := range pm.GetPackages().Packages {
+		for _, platform := range targetPackage.Platforms {
+			platformRelease := pm.GetInstalledPlatformRelease(platform)
+			if platformRelease == nil {
+				continue
+			}
+			latest := platform.GetLatestRelease()
+			releases := platform.GetAllInstalled()
+			if req.UpdatableOnly {
+				if latest == nil || !latest.Version.GreaterThan(platformRelease.Version) {
+					continue
+				}
+				releases = []*cores.PlatformRelease{platformRelease}
+			}
+			sort.Slice(releases, func(i, j int) bool { return releases[i].Version.LessThan(releases[j].Version) })
+			for _, release := range releases {
+				res.Platforms = append(res.Platforms, &InstalledPlatform{
+					Installed: release,
+					Latest:    latest,
+				})
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,108 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+)
+
+// PlatformUninstallReq is the request for PlatformUninstall. If the
+// version of the platform is not specified the installed one is removed.
+type PlatformUninstallReq struct {
+	Platform *packagemanager.PlatformReference
+}
+
+// PlatformUninstall removes a platform and the tools that are no more
+// required by other platforms.
+func PlatformUninstall(pm *packagemanager.PackageManager, req *PlatformUninstallReq, taskCB TaskProgressCB) error {
+	ref := req.Platform
+	if ref == nil {
+		return &InvalidArgumentError{Message: "missing platform reference"}
+	}
+
+	// If no version is specified consider the installed
+	if ref.PlatformVersion == nil {
+		platform := pm.FindPlatform(ref)
+		if platform == nil {
+			return &NotFoundError{Message: fmt.Sprintf("platform %s not found", ref)}
+		}
+		platformRelease := pm.GetInstalledPlatformRelease(platform)
+		if platformRelease == nil {
+			return &FailedPreconditionError{Message: fmt.Sprintf("platform %s is not installed", ref)}
+		}
+		ref = &packagemanager.PlatformReference{
+			Package:              ref.Package,
+			PlatformArchitecture: ref.PlatformArchitecture,
+			PlatformVersion:      platformRelease.Version,
+		}
+	}
+
+	platform, tools, err := findPlatformReleaseDependencies(pm, ref)
+	if err != nil {
+		return err
+	}
+
+	if err := uninstallPlatformRelease(pm, platform, taskCB); err != nil {
+		return err
+	}
+
+	for _, tool := range tools {
+		if !pm.IsToolRequired(tool) {
+			taskCB(&TaskProgress{Message: "Tool " + tool.String() + " is no more required"})
+			if err := uninstallToolRelease(pm, tool, taskCB); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func uninstallPlatformRelease(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease, taskCB TaskProgressCB) error {
+	log := pm.Log.WithField("platform", platformRelease)
+
+	log.Info("Uninstalling platform")
+	taskCB(&TaskProgress{Name: "Uninstalling " + platformRelease.String()})
+
+	if err := pm.UninstallPlatform(platformRelease); err != nil {
+		log.WithError(err).Error("Error uninstalling")
+		return fmt.Errorf("uninstalling %s: %s", platformRelease, err)
+	}
+
+	log.Info("Platform uninstalled")
+	taskCB(&TaskProgress{Message: platformRelease.String() + " uninstalled", Completed: true})
+	return nil
+}
+
+func uninstallToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease, taskCB TaskProgressCB) error {
+	log := pm.Log.WithField("Tool", toolRelease)
+
+	log.Info("Uninstalling tool")
+	taskCB(&TaskProgress{Name: "Uninstalling " + toolRelease.String()})
+
+	if err := pm.UninstallTool(toolRelease); err != nil {
+		log.WithError(err).Error("Error uninstalling")
+		return fmt.Errorf("uninstalling %s: %s", toolRelease, err)
+	}
+
+	log.Info("Tool uninstalled")
+	taskCB(&TaskProgress{Message: toolRelease.String() + " uninstalled", Completed: true})
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+)
+
+// PlatformUpgradeReq is the request for PlatformUpgrade. If the platform
+// reference specifies a version it selects the installed release to
+// upgrade.
+type PlatformUpgradeReq struct {
+	Platform *packagemanager.PlatformReference
+}
+
+// PlatformUpgrade upgrades an installed platform to the latest version. If
+// more releases are installed side by side the one pinned by the reference
+// is replaced, or the default one if no version is given (see
+// PackageManager.GetInstalledPlatformRelease).
+func PlatformUpgrade(pm *packagemanager.PackageManager, req *PlatformUpgradeReq,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	ref := req.Platform
+	if ref == nil {
+		return &InvalidArgumentError{Message: "missing platform reference"}
+	}
+
+	// Search the latest version for the specified platform
+	platform := pm.FindPlatform(ref)
+	if platform == nil {
+		return &NotFoundError{Message: fmt.Sprintf("platform %s not found", ref)}
+	}
+	installed := pm.FindInstalledPlatformRelease(platform, ref.PlatformVersion)
+	if installed == nil {
+		return &FailedPreconditionError{Message: fmt.Sprintf("platform %s is not installed", ref)}
+	}
+	latest := platform.GetLatestRelease()
+	if !latest.Version.GreaterThan(installed.Version) {
+		taskCB(&TaskProgress{Message: "Platform " + ref.String() + " is already at the latest version", Completed: true})
+		return nil
+	}
+
+	platformRelease, tools, err := findPlatformReleaseDependencies(pm, &packagemanager.PlatformReference{
+		Package:              ref.Package,
+		PlatformArchitecture: ref.PlatformArchitecture,
+		PlatformVersion:      latest.Version,
+	})
+	if err != nil {
+		return err
+	}
+	return installPlatform(pm, platformRelease, tools, installed, downloadCB, taskCB)
+}
@@ -15,12 +15,15 @@
  * a commercial license, send an email to license@arduino.cc.
  */
 
-package compile
+package api
 
 import (
+	"fmt"
+
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/configs"
 	"go.bug.st/relaxed-semver"
 )
 
@@ -87,3 +90,35 @@ var ctagsVersion = semver.ParseRelaxed("5.8-arduino11")
 func getBuiltinCtagsTool(pm *packagemanager.PackageManager) (*cores.ToolRelease, error) {
 	return pm.Package("builtin").Tool("ctags").Release(ctagsVersion).Get()
 }
+
+// EnsureCtagsInstalled downloads and installs the ctags tool, required by
+// the builder, if not already installed. After an installation the
+// hardware is reloaded in pm.
+func EnsureCtagsInstalled(pm *packagemanager.PackageManager, config *configs.Configuration,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	loadBuiltinCtagsMetadata(pm)
+	ctags, err := getBuiltinCtagsTool(pm)
+	if err != nil {
+		return fmt.Errorf("getting ctags tool: %s", err)
+	}
+	if ctags.IsInstalled() {
+		return nil
+	}
+
+	taskCB(&TaskProgress{Message: "Downloading and installing missing tool: " + ctags.String()})
+	if err := downloadTool(pm, ctags, downloadCB); err != nil {
+		return err
+	}
+	if err := installToolRelease(pm, ctags, pm.InstallTool, taskCB); err != nil {
+		return err
+	}
+
+	if err := pm.LoadHardware(config); err != nil {
+		return &ConfigurationError{Message: "loading hardware packages", Cause: err}
+	}
+	ctags, err = getBuiltinCtagsTool(pm)
+	if err != nil || !ctags.IsInstalled() {
+		return &ConfigurationError{Message: "missing ctags tool"}
+	}
+	return nil
+}
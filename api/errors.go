@@ -0,0 +1,99 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import "strings"
+
+// The errors returned by this package are of one of the types below, so
+// the caller can tell apart, for example, a bad request from a network
+// failure. Errors of any other type are generic failures.
+
+// InvalidArgumentError is returned when a request contains a missing or
+// malformed argument.
+type InvalidArgumentError struct {
+	Message string
+	Cause   error
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+// NotFoundError is returned when the requested platform, tool, library or
+// board can't be found.
+type NotFoundError struct {
+	Message string
+	Cause   error
+}
+
+func (e *NotFoundError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+// FailedPreconditionError is returned when the operation can't be done
+// in the current state of the system, for example when uninstalling a
+// platform that is not installed.
+type FailedPreconditionError struct {
+	Message string
+	Cause   error
+}
+
+func (e *FailedPreconditionError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+// NetworkError is returned when a download fails.
+type NetworkError struct {
+	Message string
+	Cause   error
+}
+
+func (e *NetworkError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+// ConfigurationError is returned when the configuration, the indexes or
+// the installed hardware can't be loaded or are invalid.
+type ConfigurationError struct {
+	Message string
+	Cause   error
+}
+
+func (e *ConfigurationError) Error() string {
+	return composeErrorMsg(e.Message, e.Cause)
+}
+
+// MissingIncludeError is returned when a compilation fails because some
+// headers included by the sketch or by its libraries can't be found. See
+// LibrarySearch to find the libraries providing them.
+type MissingIncludeError struct {
+	Headers      []string
+	Architecture string // the architecture of the board, to filter the libraries
+	Cause        error
+}
+
+func (e *MissingIncludeError) Error() string {
+	return composeErrorMsg("compilation failed: missing "+strings.Join(e.Headers, ", "), e.Cause)
+}
+
+func composeErrorMsg(msg string, cause error) string {
+	if cause == nil {
+		return msg
+	}
+	return msg + ": " + cause.Error()
+}
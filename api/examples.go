@@ -0,0 +1,248 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// Example is an example sketch shipped with a library or a platform.
+type Example struct {
+	// Source is the name of the library or the ID of the platform
+	// (PACKAGER:ARCH) containing the example.
+	Source string
+	// Name is the path of the example in the examples folder, e.g.
+	// "01.Basics/Blink".
+	Name string
+	Path *paths.Path
+}
+
+// ID returns the identifier of the example used by SketchNew.
+func (example *Example) ID() string {
+	return example.Source + "/" + example.Name
+}
+
+// ExamplesResult is the result of LibraryExamples and PlatformExamples.
+type ExamplesResult struct {
+	Examples []*Example // Sorted by ID.
+}
+
+// LibraryExamplesReq is the request for LibraryExamples.
+type LibraryExamplesReq struct {
+	Name string // The library name, if empty the examples of all the libraries are returned.
+}
+
+// LibraryExamples returns the examples of the installed libraries. If a
+// library is installed in more than one location the one with the highest
+// priority is used.
+func LibraryExamples(lm *librariesmanager.LibrariesManager, req *LibraryExamplesReq) (*ExamplesResult, error) {
+	res := &ExamplesResult{Examples: []*Example{}}
+	found := false
+	for _, alternatives := range lm.Libraries {
+		var lib *libraries.Library
+		for _, alternative := range alternatives.Alternatives {
+			if lib == nil || alternative.Location > lib.Location {
+				lib = alternative
+			}
+		}
+		if lib == nil {
+			continue
+		}
+		if req.Name != "" && req.Name != lib.Name && req.Name != lib.RealName {
+			continue
+		}
+		found = true
+		examples, err := findExamples(libraryIndexName(lib), lib.InstallDir.Join("examples"))
+		if err != nil {
+			return nil, err
+		}
+		res.Examples = append(res.Examples, examples...)
+	}
+	if req.Name != "" && !found {
+		return nil, &NotFoundError{Message: fmt.Sprintf("library %s is not installed", req.Name)}
+	}
+	sortExamples(res.Examples)
+	return res, nil
+}
+
+// PlatformExamplesReq is the request for PlatformExamples.
+type PlatformExamplesReq struct {
+	// Platform selects the platform, if nil the examples of all the
+	// installed platforms are returned. The version is ignored.
+	Platform *packagemanager.PlatformReference
+}
+
+// PlatformExamples returns the examples shipped with the installed
+// platforms, the default release of each platform is used.
+func PlatformExamples(pm *packagemanager.PackageManager, req *PlatformExamplesReq) (*ExamplesResult, error) {
+	res := &ExamplesResult{Examples: []*Example{}}
+	found := false
+	for _, targetPackage := range pm.GetPackages().Packages {
+		for _, platform := range targetPackage.Platforms {
+			if req.Platform != nil &&
+				(req.Platform.Package != targetPackage.Name || req.Platform.PlatformArchitecture != platform.Architecture) {
+				continue
+			}
+			release := pm.GetInstalledPlatformRelease(platform)
+			if release == nil {
+				continue
+			}
+			found = true
+			examples, err := findExamples(targetPackage.Name+":"+platform.Architecture, release.InstallDir.Join("examples"))
+			if err != nil {
+				return nil, err
+			}
+			res.Examples = append(res.Examples, examples...)
+		}
+	}
+	if req.Platform != nil && !found {
+		return nil, &NotFoundError{
+			Message: fmt.Sprintf("platform %s:%s is not installed", req.Platform.Package, req.Platform.PlatformArchitecture),
+		}
+	}
+	sortExamples(res.Examples)
+	return res, nil
+}
+
+func findExamples(source string, examplesDir *paths.Path) ([]*Example, error) {
+	sketchDirs, err := sketches.FindSketches(examplesDir)
+	if err != nil {
+		return nil, fmt.Errorf("searching examples of %s: %s", source, err)
+	}
+	examples := []*Example{}
+	for _, sketchDir := range sketchDirs {
+		rel, err := examplesDir.RelTo(sketchDir)
+		if err != nil {
+			return nil, fmt.Errorf("searching examples of %s: %s", source, err)
+		}
+		examples = append(examples, &Example{
+			Source: source,
+			Name:   strings.Replace(rel.String(), "\\", "/", -1),
+			Path:   sketchDir,
+		})
+	}
+	return examples, nil
+}
+
+func sortExamples(examples []*Example) {
+	sort.Slice(examples, func(i, j int) bool {
+		return examples[i].ID() < examples[j].ID()
+	})
+}
+
+// SketchNewReq is the request for SketchNew.
+type SketchNewReq struct {
+	Name string
+	// From is the template for the new sketch: the path of a sketch folder,
+	// the name of a template in the templates folder or the ID of an example
+	// (e.g. "Servo/Sweep" or "arduino:avr/01.Basics/Blink"). If empty an
+	// empty sketch is created.
+	From string
+}
+
+// SketchNewResult is the result of SketchNew.
+type SketchNewResult struct {
+	SketchPath *paths.Path
+}
+
+var emptySketch = []byte(`
+void setup() {
+}
+
+void loop() {
+}
+`)
+
+// SketchNew creates a new sketch in the sketchbook. A sketch created from a
+// template is a copy of it with the main file renamed, the sketch folder
+// must not exist. pm and lm are used to search the examples and may be nil.
+func SketchNew(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	req *SketchNewReq) (*SketchNewResult, error) {
+	if req.Name == "" {
+		return nil, &InvalidArgumentError{Message: "missing sketch name"}
+	}
+	sketchDir := config.SketchbookDir.Join(req.Name)
+	res := &SketchNewResult{SketchPath: sketchDir}
+
+	if req.From == "" {
+		if err := sketchDir.MkdirAll(); err != nil {
+			return nil, fmt.Errorf("creating sketch directory: %s", err)
+		}
+		if err := sketchDir.Join(req.Name + ".ino").WriteFile(emptySketch); err != nil {
+			return nil, fmt.Errorf("creating sketch: %s", err)
+		}
+		return res, nil
+	}
+
+	template, err := findSketchTemplate(pm, lm, config, req.From)
+	if err != nil {
+		return nil, err
+	}
+	if sketchDir.Exist() {
+		return nil, &FailedPreconditionError{Message: fmt.Sprintf("sketch %s already exists", sketchDir)}
+	}
+	logrus.WithField("template", template).Info("Creating sketch from template")
+	if err := sketches.CopySketch(template, sketchDir); err != nil {
+		return nil, fmt.Errorf("creating sketch: %s", err)
+	}
+	return res, nil
+}
+
+// findSketchTemplate returns the folder of the sketch template: a sketch
+// folder, a template in the templates folder or an example, in this order.
+func findSketchTemplate(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	from string) (*paths.Path, error) {
+	if dir := paths.New(from); dir.IsDir() {
+		return dir, nil
+	}
+	if dir := config.TemplatesDir().Join(from); dir.IsDir() {
+		return dir, nil
+	}
+
+	candidates := []*Example{}
+	if lm != nil {
+		res, err := LibraryExamples(lm, &LibraryExamplesReq{})
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, res.Examples...)
+	}
+	if pm != nil {
+		res, err := PlatformExamples(pm, &PlatformExamplesReq{})
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, res.Examples...)
+	}
+	for _, example := range candidates {
+		if example.ID() == from {
+			return example.Path, nil
+		}
+	}
+	return nil, &NotFoundError{Message: fmt.Sprintf("template or example %s not found", from)}
+}
@@ -0,0 +1,78 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestSketchNewFromExamples(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "examples_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	config := &configs.Configuration{SketchbookDir: tmp.Join("sketchbook")}
+
+	pm := packagemanager.NewPackageManager(tmp, tmp.Join("packages"), tmp.Join("staging"), tmp)
+	platform := pm.GetPackages().GetOrCreatePackage("test").GetOrCreatePlatform("avr")
+	release, err := platform.GetOrCreateRelease(semver.MustParse("1.0.0"))
+	require.NoError(t, err)
+	release.InstallDir = tmp.Join("packages", "test", "hardware", "avr", "1.0.0")
+	blink := release.InstallDir.Join("examples", "01.Basics", "Blink")
+	require.NoError(t, blink.MkdirAll())
+	require.NoError(t, blink.Join("Blink.ino").WriteFile([]byte("// Blink")))
+
+	examples, err := PlatformExamples(pm, &PlatformExamplesReq{})
+	require.NoError(t, err)
+	require.Len(t, examples.Examples, 1)
+	require.Equal(t, "test:avr/01.Basics/Blink", examples.Examples[0].ID())
+	require.Equal(t, blink.String(), examples.Examples[0].Path.String())
+	_, err = PlatformExamples(pm, &PlatformExamplesReq{
+		Platform: &packagemanager.PlatformReference{Package: "test", PlatformArchitecture: "samd"},
+	})
+	require.IsType(t, &NotFoundError{}, err)
+
+	res, err := SketchNew(pm, nil, config, &SketchNewReq{Name: "MyBlink", From: "test:avr/01.Basics/Blink"})
+	require.NoError(t, err)
+	data, err := res.SketchPath.Join("MyBlink.ino").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "// Blink", string(data))
+	_, err = SketchNew(pm, nil, config, &SketchNewReq{Name: "MyBlink", From: "test:avr/01.Basics/Blink"})
+	require.IsType(t, &FailedPreconditionError{}, err)
+
+	template := config.TemplatesDir().Join("Starter")
+	require.NoError(t, template.MkdirAll())
+	require.NoError(t, template.Join("main.ino").WriteFile([]byte("// Starter")))
+	res, err = SketchNew(pm, nil, config, &SketchNewReq{Name: "FromTemplate", From: "Starter"})
+	require.NoError(t, err)
+	require.True(t, res.SketchPath.Join("FromTemplate.ino").Exist())
+
+	_, err = SketchNew(pm, nil, config, &SketchNewReq{Name: "Missing", From: "test:avr/Missing"})
+	require.IsType(t, &NotFoundError{}, err)
+
+	res, err = SketchNew(nil, nil, config, &SketchNewReq{Name: "Empty"})
+	require.NoError(t, err)
+	data, err = res.SketchPath.Join("Empty.ino").ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(data), "void loop()")
+}
@@ -0,0 +1,140 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/arduino/arduino-cli/arduino/security"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/crypto/openpgp"
+)
+
+// maxSignatureSize is the maximum size of the detached signature of an
+// index, a bigger file is certainly not a signature.
+const maxSignatureSize = 64 * 1024
+
+// indexSignatureURL returns the URL of the detached signature of the
+// index at the given URL.
+func indexSignatureURL(URL *url.URL) *url.URL {
+	sigURL := *URL
+	sigURL.Path += ".sig"
+	return &sigURL
+}
+
+// indexSignaturePath returns the path where the detached signature of the
+// given index is saved.
+func indexSignaturePath(index *paths.Path) *paths.Path {
+	return paths.New(index.String() + ".sig")
+}
+
+// downloadIndexSignature downloads the detached signature of the index at
+// the given URL into target. It returns false if the index is not signed.
+func downloadIndexSignature(URL *url.URL, target *paths.Path) (bool, error) {
+	sigURL := indexSignatureURL(URL)
+	logrus.WithField("url", sigURL).Info("Downloading index signature")
+	resp, err := http.Get(sigURL.String())
+	if err != nil {
+		return false, &NetworkError{Message: "downloading signature " + sigURL.String(), Cause: err}
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 && resp.StatusCode < 500 {
+		// Some servers answer 403 instead of 404 for missing files
+		logrus.WithField("url", sigURL).Infof("Index signature not available: %s", resp.Status)
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, &NetworkError{Message: fmt.Sprintf("downloading signature %s: %s", sigURL, resp.Status)}
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSignatureSize))
+	if err != nil {
+		return false, &NetworkError{Message: "downloading signature " + sigURL.String(), Cause: err}
+	}
+	if err := target.WriteFile(data); err != nil {
+		return false, fmt.Errorf("saving signature: %s", err)
+	}
+	return true, nil
+}
+
+// indexKeyring returns the keys trusted to sign the index at the given
+// URL: the builtin keyring plus the keys configured for the URL.
+func indexKeyring(config *configs.Configuration, URL *url.URL) (openpgp.EntityList, error) {
+	keyring, err := security.BuiltinKeyring()
+	if err != nil {
+		return nil, err
+	}
+	if keyPath := config.TrustedKeyFor(URL); keyPath != nil {
+		keys, err := security.LoadKeyring(keyPath)
+		if err != nil {
+			return nil, &ConfigurationError{Message: "loading trusted keys for " + URL.String(), Cause: err}
+		}
+		keyring = append(keyring, keys...)
+	}
+	return keyring, nil
+}
+
+// verifyIndexSignature checks the detached signature of the index
+// downloaded from the given URL.
+func verifyIndexSignature(config *configs.Configuration, URL *url.URL, index, signature *paths.Path) error {
+	if signature.NotExist() {
+		return fmt.Errorf("index %s is not signed", URL)
+	}
+	keyring, err := indexKeyring(config, URL)
+	if err != nil {
+		return err
+	}
+	if len(keyring) == 0 {
+		return fmt.Errorf("no trusted keys to verify the signature of index %s", URL)
+	}
+	signer, err := security.VerifyDetachedSignature(index, signature, keyring)
+	if err != nil {
+		return fmt.Errorf("invalid signature of index %s: %s", URL, err)
+	}
+	for name := range signer.Identities {
+		logrus.WithField("url", URL).Infof("Index signed by %s", name)
+	}
+	return nil
+}
+
+// checkIndexSignature verifies the signature of an index and applies the
+// configured policy: if signatures are required a failure is returned as a
+// FailedPreconditionError, otherwise the index is accepted and the failure
+// is reported to warnCB, if not nil.
+func checkIndexSignature(config *configs.Configuration, URL *url.URL, index, signature *paths.Path, warnCB func(string)) error {
+	err := verifyIndexSignature(config, URL, index, signature)
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*ConfigurationError); ok {
+		return err
+	}
+	if config.IndexSignatureRequired() {
+		return &FailedPreconditionError{Message: "verifying index signature", Cause: err}
+	}
+	logrus.WithError(err).Warn("Using index without a valid signature")
+	if warnCB != nil {
+		warnCB("Warning: " + err.Error())
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"bytes"
+	"net/url"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadPackageIndexesSignature(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "index_signature_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	// A snapshot of the official index with the signature published by Arduino
+	testdata := paths.New("..", "arduino", "security", "testdata")
+	require.NoError(t, testdata.Join("package_index.json").CopyTo(tmp.Join("package_index.json")))
+	require.NoError(t, testdata.Join("package_index.json.sig").CopyTo(tmp.Join("package_index.json.sig")))
+	URL, err := url.Parse("https://downloads.arduino.cc/packages/package_index.json")
+	require.NoError(t, err)
+	config := &configs.Configuration{
+		DataDir:                    tmp,
+		BoardManagerAdditionalUrls: []*url.URL{URL},
+		IndexSignaturePolicy:       configs.IndexSignatureStrict,
+	}
+
+	pm := NewPackageManager(config)
+	require.NoError(t, LoadPackageIndexes(pm, config), "signed with the builtin keyring")
+	require.NotNil(t, pm.GetPackages().Packages["arduino-beta"])
+
+	data, err := tmp.Join("package_index.json").ReadFile()
+	require.NoError(t, err)
+	tampered := bytes.Replace(data, []byte(`"arduino-beta"`), []byte(`"arduino-evil"`), 1)
+	require.NoError(t, tmp.Join("package_index.json").WriteFile(tampered))
+	pm = NewPackageManager(config)
+	require.IsType(t, &FailedPreconditionError{}, LoadPackageIndexes(pm, config))
+	require.Empty(t, pm.GetPackages().Packages, "the tampered index is not loaded")
+
+	config.IndexSignaturePolicy = configs.IndexSignatureWarn
+	pm = NewPackageManager(config)
+	require.NoError(t, LoadPackageIndexes(pm, config))
+	require.NotNil(t, pm.GetPackages().Packages["arduino-evil"])
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateLibrariesIndex downloads the latest version of the libraries
+// index. The new index is not loaded in lm. The detached signature of the
+// index is verified as in UpdateIndex.
+func UpdateLibrariesIndex(lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	logrus.Info("Updating libraries index")
+	URL := config.LibrariesIndexURL
+	if resources.LocalFilePath(URL) != nil {
+		return checkLocalIndex(config, URL, lm.IndexFile, taskCB, func(index *paths.Path) error {
+			_, err := librariesindex.LoadIndex(index)
+			return err
+		})
+	}
+
+	tmpDir, err := paths.MkTempDir("", "index")
+	if err != nil {
+		return fmt.Errorf("creating temp dir for download: %s", err)
+	}
+	defer tmpDir.RemoveAll()
+
+	tmp := tmpDir.Join(lm.IndexFile.Base())
+	if err := downloadIndex(URL, tmp, downloadCB); err != nil {
+		return err
+	}
+	tmpSig := indexSignaturePath(tmp)
+	signed, err := downloadIndexSignature(URL, tmpSig)
+	if err != nil {
+		return err
+	}
+	warnCB := func(msg string) { taskCB(&TaskProgress{Message: msg, Completed: true}) }
+	if err := checkIndexSignature(config, URL, tmp, tmpSig, warnCB); err != nil {
+		return err
+	}
+
+	if _, err := librariesindex.LoadIndex(tmp); err != nil {
+		return fmt.Errorf("invalid libraries index in %s: %s", URL, err)
+	}
+
+	if err := lm.IndexFile.Parent().MkdirAll(); err != nil {
+		return fmt.Errorf("creating data directory %s: %s", lm.IndexFile.Parent(), err)
+	}
+	return saveIndex(URL, tmp, lm.IndexFile, signed)
+}
+
+// LoadLibrariesIndex loads the libraries index in lm, after checking its
+// signature as configured in config.IndexSignaturePolicy.
+func LoadLibrariesIndex(lm *librariesmanager.LibrariesManager, config *configs.Configuration) error {
+	if lm.IndexFile.Exist() {
+		URL := config.LibrariesIndexURL
+		if err := checkIndexSignature(config, URL, lm.IndexFile, indexSignaturePath(lm.IndexFile), nil); err != nil {
+			return err
+		}
+	}
+	return lm.LoadIndex()
+}
@@ -0,0 +1,299 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// LibraryDownloadReq is the request for LibraryDownload. If the version
+// of the library is not specified the latest is downloaded.
+type LibraryDownloadReq struct {
+	Library *librariesindex.Reference
+}
+
+// LibraryDownload downloads a library without installing it.
+func LibraryDownload(lm *librariesmanager.LibrariesManager, req *LibraryDownloadReq, downloadCB DownloadProgressCB) error {
+	libRelease, err := findLibraryRelease(lm, req.Library)
+	if err != nil {
+		return err
+	}
+	return downloadLibrary(lm, libRelease, downloadCB)
+}
+
+func findLibraryRelease(lm *librariesmanager.LibrariesManager, ref *librariesindex.Reference) (*librariesindex.Release, error) {
+	if ref == nil {
+		return nil, &InvalidArgumentError{Message: "missing library reference"}
+	}
+	libRelease := lm.Index.FindRelease(ref)
+	if libRelease == nil {
+		return nil, &NotFoundError{Message: fmt.Sprintf("library %s not found", ref)}
+	}
+	return libRelease, nil
+}
+
+func downloadLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, downloadCB DownloadProgressCB) error {
+	return downloadLibraries(lm, []*librariesindex.Release{libRelease}, downloadCB)
+}
+
+// downloadLibraries downloads the libraries in parallel.
+func downloadLibraries(lm *librariesmanager.LibrariesManager, libReleases []*librariesindex.Release, downloadCB DownloadProgressCB) error {
+	items := []*downloadItem{}
+	for _, libRelease := range libReleases {
+		logrus.WithField("library", libRelease).Info("Downloading library")
+		items = append(items, &downloadItem{label: libRelease.String(), resource: libRelease.Resource})
+	}
+	return downloadAll(lm.NewDownloadScheduler(), items, downloadCB)
+}
+
+// LibraryInstallReq is the request for LibraryInstall and
+// LibraryInstallPlan. If the version of a library is not specified the
+// latest is installed.
+type LibraryInstallReq struct {
+	Libraries []*librariesindex.Reference
+	NoDeps    bool // Install only the requested libraries, without their dependencies.
+}
+
+// LibraryInstallPlanResult is the result of LibraryInstallPlan.
+type LibraryInstallPlanResult struct {
+	// Items are sorted so that every library comes after its dependencies.
+	Items []*LibraryInstallPlanItem
+}
+
+// LibraryInstallPlanItem is a library release selected for installation.
+type LibraryInstallPlanItem struct {
+	Release *librariesindex.Release
+	// Installed is the library already installed in the sketchbook that
+	// will be replaced by Release, nil if the library is not installed.
+	Installed *libraries.Library
+	// RequiredBy are the releases depending on this one, it's empty if the
+	// library has been requested.
+	RequiredBy []*librariesindex.Release
+}
+
+// AlreadyInstalled returns true if the release selected is already
+// installed in the sketchbook.
+func (item *LibraryInstallPlanItem) AlreadyInstalled() bool {
+	// the version of a library installed by hand may be invalid
+	return item.Installed != nil && item.Installed.Version != nil && item.Installed.Version.Equal(item.Release.Version)
+}
+
+// LibraryInstallPlan computes the libraries to be installed to satisfy the
+// request, including all the dependencies unless req.NoDeps is set, without
+// installing them.
+func LibraryInstallPlan(lm *librariesmanager.LibrariesManager, req *LibraryInstallReq) (*LibraryInstallPlanResult, error) {
+	releases := []*librariesindex.Release{}
+	for _, ref := range req.Libraries {
+		release, err := findLibraryRelease(lm, ref)
+		if err != nil {
+			return nil, err
+		}
+		releases = append(releases, release)
+	}
+
+	installed := map[string]*libraries.Library{}
+	installedVersions := map[string]*semver.Version{}
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Location == libraries.Sketchbook {
+				installed[libraryIndexName(lib)] = lib
+				if lib.Version != nil {
+					installedVersions[libraryIndexName(lib)] = lib.Version
+				}
+			}
+		}
+	}
+
+	if !req.NoDeps {
+		resolved, err := lm.Index.ResolveDependencies(req.Libraries, installedVersions)
+		if err != nil {
+			return nil, &FailedPreconditionError{Message: "resolving library dependencies", Cause: err}
+		}
+		releases = resolved
+	}
+
+	res := &LibraryInstallPlanResult{Items: []*LibraryInstallPlanItem{}}
+	items := map[string]*LibraryInstallPlanItem{}
+	for _, release := range releases {
+		item := &LibraryInstallPlanItem{
+			Release:    release,
+			Installed:  installed[release.Library.Name],
+			RequiredBy: []*librariesindex.Release{},
+		}
+		items[release.Library.Name] = item
+		res.Items = append(res.Items, item)
+	}
+	if !req.NoDeps {
+		for _, release := range releases {
+			for _, dep := range release.Dependencies {
+				if item, ok := items[dep.Name]; ok {
+					item.RequiredBy = append(item.RequiredBy, release)
+				}
+			}
+		}
+	}
+	return res, nil
+}
+
+// LibraryInstall downloads and installs the requested libraries in the
+// sketchbook together with their dependencies, see LibraryInstallPlan. If
+// another version of a library is installed it is replaced. It fails if a
+// requested library is already installed, dependencies already installed
+// are skipped.
+func LibraryInstall(lm *librariesmanager.LibrariesManager, req *LibraryInstallReq,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	plan, err := LibraryInstallPlan(lm, req)
+	if err != nil {
+		return err
+	}
+
+	toInstall := []*librariesindex.Release{}
+	for _, item := range plan.Items {
+		if !item.AlreadyInstalled() {
+			toInstall = append(toInstall, item.Release)
+			continue
+		}
+		if len(item.RequiredBy) == 0 {
+			return &FailedPreconditionError{Message: item.Release.String() + " is already installed"}
+		}
+		taskCB(&TaskProgress{Name: item.Release.String() + " already installed", Completed: true})
+	}
+
+	if err := downloadLibraries(lm, toInstall, downloadCB); err != nil {
+		return err
+	}
+	for _, libRelease := range toInstall {
+		if err := installLibrary(lm, libRelease, taskCB); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LibraryInstallGitReq is the request for LibraryInstallGit.
+type LibraryInstallGitReq struct {
+	// URL is the URL of the repository, optionally followed by #REF to
+	// install a branch, tag or commit other than the default branch.
+	URL string
+}
+
+// LibraryInstallGit clones a git repository and installs the library it
+// contains in the sketchbook, replacing an installed release of the
+// library, even of the same version. The dependencies of the library are
+// not installed. The repository URL is recorded in the installed library,
+// see libraries.Library.InstalledFrom.
+func LibraryInstallGit(lm *librariesmanager.LibrariesManager, req *LibraryInstallGitReq, taskCB TaskProgressCB) (*libraries.Library, error) {
+	url, ref := req.URL, ""
+	if i := strings.LastIndex(url, "#"); i != -1 {
+		url, ref = url[:i], url[i+1:]
+	}
+	if url == "" {
+		return nil, &InvalidArgumentError{Message: "missing repository URL"}
+	}
+
+	logrus.WithField("url", url).WithField("ref", ref).Info("Installing library from git")
+	taskCB(&TaskProgress{Name: "Installing library from " + req.URL})
+	lib, err := lm.InstallGitLib(url, ref)
+	if err != nil {
+		return nil, &FailedPreconditionError{Message: "installing library from " + req.URL, Cause: err}
+	}
+	taskCB(&TaskProgress{Message: "Installed " + lib.String() + " from " + lib.InstalledFrom.String(), Completed: true})
+	return lib, nil
+}
+
+// LibraryInstallZipReq is the request for LibraryInstallZip.
+type LibraryInstallZipReq struct {
+	ZipPath *paths.Path
+}
+
+// LibraryInstallZip installs in the sketchbook the library contained in a
+// zip archive, replacing an installed release of the library, even of the
+// same version. The dependencies of the library are not installed. The
+// path of the archive is recorded in the installed library, see
+// libraries.Library.InstalledFrom.
+func LibraryInstallZip(lm *librariesmanager.LibrariesManager, req *LibraryInstallZipReq, taskCB TaskProgressCB) (*libraries.Library, error) {
+	if req.ZipPath == nil {
+		return nil, &InvalidArgumentError{Message: "missing zip file"}
+	}
+	if !req.ZipPath.Exist() {
+		return nil, &NotFoundError{Message: fmt.Sprintf("zip file %s not found", req.ZipPath)}
+	}
+
+	logrus.WithField("archive", req.ZipPath).Info("Installing library from zip")
+	taskCB(&TaskProgress{Name: "Installing library from " + req.ZipPath.String()})
+	lib, err := lm.InstallZipLib(req.ZipPath)
+	if err != nil {
+		return nil, &FailedPreconditionError{Message: "installing library from " + req.ZipPath.String(), Cause: err}
+	}
+	taskCB(&TaskProgress{Message: "Installed " + lib.String() + " from " + lib.InstalledFrom.String(), Completed: true})
+	return lib, nil
+}
+
+// libraryIndexName returns the name of an installed library as used in the
+// libraries index: the name declared in library.properties, or the folder
+// name for legacy libraries.
+func libraryIndexName(lib *libraries.Library) string {
+	if lib.RealName != "" {
+		return lib.RealName
+	}
+	return lib.Name
+}
+
+func installLibrary(lm *librariesmanager.LibrariesManager, libRelease *librariesindex.Release, taskCB TaskProgressCB) error {
+	logrus.WithField("library", libRelease).Info("Installing library")
+	taskCB(&TaskProgress{Name: "Installing " + libRelease.String()})
+
+	if _, err := lm.Install(libRelease); err != nil {
+		logrus.WithError(err).Warn("Error installing library ", libRelease)
+		return fmt.Errorf("installing library %s: %s", libRelease, err)
+	}
+
+	taskCB(&TaskProgress{Message: "Installed " + libRelease.String(), Completed: true})
+	return nil
+}
+
+// LibraryUpgradeAll upgrades all the installed libraries to the latest
+// available version.
+func LibraryUpgradeAll(lm *librariesmanager.LibrariesManager, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	libReleases := []*librariesindex.Release{}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives.Alternatives {
+			if available := lm.Index.FindLibraryUpdate(lib); available != nil {
+				libReleases = append(libReleases, available)
+			}
+		}
+	}
+
+	if err := downloadLibraries(lm, libReleases, downloadCB); err != nil {
+		return err
+	}
+	for _, libRelease := range libReleases {
+		if err := installLibrary(lm, libRelease, taskCB); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"archive/zip"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func newLibraryInstallTestEnv(t *testing.T) (*librariesmanager.LibrariesManager, *paths.Path) {
+	tmp, err := paths.MkTempDir("", "lib_install_test")
+	require.NoError(t, err)
+	lm := librariesmanager.NewLibraryManager(tmp, tmp.Join("staging"))
+	lm.AddLibrariesDir(tmp.Join("libraries"), libraries.Sketchbook)
+	require.NoError(t, lm.RescanLibraries())
+	return lm, tmp
+}
+
+func writeTestLibrary(t *testing.T, dir *paths.Path, version string) {
+	require.NoError(t, dir.Join("src").MkdirAll())
+	props := "name=My Lib\nversion=" + version + "\nauthor=me\nmaintainer=me\n"
+	require.NoError(t, dir.Join("library.properties").WriteFile([]byte(props)))
+	require.NoError(t, dir.Join("src", "MyLib.h").WriteFile([]byte("#pragma once\n")))
+}
+
+func TestLibraryInstallZip(t *testing.T) {
+	lm, tmp := newLibraryInstallTestEnv(t)
+	defer tmp.RemoveAll()
+	noTask := func(*TaskProgress) {}
+
+	writeZip := func(name string, files map[string]string) *paths.Path {
+		zipPath := tmp.Join(name)
+		file, err := os.Create(zipPath.String())
+		require.NoError(t, err)
+		defer file.Close()
+		w := zip.NewWriter(file)
+		for name, content := range files {
+			f, err := w.Create(name)
+			require.NoError(t, err)
+			f.Write([]byte(content))
+		}
+		require.NoError(t, w.Close())
+		return zipPath
+	}
+	props := "name=My Lib\nversion=1.0.0\nauthor=me\nmaintainer=me\n"
+	zipPath := writeZip("MyLib-1.0.0.zip", map[string]string{
+		"MyLib-master/library.properties": props,
+		"MyLib-master/src/MyLib.h":        "#pragma once\n",
+	})
+
+	lib, err := LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: zipPath}, noTask)
+	require.NoError(t, err)
+	libDir := tmp.Join("libraries", "My_Lib")
+	require.Equal(t, libDir.String(), lib.InstallDir.String())
+	require.True(t, libDir.Join("src", "MyLib.h").Exist())
+	require.Equal(t, &libraries.InstallSource{Type: "zip", URL: zipPath.String()}, lib.InstalledFrom)
+
+	// The same version is reinstalled, the content of a zip may change
+	// without a new version
+	require.NoError(t, lm.RescanLibraries())
+	zipPath = writeZip("MyLib-1.0.0.zip", map[string]string{
+		"MyLib-master/library.properties": props,
+		"MyLib-master/src/MyLib.h":        "#pragma once\n#define FIXED\n",
+	})
+	lib, err = LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: zipPath}, noTask)
+	require.NoError(t, err)
+	require.Equal(t, libDir.String(), lib.InstallDir.String())
+	header, err := libDir.Join("src", "MyLib.h").ReadFile()
+	require.NoError(t, err)
+	require.Contains(t, string(header), "FIXED")
+
+	// Another version replaces it, the files may be at the archive root
+	zipPath = writeZip("MyLib-2.0.0.zip", map[string]string{
+		"library.properties": "name=My Lib\nversion=2.0.0\nauthor=me\nmaintainer=me\n",
+		"src/MyLib.h":        "#pragma once\n",
+	})
+	lib, err = LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: zipPath}, noTask)
+	require.NoError(t, err)
+	require.Equal(t, libDir.String(), lib.InstallDir.String())
+	require.Equal(t, "2.0.0", lib.Version.String())
+	files, err := tmp.Join("libraries").ReadDir()
+	require.NoError(t, err)
+	require.Len(t, files, 1, "temporary files are removed")
+
+	// A version that doesn't parse is refused
+	zipPath = writeZip("MyLib-bad.zip", map[string]string{
+		"library.properties": "name=My Lib\nversion=1.0 beta\nauthor=me\nmaintainer=me\n",
+		"src/MyLib.h":        "#pragma once\n",
+	})
+	_, err = LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: zipPath}, noTask)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `version "1.0 beta" in library.properties is not valid`)
+
+	// An installed library with an invalid version is replaced
+	writeTestLibrary(t, libDir, "1.0 beta")
+	require.NoError(t, lm.RescanLibraries())
+	zipPath = writeZip("MyLib-3.0.0.zip", map[string]string{
+		"library.properties": "name=My Lib\nversion=3.0.0\nauthor=me\nmaintainer=me\n",
+		"src/MyLib.h":        "#pragma once\n",
+	})
+	lib, err = LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: zipPath}, noTask)
+	require.NoError(t, err)
+	require.Equal(t, "3.0.0", lib.Version.String())
+
+	zipPath = writeZip("NotALib.zip", map[string]string{"README.md": "hello"})
+	_, err = LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: zipPath}, noTask)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no header files found")
+
+	_, err = LibraryInstallZip(lm, &LibraryInstallZipReq{ZipPath: tmp.Join("missing.zip")}, noTask)
+	require.IsType(t, &NotFoundError{}, err)
+}
+
+func TestLibraryInstallGit(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	lm, tmp := newLibraryInstallTestEnv(t)
+	defer tmp.RemoveAll()
+	noTask := func(*TaskProgress) {}
+
+	repo := tmp.Join("repos", "MyLib.git")
+	require.NoError(t, repo.MkdirAll())
+	git := func(args ...string) {
+		cmd := exec.Command("git", append([]string{"-C", repo.String(),
+			"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	git("init", "--quiet")
+	writeTestLibrary(t, repo, "1.0.0")
+	git("add", ".")
+	git("commit", "--quiet", "-m", "first")
+	git("tag", "1.0.0")
+	writeTestLibrary(t, repo, "1.1.0")
+	git("commit", "--quiet", "-a", "-m", "second")
+
+	lib, err := LibraryInstallGit(lm, &LibraryInstallGitReq{URL: repo.String() + "#1.0.0"}, noTask)
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", lib.Version.String())
+	require.Equal(t, "git", lib.InstalledFrom.Type)
+	require.Equal(t, repo.String(), lib.InstalledFrom.URL)
+	require.Equal(t, "1.0.0", lib.InstalledFrom.Ref)
+	require.Len(t, lib.InstalledFrom.Commit, 40)
+	require.False(t, lib.InstallDir.Join(".git").Exist())
+
+	require.NoError(t, lm.RescanLibraries())
+	lib, err = LibraryInstallGit(lm, &LibraryInstallGitReq{URL: repo.String()}, noTask)
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", lib.Version.String())
+
+	// The same version is reinstalled from a new commit
+	writeTestLibrary(t, repo, "1.1.0")
+	require.NoError(t, repo.Join("src", "Extra.h").WriteFile([]byte("#pragma once\n")))
+	git("add", ".")
+	git("commit", "--quiet", "-m", "third")
+	require.NoError(t, lm.RescanLibraries())
+	reinstalled, err := LibraryInstallGit(lm, &LibraryInstallGitReq{URL: repo.String()}, noTask)
+	require.NoError(t, err)
+	require.Equal(t, "1.1.0", reinstalled.Version.String())
+	require.NotEqual(t, lib.InstalledFrom.Commit, reinstalled.InstalledFrom.Commit)
+	require.True(t, reinstalled.InstallDir.Join("src", "Extra.h").Exist())
+
+	_, err = LibraryInstallGit(lm, &LibraryInstallGitReq{URL: tmp.Join("repos", "Missing.git").String()}, noTask)
+	require.IsType(t, &FailedPreconditionError{}, err)
+	_, err = LibraryInstallGit(lm, &LibraryInstallGitReq{URL: "#1.0.0"}, noTask)
+	require.IsType(t, &InvalidArgumentError{}, err)
+
+	// URLs and refs must not be parsed as git options
+	marker := tmp.Join("executed")
+	_, err = LibraryInstallGit(lm, &LibraryInstallGitReq{URL: "--upload-pack=touch " + marker.String()}, noTask)
+	require.IsType(t, &FailedPreconditionError{}, err)
+	_, err = LibraryInstallGit(lm, &LibraryInstallGitReq{URL: repo.String() + "#--help"}, noTask)
+	require.IsType(t, &FailedPreconditionError{}, err)
+	require.Contains(t, err.Error(), "invalid git ref")
+	require.False(t, marker.Exist())
+}
+
+func TestLibraryInstallPlanInvalidInstalledVersion(t *testing.T) {
+	lm, tmp := newLibraryInstallTestEnv(t)
+	defer tmp.RemoveAll()
+	index, err := librariesindex.LoadIndex(paths.New("testdata", "library_index.json"))
+	require.NoError(t, err)
+	lm.Index = index
+
+	// A library installed by hand with a version that can't be parsed
+	libDir := tmp.Join("libraries", "Servo")
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	props := "name=Servo\nversion=1.1 beta\nauthor=me\nmaintainer=me\n"
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte(props)))
+	require.NoError(t, libDir.Join("src", "Servo.h").WriteFile([]byte("#pragma once\n")))
+	require.NoError(t, lm.RescanLibraries())
+
+	plan, err := LibraryInstallPlan(lm, &LibraryInstallReq{Libraries: []*librariesindex.Reference{{Name: "Servo"}}})
+	require.NoError(t, err)
+	require.Len(t, plan.Items, 1)
+	require.Equal(t, "Servo@1.1.2", plan.Items[0].Release.String())
+	require.NotNil(t, plan.Items[0].Installed)
+	require.Nil(t, plan.Items[0].Installed.Version)
+	require.False(t, plan.Items[0].AlreadyInstalled())
+}
@@ -0,0 +1,80 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// LibraryLintReq is the request for LibraryLint.
+type LibraryLintReq struct {
+	LibraryPath *paths.Path // The root folder of the library.
+}
+
+// LibraryLintResult is the result of LibraryLint.
+type LibraryLintResult struct {
+	Library *libraries.Library
+	Results []*lint.Result // Sorted from the most severe.
+}
+
+// Failed returns true if a problem at least as severe as minSeverity has
+// been found.
+func (res *LibraryLintResult) Failed(minSeverity lint.Severity) bool {
+	return lintFailed(res.Results, minSeverity)
+}
+
+// lintFailed returns true if the results, sorted from the most severe,
+// contain a problem at least as severe as minSeverity.
+func lintFailed(results []*lint.Result, minSeverity lint.Severity) bool {
+	return len(results) > 0 && results[0].Severity >= minSeverity
+}
+
+// LibraryLint checks the library in req.LibraryPath for problems in
+// library.properties, in the layout of the sources and examples and in the
+// name of the folder. The library doesn't need to be installed.
+func LibraryLint(req *LibraryLintReq) (*LibraryLintResult, error) {
+	if req.LibraryPath == nil {
+		return nil, &InvalidArgumentError{Message: "missing library path"}
+	}
+	if !req.LibraryPath.IsDir() {
+		return nil, &NotFoundError{Message: fmt.Sprintf("library folder %s not found", req.LibraryPath)}
+	}
+
+	// The name of the folder is checked, so a relative path like "." must
+	// be made absolute
+	libPath, err := req.LibraryPath.Abs()
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "invalid library path " + req.LibraryPath.String(), Cause: err}
+	}
+
+	logrus.WithField("path", libPath).Info("Linting library")
+	lib, err := libraries.Load(libPath, libraries.Sketchbook)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "loading library " + libPath.String(), Cause: err}
+	}
+	results, err := lib.LintResults()
+	if err != nil {
+		return nil, err
+	}
+	return &LibraryLintResult{Library: lib, Results: results}, nil
+}
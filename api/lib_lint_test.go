@@ -0,0 +1,52 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"os"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibraryLintRelativePath(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "lib_lint_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	libDir := tmp.Join("My_Lib")
+	require.NoError(t, libDir.Join("src").MkdirAll())
+	require.NoError(t, libDir.Join("src", "MyLib.h").WriteFile([]byte("#pragma once\n")))
+	require.NoError(t, libDir.Join("examples", "Basic").MkdirAll())
+	require.NoError(t, libDir.Join("examples", "Basic", "Basic.ino").WriteFile(nil))
+	require.NoError(t, libDir.Join("library.properties").WriteFile([]byte(
+		"name=My Lib\nversion=1.0.0\nauthor=me\nmaintainer=me\n"+
+			"sentence=A library.\nparagraph=A library for testing.\ncategory=Other\n"+
+			"url=https://example.com/mylib\narchitectures=avr\n")))
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	defer os.Chdir(cwd)
+	require.NoError(t, os.Chdir(libDir.String()))
+
+	res, err := LibraryLint(&LibraryLintReq{LibraryPath: paths.New(".")})
+	require.NoError(t, err)
+	require.Equal(t, "My_Lib", res.Library.InstallDir.Base())
+	require.Empty(t, res.Results)
+}
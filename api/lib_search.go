@@ -0,0 +1,157 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesresolver"
+)
+
+// LibrarySearchReq is the request for LibrarySearch.
+type LibrarySearchReq struct {
+	Query string
+	// Header, if set, selects the libraries providing the header instead of
+	// searching the query.
+	Header string
+	// Architecture excludes the libraries not compatible with the
+	// architecture.
+	Architecture string
+	// Category selects the libraries of the category (case insensitive).
+	Category string
+	// Types selects the libraries of any of the types (case insensitive),
+	// e.g. Arduino, Contributed.
+	Types []string
+	// Offset and Limit select a page of the results, all the results are
+	// returned if Limit is 0.
+	Offset int
+	Limit  int
+}
+
+// LibrarySearchResult is the result of LibrarySearch.
+type LibrarySearchResult struct {
+	Libraries []*librariesindex.Library
+	// Total is the number of libraries found, including the ones outside
+	// of the requested page.
+	Total int
+}
+
+// LibrarySearch searches the libraries index for libraries matching all
+// the words of the query in the name, sentence, paragraph, author,
+// maintainer, category, types or architectures of their latest release,
+// the most relevant first, see librariesindex.Index.Search. If req.Header
+// is set, it returns the libraries providing the header instead, the most
+// suitable first.
+func LibrarySearch(lm *librariesmanager.LibrariesManager, req *LibrarySearchReq) (*LibrarySearchResult, error) {
+	if req.Offset < 0 || req.Limit < 0 {
+		return nil, &InvalidArgumentError{Message: "invalid page of results"}
+	}
+	var found []*librariesindex.Library
+	if req.Header != "" {
+		found = librariesresolver.NewIndexResolver(lm.Index).SuggestFor(req.Header, req.Architecture)
+	} else {
+		for _, result := range lm.Index.Search(req.Query) {
+			found = append(found, result.Library)
+		}
+	}
+
+	libs := []*librariesindex.Library{}
+	for _, lib := range found {
+		if matchesSearchFilters(lib.Latest, req) {
+			libs = append(libs, lib)
+		}
+	}
+	res := &LibrarySearchResult{Libraries: libs, Total: len(libs)}
+	if req.Offset >= len(libs) {
+		res.Libraries = []*librariesindex.Library{}
+	} else if req.Limit > 0 && req.Offset+req.Limit < len(libs) {
+		res.Libraries = libs[req.Offset : req.Offset+req.Limit]
+	} else {
+		res.Libraries = libs[req.Offset:]
+	}
+	return res, nil
+}
+
+// matchesSearchFilters returns true if the release matches the
+// architecture, category and types requested.
+func matchesSearchFilters(release *librariesindex.Release, req *LibrarySearchReq) bool {
+	if req.Architecture != "" && !release.SupportsAnyArchitectureIn(req.Architecture) {
+		return false
+	}
+	if req.Category != "" && !strings.EqualFold(release.Category, req.Category) {
+		return false
+	}
+	if len(req.Types) == 0 {
+		return true
+	}
+	for _, wanted := range req.Types {
+		for _, libType := range release.Types {
+			if strings.EqualFold(libType, wanted) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LibraryListReq is the request for LibraryList.
+type LibraryListReq struct {
+	// All includes the libraries bundled with the installed platforms.
+	All bool
+	// Updatable selects only the libraries with a newer release available.
+	Updatable bool
+}
+
+// LibraryListResult is the result of LibraryList.
+type LibraryListResult struct {
+	Libraries []*InstalledLibrary
+}
+
+// InstalledLibrary is an installed library together with the newer
+// release available in the libraries index, if requested.
+type InstalledLibrary struct {
+	Library   *libraries.Library
+	Available *librariesindex.Release
+}
+
+// LibraryList returns the installed libraries.
+func LibraryList(lm *librariesmanager.LibrariesManager, req *LibraryListReq) (*LibraryListResult, error) {
+	res := &LibraryListResult{Libraries: []*InstalledLibrary{}}
+	for _, libAlternatives := range lm.Libraries {
+		for _, lib := range libAlternatives.Alternatives {
+			if !req.All && (lib.Location == libraries.PlatformBuiltIn || lib.Location == libraries.ReferencedPlatformBuiltIn) {
+				continue
+			}
+			var available *librariesindex.Release
+			if req.Updatable {
+				available = lm.Index.FindLibraryUpdate(lib)
+				if available == nil {
+					continue
+				}
+			}
+			res.Libraries = append(res.Libraries, &InstalledLibrary{
+				Library:   lib,
+				Available: available,
+			})
+		}
+	}
+	return res, nil
+}
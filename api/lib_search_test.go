@@ -0,0 +1,65 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibrarySearch(t *testing.T) {
+	index, err := librariesindex.LoadIndex(paths.New("testdata", "library_index.json"))
+	require.NoError(t, err)
+	lm := &librariesmanager.LibrariesManager{Index: index}
+	search := func(req *LibrarySearchReq) []string {
+		res, err := LibrarySearch(lm, req)
+		require.NoError(t, err)
+		names := []string{}
+		for _, lib := range res.Libraries {
+			names = append(names, lib.Name)
+		}
+		return names
+	}
+
+	require.Equal(t, []string{"Servo", "ServoEasing"}, search(&LibrarySearchReq{Query: "servo"}))
+	require.Equal(t, []string{"ServoEasing"}, search(&LibrarySearchReq{Query: "easing servo"}))
+	require.Equal(t, []string{"ServoEasing"}, search(&LibrarySearchReq{Query: "servo", Types: []string{"contributed"}}))
+	require.Equal(t, []string{"Servo", "ServoEasing", "Temperature Sensor"}, search(&LibrarySearchReq{Architecture: "samd"}))
+	require.Equal(t, []string{"Servo", "ServoEasing"}, search(&LibrarySearchReq{Architecture: "avr"}))
+	require.Equal(t, []string{"Temperature Sensor"}, search(&LibrarySearchReq{Category: "sensors"}))
+	require.Equal(t, []string{"Temperature Sensor"}, search(&LibrarySearchReq{Query: "temperatur"}))
+
+	res, err := LibrarySearch(lm, &LibrarySearchReq{Offset: 1, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Total)
+	require.Len(t, res.Libraries, 2)
+	require.Equal(t, "ServoEasing", res.Libraries[0].Name)
+	res, err = LibrarySearch(lm, &LibrarySearchReq{Offset: 5})
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Total)
+	require.Empty(t, res.Libraries)
+	_, err = LibrarySearch(lm, &LibrarySearchReq{Limit: -1})
+	require.IsType(t, &InvalidArgumentError{}, err)
+
+	require.Equal(t, []string{"Servo", "ServoEasing"}, search(&LibrarySearchReq{Header: "Servo.h"}))
+	require.Equal(t, []string{"ServoEasing"}, search(&LibrarySearchReq{Header: "Servo.h", Architecture: "esp32"}))
+}
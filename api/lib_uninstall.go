@@ -0,0 +1,49 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+)
+
+// LibraryUninstallReq is the request for LibraryUninstall. If the version
+// of the library is not specified the installed one is removed.
+type LibraryUninstallReq struct {
+	Library *librariesindex.Reference
+}
+
+// LibraryUninstall removes an installed library.
+func LibraryUninstall(lm *librariesmanager.LibrariesManager, req *LibraryUninstallReq, taskCB TaskProgressCB) error {
+	if req.Library == nil {
+		return &InvalidArgumentError{Message: "missing library reference"}
+	}
+	lib := lm.FindByReference(req.Library)
+	if lib == nil {
+		return &FailedPreconditionError{Message: fmt.Sprintf("library %s is not installed", req.Library)}
+	}
+
+	taskCB(&TaskProgress{Name: "Uninstalling " + lib.String()})
+	if err := lm.Uninstall(lib); err != nil {
+		return fmt.Errorf("uninstalling %s: %s", lib, err)
+	}
+	taskCB(&TaskProgress{Message: lib.String() + " uninstalled", Completed: true})
+	return nil
+}
@@ -0,0 +1,383 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	builder "github.com/arduino/arduino-builder"
+	"github.com/arduino/arduino-builder/types"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// SketchLockReq is the request for SketchLock.
+type SketchLockReq struct {
+	SketchPath *paths.Path // The sketch to lock.
+	FQBN       string      // Fully Qualified Board Name, if empty the one attached to the sketch is used.
+}
+
+// SketchLockResult is the result of SketchLock.
+type SketchLockResult struct {
+	Lockfile *sketches.Lockfile
+	Path     *paths.Path // The path of the saved lockfile.
+}
+
+// SketchLock detects the platforms, tools and libraries used to build the
+// sketch and records them, together with their checksums, in the lockfile
+// in the sketch folder. A previous lockfile is overwritten. The output of
+// the builder is written to stdout and stderr.
+func SketchLock(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	req *SketchLockReq, stdout, stderr io.Writer) (*SketchLockResult, error) {
+	ctx, err := detectSketchDependencies(pm, config, &CompileReq{SketchPath: req.SketchPath, FQBN: req.FQBN}, stdout, stderr)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := newSketchLockfile(ctx, lm)
+	lockPath := req.SketchPath.Join(sketches.LockfileName)
+	if err := lock.Save(lockPath); err != nil {
+		return nil, err
+	}
+	return &SketchLockResult{Lockfile: lock, Path: lockPath}, nil
+}
+
+// detectSketchDependencies runs the builder up to the detection of the
+// libraries used by the sketch, without compiling it.
+func detectSketchDependencies(pm *packagemanager.PackageManager, config *configs.Configuration, req *CompileReq,
+	stdout, stderr io.Writer) (*types.Context, error) {
+	ctx, err := newBuilderContext(pm, config, req, stdout, stderr)
+	if err != nil {
+		return nil, err
+	}
+	commands := []types.Command{
+		&builder.GenerateBuildPathIfMissing{},
+		&builder.EnsureBuildPathExists{},
+		&builder.ContainerSetupHardwareToolsLibsSketchAndProps{},
+		&builder.ContainerMergeCopySketchFiles{},
+		&builder.ContainerFindIncludes{},
+	}
+	for _, command := range commands {
+		if err := command.Run(ctx); err != nil {
+			return nil, fmt.Errorf("detecting sketch dependencies: %s", err)
+		}
+	}
+	return ctx, nil
+}
+
+// newSketchLockfile creates a Lockfile with the platforms, tools and
+// libraries selected by the builder. If lm is nil the checksums of the
+// libraries are not filled.
+func newSketchLockfile(ctx *types.Context, lm *librariesmanager.LibrariesManager) *sketches.Lockfile {
+	// The release of the platform is recorded in Platforms
+	fqbn := *ctx.FQBN
+	fqbn.PlatformVersion = nil
+	lock := &sketches.Lockfile{
+		FQBN:      fqbn.String(),
+		Platforms: []*sketches.LockedPlatform{},
+		Tools:     []*sketches.LockedTool{},
+		Libraries: []*sketches.LockedLibrary{},
+	}
+
+	platforms := []*cores.PlatformRelease{ctx.TargetPlatform}
+	if ctx.ActualPlatform != nil && ctx.ActualPlatform != ctx.TargetPlatform {
+		platforms = append(platforms, ctx.ActualPlatform)
+	}
+	for _, platform := range platforms {
+		lockedPlatform := &sketches.LockedPlatform{
+			Packager:     platform.Platform.Package.Name,
+			Architecture: platform.Platform.Architecture,
+			Version:      platform.Version.String(),
+		}
+		if platform.Resource != nil {
+			lockedPlatform.Checksum = platform.Resource.Checksum
+		}
+		lock.Platforms = append(lock.Platforms, lockedPlatform)
+	}
+
+	for _, tool := range ctx.RequiredTools {
+		lockedTool := &sketches.LockedTool{
+			Packager: tool.Tool.Package.Name,
+			Name:     tool.Tool.Name,
+			Version:  tool.Version.String(),
+		}
+		for _, flavor := range tool.Flavors {
+			if flavor.Resource == nil {
+				continue
+			}
+			if lockedTool.Checksums == nil {
+				lockedTool.Checksums = map[string]string{}
+			}
+			lockedTool.Checksums[flavor.OS] = flavor.Resource.Checksum
+		}
+		lock.Tools = append(lock.Tools, lockedTool)
+	}
+	sort.Slice(lock.Tools, func(i, j int) bool {
+		return lock.Tools[i].String() < lock.Tools[j].String()
+	})
+
+	for _, lib := range ctx.ImportedLibraries {
+		location := lib.Location
+		lockedLib := &sketches.LockedLibrary{
+			Name:     libraryIndexName(lib),
+			Version:  lib.Version.String(),
+			Location: location.String(),
+		}
+		if lm != nil && lm.Index != nil && lib.Location == libraries.Sketchbook {
+			release := lm.Index.FindRelease(&librariesindex.Reference{Name: lockedLib.Name, Version: lib.Version})
+			if release != nil && release.Resource != nil {
+				lockedLib.Checksum = release.Resource.Checksum
+			}
+		}
+		lock.Libraries = append(lock.Libraries, lockedLib)
+	}
+	sort.Slice(lock.Libraries, func(i, j int) bool {
+		return lock.Libraries[i].Name < lock.Libraries[j].Name
+	})
+	return lock
+}
+
+func loadSketchLockfile(sketchPath *paths.Path) (*sketches.Lockfile, error) {
+	if sketchPath == nil {
+		return nil, &InvalidArgumentError{Message: "missing sketch path"}
+	}
+	lockPath := sketchPath.Join(sketches.LockfileName)
+	if !lockPath.Exist() {
+		return nil, &FailedPreconditionError{Message: "the sketch has no lockfile"}
+	}
+	lock, err := sketches.LoadLockfile(lockPath)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "loading sketch lockfile", Cause: err}
+	}
+	return lock, nil
+}
+
+// SketchLockInstallReq is the request for SketchLockInstall.
+type SketchLockInstallReq struct {
+	SketchPath *paths.Path
+}
+
+// SketchLockInstall installs the platforms, tools and libraries recorded
+// in the sketch lockfile that are missing. The checksums in the indexes
+// must match the ones in the lockfile, for the items already installed too.
+// Libraries bundled with a platform or with the IDE can't be installed, they
+// are checked only when compiling with CompileReq.Locked.
+func SketchLockInstall(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	req *SketchLockInstallReq, downloadCB DownloadProgressCB, taskCB TaskProgressCB) error {
+	lock, err := loadSketchLockfile(req.SketchPath)
+	if err != nil {
+		return err
+	}
+
+	hardwareChanged := false
+	for _, locked := range lock.Platforms {
+		version, err := semver.Parse(locked.Version)
+		if err != nil {
+			return &InvalidArgumentError{Message: "invalid version in lockfile for platform " + locked.String(), Cause: err}
+		}
+		ref := &packagemanager.PlatformReference{
+			Package:              locked.Packager,
+			PlatformArchitecture: locked.Architecture,
+			PlatformVersion:      version,
+		}
+		release := pm.FindPlatformRelease(ref)
+		if release == nil {
+			return &NotFoundError{Message: fmt.Sprintf("platform %s not found", locked)}
+		}
+		if err := checkLockedChecksum(release.String(), release.Resource, locked.Checksum); err != nil {
+			return err
+		}
+		if release.IsInstalled() {
+			continue
+		}
+		_, tools, err := pm.FindPlatformReleaseDependencies(ref)
+		if err != nil {
+			return &NotFoundError{Message: "finding platform dependencies", Cause: err}
+		}
+		if err := installPlatform(pm, release, tools, nil, downloadCB, taskCB); err != nil {
+			return err
+		}
+		hardwareChanged = true
+	}
+
+	for _, locked := range lock.Tools {
+		release, err := pm.Package(locked.Packager).Tool(locked.Name).Release(semver.ParseRelaxed(locked.Version)).Get()
+		if err != nil {
+			return &NotFoundError{Message: fmt.Sprintf("tool %s not found", locked), Cause: err}
+		}
+		for _, flavor := range release.Flavors {
+			if checksum, ok := locked.Checksums[flavor.OS]; ok {
+				if err := checkLockedChecksum(release.String()+" for "+flavor.OS, flavor.Resource, checksum); err != nil {
+					return err
+				}
+			}
+		}
+		if release.IsInstalled() {
+			continue
+		}
+		if err := downloadTool(pm, release, downloadCB); err != nil {
+			return err
+		}
+		if err := installToolRelease(pm, release, pm.InstallTool, taskCB); err != nil {
+			return err
+		}
+		hardwareChanged = true
+	}
+
+	librariesChanged := false
+	for _, locked := range lock.Libraries {
+		if locked.Location != "sketchbook" {
+			continue
+		}
+		ref := &librariesindex.Reference{Name: locked.Name}
+		if locked.Version != "" {
+			version, err := semver.Parse(locked.Version)
+			if err != nil {
+				return &InvalidArgumentError{Message: "invalid version in lockfile for library " + locked.String(), Cause: err}
+			}
+			ref.Version = version
+		}
+		release := lm.Index.FindRelease(ref)
+		if release != nil {
+			if err := checkLockedChecksum(release.String(), release.Resource, locked.Checksum); err != nil {
+				return err
+			}
+		}
+		if isLockedLibraryInstalled(lm, locked) {
+			continue
+		}
+		if release == nil {
+			return &NotFoundError{Message: fmt.Sprintf("library %s not found", locked)}
+		}
+		if err := downloadLibrary(lm, release, downloadCB); err != nil {
+			return err
+		}
+		if err := installLibrary(lm, release, taskCB); err != nil {
+			return err
+		}
+		librariesChanged = true
+	}
+
+	if hardwareChanged {
+		if err := pm.LoadHardware(config); err != nil {
+			return &ConfigurationError{Message: "loading hardware packages", Cause: err}
+		}
+	}
+	if librariesChanged {
+		if err := lm.RescanLibraries(); err != nil {
+			return &ConfigurationError{Message: "rescanning libraries", Cause: err}
+		}
+	}
+	return nil
+}
+
+func checkLockedChecksum(item string, resource *resources.DownloadResource, checksum string) error {
+	if checksum == "" {
+		return nil
+	}
+	if resource == nil || resource.Checksum != checksum {
+		return &FailedPreconditionError{Message: fmt.Sprintf("checksum of %s doesn't match the lockfile", item)}
+	}
+	return nil
+}
+
+func isLockedLibraryInstalled(lm *librariesmanager.LibrariesManager, locked *sketches.LockedLibrary) bool {
+	for _, alternatives := range lm.Libraries {
+		for _, lib := range alternatives.Alternatives {
+			if lib.Location == libraries.Sketchbook &&
+				libraryIndexName(lib) == locked.Name &&
+				lib.Version.String() == locked.Version {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// verifySketchLock checks that the platforms, tools and libraries selected
+// by the builder to compile the sketch are the ones recorded in the sketch
+// lockfile.
+func verifySketchLock(pm *packagemanager.PackageManager, config *configs.Configuration, req *CompileReq,
+	stdout, stderr io.Writer) error {
+	lock, err := loadSketchLockfile(req.SketchPath)
+	if err != nil {
+		return err
+	}
+	ctx, err := detectSketchDependencies(pm, config, req, stdout, stderr)
+	if err != nil {
+		return err
+	}
+	current := newSketchLockfile(ctx, nil)
+
+	if current.FQBN != lock.FQBN {
+		return &FailedPreconditionError{
+			Message: fmt.Sprintf("the sketch is locked for board %s but %s is selected", lock.FQBN, current.FQBN),
+		}
+	}
+
+	locked := map[string]bool{}
+	used := map[string]bool{}
+	for _, platform := range lock.Platforms {
+		locked["platform "+platform.String()] = true
+	}
+	for _, platform := range current.Platforms {
+		used["platform "+platform.String()] = true
+	}
+	for _, tool := range lock.Tools {
+		locked["tool "+tool.String()] = true
+	}
+	for _, tool := range current.Tools {
+		used["tool "+tool.String()] = true
+	}
+	for _, lib := range lock.Libraries {
+		locked["library "+lib.String()+" ("+lib.Location+")"] = true
+	}
+	for _, lib := range current.Libraries {
+		used["library "+lib.String()+" ("+lib.Location+")"] = true
+	}
+
+	diffs := []string{}
+	for item := range locked {
+		if !used[item] {
+			diffs = append(diffs, "locked "+item+" is not used")
+		}
+	}
+	for item := range used {
+		if !locked[item] {
+			diffs = append(diffs, item+" is not locked")
+		}
+	}
+	if len(diffs) > 0 {
+		sort.Strings(diffs)
+		return &FailedPreconditionError{
+			Message: "the build environment doesn't match the sketch lockfile: " + strings.Join(diffs, ", "),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// newLockTestEnv copies the lock fixture in a temp dir: the locktest
+// package index with its archives already downloaded, and the sketches
+// with their lockfiles. Nothing is installed.
+func newLockTestEnv(t *testing.T) (*packagemanager.PackageManager, *configs.Configuration, *paths.Path) {
+	tmp, err := paths.MkTempDir("", "lock_test")
+	require.NoError(t, err)
+	env := tmp.Join("env")
+	require.NoError(t, paths.New("testdata", "lock").CopyDirTo(env))
+	notBundled := false
+	config := &configs.Configuration{
+		DataDir:               env.Join("data"),
+		SketchbookDir:         env.Join("sketchbook"),
+		IDEBundledCheckResult: &notBundled,
+	}
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+
+	pm := NewPackageManager(config)
+	_, err = pm.LoadPackageIndexFromFile(config.IndexesDir().Join("package_locktest_index.json"))
+	require.NoError(t, err)
+	require.NoError(t, pm.LoadHardware(config))
+	return pm, config, tmp
+}
+
+// lockTestCompileReq returns a CompileReq for a sketch of the lock fixture,
+// running TestHelperProcess as preprocessor.
+func lockTestCompileReq(env *paths.Path, sketch string) *CompileReq {
+	return &CompileReq{
+		SketchPath:      env.Join("env", sketch),
+		BuildPath:       env.Join("build"),
+		BuildProperties: []string{"helper.cmd=" + os.Args[0]},
+		Locked:          true,
+	}
+}
+
+func TestSketchLockInstall(t *testing.T) {
+	pm, config, tmp := newLockTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+	platformDir := config.PackagesDir().Join("locktest", "hardware", "avr", "1.0.0")
+	toolDir := config.PackagesDir().Join("locktest", "tools", "fake", "1.0.0")
+
+	req := lockTestCompileReq(tmp, "Sketch")
+	_, err := Compile(pm, config, req, os.Stdout, os.Stderr)
+	require.IsType(t, &FailedPreconditionError{}, err, "the locked platform is not installed")
+
+	// The pinned releases missing are installed from the archives in the cache
+	lockReq := &SketchLockInstallReq{SketchPath: req.SketchPath}
+	require.NoError(t, SketchLockInstall(pm, nil, config, lockReq, func(*DownloadProgress) {}, func(*TaskProgress) {}))
+	require.True(t, platformDir.Join("boards.txt").Exist())
+	require.True(t, toolDir.Join("bin", "fake").Exist())
+	require.NoError(t, verifySketchLock(pm, config, req, &bytes.Buffer{}, &bytes.Buffer{}))
+
+	// Running it again has nothing to do
+	require.NoError(t, SketchLockInstall(pm, nil, config, lockReq, func(*DownloadProgress) {}, func(*TaskProgress) {}))
+}
+
+func TestCompileLockedSelectsLockedPlatform(t *testing.T) {
+	pm, config, tmp := newLockTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	req := lockTestCompileReq(tmp, "Sketch")
+	lockReq := &SketchLockInstallReq{SketchPath: req.SketchPath}
+	require.NoError(t, SketchLockInstall(pm, nil, config, lockReq, func(*DownloadProgress) {}, func(*TaskProgress) {}))
+
+	// A newer release of the platform installed side by side is not used
+	newer := &packagemanager.PlatformReference{
+		Package:              "locktest",
+		PlatformArchitecture: "avr",
+		PlatformVersion:      semver.MustParse("1.1.0"),
+	}
+	require.NoError(t, PlatformInstall(pm, &PlatformInstallReq{Platform: newer}, func(*DownloadProgress) {}, func(*TaskProgress) {}))
+	require.NoError(t, pm.LoadHardware(config))
+	require.NoError(t, verifySketchLock(pm, config, req, &bytes.Buffer{}, &bytes.Buffer{}))
+
+	// unless the FQBN pins it
+	req.FQBN = "locktest:avr@1.1.0:uno"
+	_, err := Compile(pm, config, req, &bytes.Buffer{}, &bytes.Buffer{})
+	require.IsType(t, &FailedPreconditionError{}, err)
+	require.EqualError(t, err, "the build environment doesn't match the sketch lockfile: "+
+		"locked platform locktest:avr@1.0.0 is not used, platform locktest:avr@1.1.0 is not locked")
+
+	// The locked release must be installed
+	req.FQBN = ""
+	locked := &packagemanager.PlatformReference{
+		Package:              "locktest",
+		PlatformArchitecture: "avr",
+		PlatformVersion:      semver.MustParse("1.0.0"),
+	}
+	require.NoError(t, PlatformUninstall(pm, &PlatformUninstallReq{Platform: locked}, func(*TaskProgress) {}))
+	_, err = Compile(pm, config, req, &bytes.Buffer{}, &bytes.Buffer{})
+	require.IsType(t, &FailedPreconditionError{}, err)
+	require.EqualError(t, err, "platform locktest:avr@1.0.0 is not installed")
+}
+
+func TestSketchLockInstallChecksumMismatch(t *testing.T) {
+	pm, config, tmp := newLockTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	// The lockfile of Tampered records a checksum of the platform archive
+	// different from the one in the package index
+	lockReq := &SketchLockInstallReq{SketchPath: tmp.Join("env", "Tampered")}
+	err := SketchLockInstall(pm, nil, config, lockReq, func(*DownloadProgress) {}, func(*TaskProgress) {})
+	require.IsType(t, &FailedPreconditionError{}, err)
+	require.EqualError(t, err, "checksum of locktest:avr@1.0.0 doesn't match the lockfile")
+	require.False(t, config.PackagesDir().Join("locktest").Exist(), "nothing is installed")
+}
+
+func TestCheckLockedChecksum(t *testing.T) {
+	resource := &resources.DownloadResource{Checksum: "SHA-256:0123"}
+	require.NoError(t, checkLockedChecksum("item", resource, "SHA-256:0123"))
+	require.NoError(t, checkLockedChecksum("item", resource, ""), "nothing to check without a locked checksum")
+	require.NoError(t, checkLockedChecksum("item", nil, ""))
+	require.IsType(t, &FailedPreconditionError{}, checkLockedChecksum("item", resource, "SHA-256:4567"))
+	require.IsType(t, &FailedPreconditionError{}, checkLockedChecksum("item", nil, "SHA-256:0123"))
+}
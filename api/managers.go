@@ -0,0 +1,134 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/arduino-cli/configs"
+	"github.com/sirupsen/logrus"
+)
+
+// NewPackageManager creates an empty PackageManager using the directories
+// of the given configuration.
+func NewPackageManager(config *configs.Configuration) *packagemanager.PackageManager {
+	pm := packagemanager.NewPackageManager(
+		config.IndexesDir(),
+		config.PackagesDir(),
+		config.DownloadsDir(),
+		config.DataDir.Join("tmp"))
+	pm.MaxParallelDownloads = config.MaxParallelDownloads
+	return pm
+}
+
+// LoadPackageIndexes loads in pm the package indexes of all the URLs in
+// the configuration, after checking their signatures as configured in
+// config.IndexSignaturePolicy. All the indexes are loaded even if one of
+// them fails, the first error encountered is returned.
+func LoadPackageIndexes(pm *packagemanager.PackageManager, config *configs.Configuration) error {
+	var res error
+	for _, URL := range config.BoardManagerAdditionalUrls {
+		if index := packageIndexPath(config, URL); index.Exist() {
+			if err := checkIndexSignature(config, URL, index, indexSignaturePath(index), nil); err != nil {
+				logrus.WithError(err).Warnf("Refusing %s package index", URL)
+				if res == nil {
+					res = err
+				}
+				continue
+			}
+		}
+		if err := pm.LoadPackageIndex(URL); err != nil {
+			logrus.WithError(err).Warnf("Failed to load %s package index", URL)
+			if res == nil {
+				res = &ConfigurationError{Message: fmt.Sprintf("loading %s package index", URL), Cause: err}
+			}
+		}
+	}
+	return res
+}
+
+// LoadPackageManager creates a PackageManager and loads the package
+// indexes and the installed hardware.
+func LoadPackageManager(config *configs.Configuration) (*packagemanager.PackageManager, error) {
+	logrus.Info("Initializing package manager")
+
+	pm := NewPackageManager(config)
+	if err := LoadPackageIndexes(pm, config); err != nil {
+		return nil, err
+	}
+	if err := pm.LoadHardware(config); err != nil {
+		return nil, &ConfigurationError{Message: "loading hardware packages", Cause: err}
+	}
+	return pm, nil
+}
+
+// NewLibrariesManager creates a LibrariesManager that looks for libraries
+// in the directories of the given configuration and, if pm is not nil, in
+// the platforms installed in pm. The index and the libraries are not loaded.
+func NewLibrariesManager(config *configs.Configuration, pm *packagemanager.PackageManager) *librariesmanager.LibrariesManager {
+	lm := librariesmanager.NewLibraryManager(
+		config.IndexesDir(),
+		config.DownloadsDir())
+	lm.MaxParallelDownloads = config.MaxParallelDownloads
+
+	if indexFile := resources.LocalFilePath(config.LibrariesIndexURL); indexFile != nil {
+		lm.IndexFile = indexFile
+	}
+
+	// Add IDE builtin libraries dir
+	if bundledLibsDir := config.IDEBundledLibrariesDir(); bundledLibsDir != nil {
+		lm.AddLibrariesDir(bundledLibsDir, libraries.IDEBuiltIn)
+	}
+
+	// Add sketchbook libraries dir
+	lm.AddLibrariesDir(config.LibrariesDir(), libraries.Sketchbook)
+
+	// Add libraries dirs from installed platforms
+	if pm != nil {
+		for _, targetPackage := range pm.GetPackages().Packages {
+			for _, platform := range targetPackage.Platforms {
+				if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
+					lm.AddPlatformReleaseLibrariesDir(platformRelease, libraries.PlatformBuiltIn)
+				}
+			}
+		}
+	}
+	return lm
+}
+
+// LoadLibrariesManager creates a LibrariesManager (see NewLibrariesManager)
+// and loads the libraries index and the installed libraries.
+func LoadLibrariesManager(config *configs.Configuration, pm *packagemanager.PackageManager) (*librariesmanager.LibrariesManager, error) {
+	logrus.Info("Starting libraries manager")
+
+	lm := NewLibrariesManager(config, pm)
+	if err := LoadLibrariesIndex(lm, config); err != nil {
+		if _, ok := err.(*FailedPreconditionError); ok {
+			return nil, err
+		}
+		return nil, &ConfigurationError{Message: "loading libraries index", Cause: err}
+	}
+	if err := lm.RescanLibraries(); err != nil {
+		return nil, &ConfigurationError{Message: "rescanning libraries", Cause: err}
+	}
+	return lm, nil
+}
@@ -0,0 +1,199 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// MirrorCreateReq is the request for MirrorCreate. If the version of a
+// platform or library is not specified the latest is mirrored.
+type MirrorCreateReq struct {
+	Dir       *paths.Path                         // The directory where the mirror is created.
+	BaseURL   *url.URL                            // The URL where Dir will be published, if nil the file:// URL of Dir is used.
+	Platforms []*packagemanager.PlatformReference // The platforms to mirror, together with their tools.
+	Libraries []*librariesindex.Reference         // The libraries to mirror.
+	NoDeps    bool                                // Mirror only the requested libraries, without their dependencies.
+	AllHosts  bool                                // Mirror the tools for all the operating systems, not only the current one.
+}
+
+// MirrorCreateResult is the result of MirrorCreate.
+type MirrorCreateResult struct {
+	PackageIndexURL   *url.URL // The URL of the package index of the mirror.
+	LibrariesIndexURL *url.URL // The URL of the libraries index of the mirror.
+}
+
+// MirrorCreate creates in req.Dir a self-contained copy of the requested
+// platforms, tools and libraries: the archives and a package index and a
+// libraries index pointing to them. Other machines can use the mirror by
+// setting the URLs in the result as board_manager.index_url and
+// library_manager.index_url in their configuration. Archives already in
+// the downloads cache are copied instead of downloaded again.
+func MirrorCreate(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, req *MirrorCreateReq,
+	downloadCB DownloadProgressCB, taskCB TaskProgressCB) (*MirrorCreateResult, error) {
+	if req.Dir == nil {
+		return nil, &InvalidArgumentError{Message: "missing mirror directory"}
+	}
+	dir, err := req.Dir.Abs()
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "invalid mirror directory", Cause: err}
+	}
+	baseURL := req.BaseURL
+	if baseURL == nil {
+		baseURL = resources.FileURL(dir)
+	}
+	archiveURL := func(r *resources.DownloadResource) string {
+		return mirrorURL(baseURL, r.CachePath, r.ArchiveFileName).String()
+	}
+
+	platforms := []*cores.PlatformRelease{}
+	tools := []*cores.ToolRelease{}
+	seenTools := map[*cores.ToolRelease]bool{}
+	for _, ref := range req.Platforms {
+		platform, requiredTools, err := findPlatformReleaseDependencies(pm, ref)
+		if err != nil {
+			return nil, err
+		}
+		platforms = append(platforms, platform)
+		for _, tool := range requiredTools {
+			if !seenTools[tool] {
+				seenTools[tool] = true
+				tools = append(tools, tool)
+			}
+		}
+	}
+	keptFlavors := map[*cores.Flavor]bool{}
+	for _, tool := range tools {
+		compatible := tool.GetCompatibleFlavour()
+		if compatible == nil && !req.AllHosts {
+			return nil, &NotFoundError{Message: fmt.Sprintf("tool %s is not available for the current OS", tool)}
+		}
+		for _, flavor := range tool.Flavors {
+			if req.AllHosts || flavor.Resource == compatible {
+				keptFlavors[flavor] = true
+			}
+		}
+	}
+	keepFlavor := func(flavor *cores.Flavor) bool { return keptFlavors[flavor] }
+
+	libs := []*librariesindex.Release{}
+	if len(req.Libraries) > 0 {
+		if req.NoDeps {
+			for _, ref := range req.Libraries {
+				release, err := findLibraryRelease(lm, ref)
+				if err != nil {
+					return nil, err
+				}
+				libs = append(libs, release)
+			}
+		} else {
+			libs, err = lm.Index.ResolveDependencies(req.Libraries, nil)
+			if err != nil {
+				return nil, &FailedPreconditionError{Message: "resolving library dependencies", Cause: err}
+			}
+		}
+	}
+
+	if err := dir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating mirror directory: %s", err)
+	}
+	for _, platform := range platforms {
+		if err := mirrorArchive(pm.DownloadDir, dir, platform.Resource, platform.String(), downloadCB); err != nil {
+			return nil, err
+		}
+	}
+	for _, tool := range tools {
+		for _, flavor := range tool.Flavors {
+			if !keepFlavor(flavor) {
+				continue
+			}
+			label := tool.String() + " (" + flavor.OS + ")"
+			if err := mirrorArchive(pm.DownloadDir, dir, flavor.Resource, label, downloadCB); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, lib := range libs {
+		if err := mirrorArchive(lm.DownloadsDir, dir, lib.Resource, lib.String(), downloadCB); err != nil {
+			return nil, err
+		}
+	}
+
+	taskCB(&TaskProgress{Name: "Writing mirror indexes"})
+	index := packageindex.IndexFromPlatformReleases(platforms, tools, keepFlavor, archiveURL)
+	if err := index.SaveIndex(dir.Join("package_index.json")); err != nil {
+		return nil, fmt.Errorf("writing package index: %s", err)
+	}
+	if err := librariesindex.SaveIndex(dir.Join("library_index.json"), libs, archiveURL); err != nil {
+		return nil, fmt.Errorf("writing libraries index: %s", err)
+	}
+	taskCB(&TaskProgress{Message: "Mirror created in " + dir.String(), Completed: true})
+
+	return &MirrorCreateResult{
+		PackageIndexURL:   mirrorURL(baseURL, "package_index.json"),
+		LibrariesIndexURL: mirrorURL(baseURL, "library_index.json"),
+	}, nil
+}
+
+// mirrorURL returns the URL of a file of the mirror published at baseURL.
+func mirrorURL(baseURL *url.URL, elem ...string) *url.URL {
+	res := *baseURL
+	res.Path = path.Join(append([]string{res.Path}, elem...)...)
+	return &res
+}
+
+// mirrorArchive puts the archive of r in the mirror, copying it from the
+// downloads cache if available.
+func mirrorArchive(downloadsDir, mirrorDir *paths.Path, r *resources.DownloadResource, label string, downloadCB DownloadProgressCB) error {
+	logrus.WithField("archive", r.ArchiveFileName).Info("Adding archive to mirror")
+	if cached, err := r.TestLocalArchiveIntegrity(downloadsDir); err == nil && cached {
+		if inMirror, err := r.TestLocalArchiveIntegrity(mirrorDir); err != nil || !inMirror {
+			from, err := r.ArchivePath(downloadsDir)
+			if err != nil {
+				return fmt.Errorf("getting archive path: %s", err)
+			}
+			to, err := r.ArchivePath(mirrorDir)
+			if err != nil {
+				return fmt.Errorf("getting archive path: %s", err)
+			}
+			if err := from.CopyTo(to); err != nil {
+				return fmt.Errorf("copying %s to the mirror: %s", r.ArchiveFileName, err)
+			}
+		}
+		return download(nil, label, downloadCB)
+	}
+	d, err := r.Download(mirrorDir)
+	if err != nil {
+		return &NetworkError{Message: "downloading " + label, Cause: err}
+	}
+	if err := download(d, label, downloadCB); err != nil {
+		return &NetworkError{Message: "downloading " + label, Cause: err}
+	}
+	return nil
+}
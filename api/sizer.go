@@ -0,0 +1,69 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"github.com/arduino/arduino-builder/types"
+)
+
+// SizeReport is the memory usage of a compiled sketch as computed by the
+// Sizer of the builder with the recipe.size.pattern of the platform and the
+// recipe.size.regex, recipe.size.regex.data and recipe.size.regex.eeprom
+// properties.
+type SizeReport struct {
+	ProgramSize    int // Flash used by the sketch, in bytes.
+	ProgramMaxSize int // Flash available on the board (upload.maximum_size), 0 if unknown.
+	DataSize       int // RAM used by global variables, -1 if unknown.
+	DataMaxSize    int // RAM available on the board (upload.maximum_data_size), 0 if unknown.
+	EEPROMSize     int // EEPROM used by the sketch, -1 if unknown.
+}
+
+// ProgramExceeded returns true if the sketch doesn't fit in the flash of
+// the board.
+func (r *SizeReport) ProgramExceeded() bool {
+	return r.ProgramMaxSize > 0 && r.ProgramSize > r.ProgramMaxSize
+}
+
+// DataExceeded returns true if the global variables don't fit in the RAM
+// of the board.
+func (r *SizeReport) DataExceeded() bool {
+	return r.DataMaxSize > 0 && r.DataSize > r.DataMaxSize
+}
+
+// newSizeReport returns the memory usage measured by the Sizer, nil if it
+// didn't run: the Sizer runs only for the boards defining
+// upload.maximum_size and the platforms defining a size recipe.
+func newSizeReport(sections types.ExecutablesFileSections) *SizeReport {
+	if len(sections) == 0 {
+		return nil
+	}
+	report := &SizeReport{DataSize: -1, EEPROMSize: -1}
+	for _, section := range sections {
+		switch section.Name {
+		case "text":
+			report.ProgramSize = section.Size
+			report.ProgramMaxSize = section.MaxSize
+		case "data":
+			report.DataSize = section.Size
+			report.DataMaxSize = section.MaxSize
+		case "eeprom":
+			report.EEPROMSize = section.Size
+		}
+	}
+	return report
+}
@@ -0,0 +1,51 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-builder/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSizeReport(t *testing.T) {
+	report := newSizeReport(types.ExecutablesFileSections{
+		{Name: "text", Size: 1200, MaxSize: 1024},
+		{Name: "data", Size: 300, MaxSize: 2048},
+		{Name: "eeprom", Size: 16},
+	})
+	require.Equal(t, &SizeReport{
+		ProgramSize:    1200,
+		ProgramMaxSize: 1024,
+		DataSize:       300,
+		DataMaxSize:    2048,
+		EEPROMSize:     16,
+	}, report)
+	require.True(t, report.ProgramExceeded())
+	require.False(t, report.DataExceeded())
+
+	// recipe.size.regex.data and recipe.size.regex.eeprom are optional
+	report = newSizeReport(types.ExecutablesFileSections{{Name: "text", Size: 900, MaxSize: 1024}})
+	require.Equal(t, &SizeReport{ProgramSize: 900, ProgramMaxSize: 1024, DataSize: -1, EEPROMSize: -1}, report)
+	require.False(t, report.ProgramExceeded())
+	require.False(t, report.DataExceeded())
+
+	// The size is not known if the Sizer doesn't run
+	require.Nil(t, newSizeReport(nil))
+}
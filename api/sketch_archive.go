@@ -0,0 +1,425 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"archive/zip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// sketchMetadataFile is the file where the board attached to a sketch is
+// saved, see `board attach`.
+const sketchMetadataFile = "sketch.json"
+
+// archiveLibrariesDir is the folder of a sketch archive containing the
+// libraries used by the sketch.
+const archiveLibrariesDir = "libraries"
+
+// buildOutputExtensions are the extensions of the compiled binaries copied
+// in the sketch folder by Compile.
+var buildOutputExtensions = []string{".hex", ".bin", ".elf", ".eep", ".uf2"}
+
+// SketchArchiveReq is the request for SketchArchive.
+type SketchArchiveReq struct {
+	SketchPath       *paths.Path
+	ArchivePath      *paths.Path // The zip file to create, if nil it's <sketch name>.zip next to the sketch folder.
+	FQBN             string      // Fully Qualified Board Name, if empty the one attached to the sketch is used.
+	IncludeBuild     bool        // Include the compiled binaries exported in the sketch folder.
+	IncludeMetadata  bool        // Include sketch.json and a lockfile of the platforms, tools and libraries used.
+	IncludeLibraries bool        // Include a copy of the sketchbook libraries used by the sketch.
+}
+
+// SketchArchiveResult is the result of SketchArchive.
+type SketchArchiveResult struct {
+	ArchivePath *paths.Path
+	Lockfile    *sketches.Lockfile // The lockfile added to the archive, nil if not included.
+	Libraries   []*libraries.Library
+}
+
+// SketchArchive creates a zip archive of the sketch folder. The sketch is
+// stored in a folder named as the sketch, the libraries, if requested, in
+// the "libraries" folder alongside it. Including the metadata or the
+// libraries requires an FQBN to detect the libraries used by the sketch, the
+// output of the builder is written to stdout and stderr.
+func SketchArchive(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	req *SketchArchiveReq, stdout, stderr io.Writer) (*SketchArchiveResult, error) {
+	if req.SketchPath == nil {
+		return nil, &InvalidArgumentError{Message: "missing sketch path"}
+	}
+	sketchPath, err := req.SketchPath.Abs()
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "opening sketch", Cause: err}
+	}
+	if !sketchPath.IsDir() {
+		return nil, &NotFoundError{Message: fmt.Sprintf("sketch folder %s not found", sketchPath)}
+	}
+	sketchName := sketchPath.Base()
+
+	res := &SketchArchiveResult{ArchivePath: req.ArchivePath, Libraries: []*libraries.Library{}}
+	if res.ArchivePath == nil {
+		res.ArchivePath = sketchPath.Parent().Join(sketchName + ".zip")
+	}
+	if res.ArchivePath, err = res.ArchivePath.Abs(); err != nil {
+		return nil, &InvalidArgumentError{Message: "invalid archive path", Cause: err}
+	}
+	if inside, _ := res.ArchivePath.IsInsideDir(sketchPath); inside {
+		return nil, &InvalidArgumentError{Message: "the archive can't be created inside the sketch folder"}
+	}
+
+	hasFQBN := req.FQBN != ""
+	if sketch, err := sketches.NewSketchFromPath(sketchPath); err == nil && sketch.Metadata.CPU.Fqbn != "" {
+		hasFQBN = true
+	}
+	if req.IncludeLibraries || (req.IncludeMetadata && hasFQBN) {
+		ctx, err := detectSketchDependencies(pm, config, &CompileReq{SketchPath: sketchPath, FQBN: req.FQBN}, stdout, stderr)
+		if err != nil {
+			return nil, err
+		}
+		if req.IncludeMetadata {
+			res.Lockfile = newSketchLockfile(ctx, lm)
+		}
+		if req.IncludeLibraries {
+			for _, lib := range ctx.ImportedLibraries {
+				if lib.Location == libraries.Sketchbook {
+					res.Libraries = append(res.Libraries, lib)
+				}
+			}
+		}
+	}
+
+	if err := res.ArchivePath.Parent().MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating archive folder: %s", err)
+	}
+	file, err := os.Create(res.ArchivePath.String())
+	if err != nil {
+		return nil, fmt.Errorf("creating archive: %s", err)
+	}
+	archive := zip.NewWriter(file)
+	err = writeSketchArchive(archive, sketchPath, req, res)
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		res.ArchivePath.Remove()
+		return nil, fmt.Errorf("creating archive: %s", err)
+	}
+	return res, nil
+}
+
+func writeSketchArchive(archive *zip.Writer, sketchPath *paths.Path, req *SketchArchiveReq, res *SketchArchiveResult) error {
+	sketchName := sketchPath.Base()
+	skip := func(file *paths.Path) bool {
+		if file.Parent().EqualsTo(sketchPath) {
+			switch file.Base() {
+			case sketches.LockfileName:
+				// The lockfile, if requested, is generated again
+				return true
+			case sketchMetadataFile:
+				return !req.IncludeMetadata
+			}
+			if !req.IncludeBuild && strings.HasPrefix(file.Base(), sketchName+".") && file.HasSuffix(buildOutputExtensions...) {
+				return true
+			}
+		}
+		return false
+	}
+	if err := addDirToArchive(archive, sketchPath, sketchName, skip); err != nil {
+		return err
+	}
+
+	if res.Lockfile != nil {
+		data, err := res.Lockfile.Encode()
+		if err != nil {
+			return err
+		}
+		w, err := archive.CreateHeader(&zip.FileHeader{
+			Name:     path.Join(sketchName, sketches.LockfileName),
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+
+	for _, lib := range res.Libraries {
+		logrus.WithField("library", lib).Info("Adding library to sketch archive")
+		dir := path.Join(archiveLibrariesDir, lib.InstallDir.Base())
+		if err := addDirToArchive(archive, lib.InstallDir, dir, func(*paths.Path) bool { return false }); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addDirToArchive adds the content of dir to the archive in the prefix
+// folder, hidden files and the files selected by skip are not added.
+func addDirToArchive(archive *zip.Writer, dir *paths.Path, prefix string, skip func(*paths.Path) bool) error {
+	files, err := dir.ReadDir()
+	if err != nil {
+		return err
+	}
+	files.FilterOutHiddenFiles()
+	files.Sort()
+	for _, file := range files {
+		if skip(file) {
+			continue
+		}
+		name := path.Join(prefix, file.Base())
+		if file.IsDir() {
+			if err := addDirToArchive(archive, file, name, skip); err != nil {
+				return err
+			}
+			continue
+		}
+		info, err := file.Stat()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = name
+		header.Method = zip.Deflate
+		w, err := archive.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		data, err := file.ReadFile()
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SketchImportReq is the request for SketchImport.
+type SketchImportReq struct {
+	ArchivePath *paths.Path // A sketch archive created by SketchArchive.
+}
+
+// SketchImportResult is the result of SketchImport.
+type SketchImportResult struct {
+	SketchPath *paths.Path
+	// Libraries are the folders of the libraries installed in the sketchbook
+	// from the archive.
+	Libraries paths.PathList
+}
+
+// SketchImport unpacks a sketch archive in the sketchbook. The libraries in
+// the archive are installed unless a library with the same folder name is
+// already installed. Then the platforms, tools and libraries recorded in the
+// lockfile of the sketch are installed if missing, see SketchLockInstall, or
+// if there's no lockfile the platform of the board attached to the sketch.
+func SketchImport(pm *packagemanager.PackageManager, lm *librariesmanager.LibrariesManager, config *configs.Configuration,
+	req *SketchImportReq, downloadCB DownloadProgressCB, taskCB TaskProgressCB) (*SketchImportResult, error) {
+	if req.ArchivePath == nil {
+		return nil, &InvalidArgumentError{Message: "missing archive path"}
+	}
+	if config.SketchbookDir == nil {
+		return nil, &ConfigurationError{Message: "sketchbook folder not set"}
+	}
+	archive, err := zip.OpenReader(req.ArchivePath.String())
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "opening sketch archive", Cause: err}
+	}
+	defer archive.Close()
+
+	sketchName, err := findArchivedSketch(archive.File)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "invalid sketch archive", Cause: err}
+	}
+	res := &SketchImportResult{SketchPath: config.SketchbookDir.Join(sketchName), Libraries: paths.PathList{}}
+	if res.SketchPath.Exist() {
+		return nil, &FailedPreconditionError{Message: fmt.Sprintf("sketch %s already exists in the sketchbook", sketchName)}
+	}
+
+	if err := config.SketchbookDir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating sketchbook folder: %s", err)
+	}
+	tmp, err := config.SketchbookDir.MkTempDir(".import-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp folder: %s", err)
+	}
+	defer tmp.RemoveAll()
+	taskCB(&TaskProgress{Name: "Importing " + sketchName})
+	if err := extractArchive(archive.File, tmp); err != nil {
+		return nil, fmt.Errorf("extracting sketch archive: %s", err)
+	}
+	if err := tmp.Join(sketchName).Rename(res.SketchPath); err != nil {
+		return nil, fmt.Errorf("moving sketch to the sketchbook: %s", err)
+	}
+	taskCB(&TaskProgress{Message: sketchName + " unpacked", Completed: true})
+
+	if archivedLibs, err := tmp.Join(archiveLibrariesDir).ReadDir(); err == nil {
+		archivedLibs.FilterDirs()
+		archivedLibs.Sort()
+		librariesDir := config.LibrariesDir()
+		for _, lib := range archivedLibs {
+			installDir := librariesDir.Join(lib.Base())
+			if installDir.Exist() {
+				taskCB(&TaskProgress{Name: "Library " + lib.Base() + " already installed", Completed: true})
+				continue
+			}
+			logrus.WithField("library", lib.Base()).Info("Installing library from sketch archive")
+			taskCB(&TaskProgress{Name: "Installing library " + lib.Base()})
+			if err := librariesDir.MkdirAll(); err != nil {
+				return nil, fmt.Errorf("creating libraries folder: %s", err)
+			}
+			if err := lib.Rename(installDir); err != nil {
+				return nil, fmt.Errorf("installing library %s: %s", lib.Base(), err)
+			}
+			res.Libraries.Add(installDir)
+			taskCB(&TaskProgress{Message: "Installed library " + lib.Base(), Completed: true})
+		}
+		if len(res.Libraries) > 0 {
+			if err := lm.RescanLibraries(); err != nil {
+				return nil, &ConfigurationError{Message: "rescanning libraries", Cause: err}
+			}
+		}
+	}
+
+	if res.SketchPath.Join(sketches.LockfileName).Exist() {
+		err := SketchLockInstall(pm, lm, config, &SketchLockInstallReq{SketchPath: res.SketchPath}, downloadCB, taskCB)
+		if err != nil {
+			return nil, err
+		}
+		return res, nil
+	}
+	sketch, err := sketches.NewSketchFromPath(res.SketchPath)
+	if err != nil || sketch.Metadata.CPU.Fqbn == "" {
+		return res, nil
+	}
+	fqbn, err := cores.ParseFQBN(sketch.Metadata.CPU.Fqbn)
+	if err != nil {
+		return nil, &InvalidArgumentError{Message: "invalid FQBN in sketch metadata", Cause: err}
+	}
+	ref := &packagemanager.PlatformReference{
+		Package:              fqbn.Package,
+		PlatformArchitecture: fqbn.PlatformArch,
+		PlatformVersion:      fqbn.PlatformVersion,
+	}
+	if platform := pm.FindPlatform(ref); platform != nil {
+		installed := pm.GetInstalledPlatformRelease(platform) != nil
+		if installed && ref.PlatformVersion != nil {
+			release := platform.FindReleaseWithVersion(ref.PlatformVersion)
+			installed = release != nil && release.IsInstalled()
+		}
+		if installed {
+			return res, nil
+		}
+	}
+	if err := PlatformInstall(pm, &PlatformInstallReq{Platform: ref}, downloadCB, taskCB); err != nil {
+		return nil, err
+	}
+	if err := pm.LoadHardware(config); err != nil {
+		return nil, &ConfigurationError{Message: "loading hardware packages", Cause: err}
+	}
+	return res, nil
+}
+
+// findArchivedSketch returns the name of the sketch folder in a sketch
+// archive: the only root folder other than the libraries folder.
+func findArchivedSketch(files []*zip.File) (string, error) {
+	sketchName := ""
+	for _, file := range files {
+		root := strings.SplitN(path.Clean(file.Name), "/", 2)[0]
+		if root == archiveLibrariesDir || root == "." {
+			continue
+		}
+		if !strings.Contains(strings.TrimSuffix(file.Name, "/"), "/") && !file.FileInfo().IsDir() {
+			return "", fmt.Errorf("unexpected file %s in the root of the archive", file.Name)
+		}
+		if sketchName != "" && root != sketchName {
+			return "", fmt.Errorf("more than one sketch folder: %s, %s", sketchName, root)
+		}
+		sketchName = root
+	}
+	if sketchName == "" {
+		return "", fmt.Errorf("no sketch folder found")
+	}
+	return sketchName, nil
+}
+
+// extractArchive extracts the files of a zip archive in destDir.
+func extractArchive(files []*zip.File, destDir *paths.Path) error {
+	for _, file := range files {
+		name := path.Clean(file.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return fmt.Errorf("invalid file name in archive: %s", file.Name)
+		}
+		dest := destDir.Join(name)
+		if file.FileInfo().IsDir() {
+			if err := dest.MkdirAll(); err != nil {
+				return err
+			}
+			continue
+		}
+		if !file.Mode().IsRegular() {
+			return fmt.Errorf("unsupported file type in archive: %s", file.Name)
+		}
+		if err := dest.Parent().MkdirAll(); err != nil {
+			return err
+		}
+		if err := extractArchiveFile(file, dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extractArchiveFile(file *zip.File, dest *paths.Path) error {
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w, err := os.OpenFile(dest.String(), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, file.Mode().Perm()|0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return err
+	}
+	return w.Close()
+}
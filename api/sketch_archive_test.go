@@ -0,0 +1,127 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"archive/zip"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configs"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSketchArchiveAndImport(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch_archive_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	sketchPath := tmp.Join("MySketch")
+	require.NoError(t, sketchPath.Join("data").MkdirAll())
+	require.NoError(t, sketchPath.Join("MySketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketchPath.Join("data", "config.txt").WriteFile([]byte("config")))
+	require.NoError(t, sketchPath.Join("sketch.json").WriteFile([]byte("{}")))
+	require.NoError(t, sketchPath.Join("MySketch.arduino.avr.uno.hex").WriteFile([]byte("hex")))
+	require.NoError(t, sketchPath.Join(".hidden").WriteFile([]byte("hidden")))
+
+	archiveContent := func(archivePath *paths.Path) []string {
+		archive, err := zip.OpenReader(archivePath.String())
+		require.NoError(t, err)
+		defer archive.Close()
+		files := []string{}
+		for _, file := range archive.File {
+			files = append(files, file.Name)
+		}
+		sort.Strings(files)
+		return files
+	}
+
+	res, err := SketchArchive(nil, nil, nil, &SketchArchiveReq{SketchPath: sketchPath}, os.Stdout, os.Stderr)
+	require.NoError(t, err)
+	require.Equal(t, tmp.Join("MySketch.zip").String(), res.ArchivePath.String())
+	require.Nil(t, res.Lockfile)
+	require.Equal(t, []string{"MySketch/MySketch.ino", "MySketch/data/config.txt"}, archiveContent(res.ArchivePath))
+
+	res, err = SketchArchive(nil, nil, nil, &SketchArchiveReq{
+		SketchPath:      sketchPath,
+		ArchivePath:     tmp.Join("full.zip"),
+		IncludeBuild:    true,
+		IncludeMetadata: true,
+	}, os.Stdout, os.Stderr)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"MySketch/MySketch.arduino.avr.uno.hex",
+		"MySketch/MySketch.ino",
+		"MySketch/data/config.txt",
+		"MySketch/sketch.json",
+	}, archiveContent(res.ArchivePath))
+
+	_, err = SketchArchive(nil, nil, nil, &SketchArchiveReq{
+		SketchPath:  sketchPath,
+		ArchivePath: sketchPath.Join("MySketch.zip"),
+	}, os.Stdout, os.Stderr)
+	require.IsType(t, &InvalidArgumentError{}, err)
+
+	config := &configs.Configuration{SketchbookDir: tmp.Join("sketchbook")}
+	imported, err := SketchImport(nil, nil, config, &SketchImportReq{ArchivePath: tmp.Join("full.zip")},
+		func(*DownloadProgress) {}, func(*TaskProgress) {})
+	require.NoError(t, err)
+	require.Equal(t, config.SketchbookDir.Join("MySketch").String(), imported.SketchPath.String())
+	require.Empty(t, imported.Libraries)
+	data, err := imported.SketchPath.Join("data", "config.txt").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "config", string(data))
+	require.True(t, imported.SketchPath.Join("sketch.json").Exist())
+	require.False(t, config.SketchbookDir.Join("libraries").Exist())
+
+	_, err = SketchImport(nil, nil, config, &SketchImportReq{ArchivePath: tmp.Join("full.zip")},
+		func(*DownloadProgress) {}, func(*TaskProgress) {})
+	require.IsType(t, &FailedPreconditionError{}, err)
+}
+
+func TestSketchImportInvalidArchive(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "sketch_import_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	config := &configs.Configuration{SketchbookDir: tmp.Join("sketchbook")}
+
+	importArchive := func(files ...string) error {
+		archivePath := tmp.Join("archive.zip")
+		file, err := os.Create(archivePath.String())
+		require.NoError(t, err)
+		archive := zip.NewWriter(file)
+		for _, name := range files {
+			_, err := archive.Create(name)
+			require.NoError(t, err)
+		}
+		require.NoError(t, archive.Close())
+		require.NoError(t, file.Close())
+		_, err = SketchImport(nil, nil, config, &SketchImportReq{ArchivePath: archivePath},
+			func(*DownloadProgress) {}, func(*TaskProgress) {})
+		return err
+	}
+
+	require.Error(t, importArchive("libraries/Foo/Foo.h"))
+	require.Error(t, importArchive("First/First.ino", "Second/Second.ino"))
+	require.Error(t, importArchive("First/First.ino", "README"))
+	require.Error(t, importArchive("Evil/Evil.ino", "../evil.txt"))
+	require.False(t, tmp.Join("evil.txt").Exist())
+	require.False(t, config.SketchbookDir.Join("Evil").Exist())
+}
@@ -0,0 +1,515 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/executils"
+	paths "github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+	serial "go.bug.st/serial.v1"
+)
+
+// UploadReq is the request for Upload.
+type UploadReq struct {
+	SketchPath *paths.Path // The sketch to upload.
+	FQBN       string      // Fully Qualified Board Name, if empty the one attached to the sketch is used.
+	Port       string      // The upload port, e.g.: COM10 or /dev/ttyACM0.
+	Verbose    bool        // Turns on the verbose output of the upload tool.
+	Verify     bool        // Verify the uploaded binary after the upload.
+	ImportFile *paths.Path // The file to upload, if nil the binary exported by Compile in the sketch folder is used.
+	Programmer string      // Upload with this programmer instead of the bootloader, e.g.: avrispmkii or arduino:usbasp.
+	Password   string      // The password of the board, used by the uploads over the network.
+}
+
+// Upload uploads a compiled sketch to a board. The output of the upload
+// tool is written to stdout and stderr. If a programmer is specified the
+// program.pattern recipe is used instead of upload.pattern, in this case
+// the port is required only by the programmers connected to a serial port.
+//
+// If the port is a network address, e.g.: 192.168.1.5, 192.168.1.5:65280
+// or network://myboard.local:65280, the sketch is uploaded over the network
+// with the upload.network_pattern recipe of the upload.tool.network tool
+// (or of the upload.tool if the board doesn't define one). The password is
+// required if the board or the tool set upload.network.auth=true.
+func Upload(pm *packagemanager.PackageManager, req *UploadReq, stdout, stderr io.Writer) error {
+	if req.SketchPath == nil {
+		return &InvalidArgumentError{Message: "missing sketch path"}
+	}
+	sketch, err := sketches.NewSketchFromPath(req.SketchPath)
+	if err != nil {
+		return &InvalidArgumentError{Message: "opening sketch", Cause: err}
+	}
+
+	// FIXME: make a specification on how a port is specified via command line
+	port := req.Port
+	if port == "" && req.Programmer == "" {
+		return &InvalidArgumentError{Message: "no upload port provided"}
+	}
+
+	fqbnIn := req.FQBN
+	if fqbnIn == "" && sketch != nil {
+		fqbnIn = sketch.Metadata.CPU.Fqbn
+	}
+	fqbn, board, boardProperties, buildPlatformRelease, err := resolveUploadFQBN(pm, fqbnIn)
+	if err != nil {
+		return err
+	}
+
+	// The recipes of the upload with a programmer are prefixed by
+	// "program." instead of "upload."
+	action := "upload"
+	toolKey := "upload.tool"
+	recipeID := "upload.pattern"
+	var programmer *properties.Map
+	networkHost, networkPort, isNetwork := parseNetworkPort(port)
+	if req.Programmer != "" {
+		action = "program"
+		toolKey = "program.tool"
+		recipeID = "program.pattern"
+		isNetwork = false
+		if programmer, err = findProgrammer(pm, board, buildPlatformRelease, req.Programmer); err != nil {
+			return err
+		}
+	} else if isNetwork {
+		if _, ok := boardProperties.GetOk("upload.tool.network"); ok {
+			toolKey = "upload.tool.network"
+		}
+		recipeID = "upload.network_pattern"
+	}
+	uploadProperties, err := uploadRecipeProperties(pm, board, boardProperties, programmer, toolKey)
+	if err != nil {
+		return err
+	}
+	if isNetwork && uploadProperties.Get(recipeID) == "" {
+		return &FailedPreconditionError{Message: fmt.Sprintf("board %s doesn't support the upload over the network", board)}
+	}
+	if isNetwork && req.Password == "" && uploadProperties.GetBoolean("upload.network.auth") {
+		return &InvalidArgumentError{Message: fmt.Sprintf("board %s requires a password for the upload over the network", board)}
+	}
+	setVerboseAndVerifyProperties(uploadProperties, req.Verbose, req.Verify, action)
+
+	// Set path to compiled binary
+	// Make the filename without the FQBN configs and platform version parts
+	fqbn.Configs = properties.NewMap()
+	fqbn.PlatformVersion = nil
+	fqbnSuffix := strings.Replace(fqbn.String(), ":", ".", -1)
+	ext := filepath.Ext(uploadProperties.ExpandPropsInString("{recipe.output.tmp_file}"))
+
+	var importPath *paths.Path
+	var importFile string
+	if req.ImportFile == nil {
+		importPath = paths.New(sketch.FullPath)
+		importFile = sketch.Name + "." + fqbnSuffix
+	} else {
+		importPath = req.ImportFile.Parent()
+		importFile = req.ImportFile.Base()
+		if strings.HasSuffix(importFile, ext) {
+			importFile = importFile[:len(importFile)-len(ext)]
+		}
+	}
+
+	uploadProperties.SetPath("build.path", importPath)
+	uploadProperties.Set("build.project_name", importFile)
+	if !importPath.Join(importFile + ext).Exist() {
+		return &FailedPreconditionError{
+			Message: fmt.Sprintf("compiled sketch not found in %s, please compile first", importPath.Join(importFile+ext)),
+		}
+	}
+
+	// Perform reset via 1200bps touch if requested
+	if programmer == nil && !isNetwork && uploadProperties.GetBoolean("upload.use_1200bps_touch") {
+		ports, err := serial.GetPortsList()
+		if err != nil {
+			return fmt.Errorf("getting serial port list: %s", err)
+		}
+		for _, p := range ports {
+			if p == port {
+				if err := touchSerialPortAt1200bps(p); err != nil {
+					return fmt.Errorf("performing reset via 1200bps-touch on serial port: %s", err)
+				}
+				break
+			}
+		}
+
+		// Scanning for available ports seems to open the port or
+		// otherwise assert DTR, which would cancel the WDT reset if
+		// it happened within 250 ms. So we wait until the reset should
+		// have already occurred before we start scanning.
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	// Wait for upload port if requested
+	actualPort := port // default
+	if programmer == nil && !isNetwork && uploadProperties.GetBoolean("upload.wait_for_upload_port") {
+		if p, err := waitForNewSerialPort(); err != nil {
+			return fmt.Errorf("detecting serial ports: %s", err)
+		} else if p == "" {
+			fmt.Fprintln(stdout, "No new serial port detected.")
+		} else {
+			actualPort = p
+		}
+
+		// on OS X, if the port is opened too quickly after it is detected,
+		// a "Resource busy" error occurs, add a delay to workaround.
+		// This apply to other platforms as well.
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	if isNetwork {
+		// The recipes of the network uploads use serial.port for the
+		// address of the board, as the Arduino IDE does.
+		setSerialPortProperties(uploadProperties, networkHost)
+		if networkPort != "" {
+			uploadProperties.Set("network.port", networkPort)
+		} else if strings.Contains(uploadProperties.Get(recipeID), "{network.port}") {
+			return &InvalidArgumentError{Message: fmt.Sprintf("missing TCP port of the network board, use %s:<port>", networkHost)}
+		}
+		uploadProperties.Set("network.password", req.Password)
+	} else {
+		setSerialPortProperties(uploadProperties, actualPort)
+	}
+
+	return runRecipe(uploadProperties, recipeID, "uploading error", stdout, stderr)
+}
+
+// parseNetworkPort returns the host and the TCP port, if any, of an upload
+// port specified as an IP address or with the network:// scheme. It
+// returns false if the upload port is not a network port.
+func parseNetworkPort(port string) (string, string, bool) {
+	address := strings.TrimPrefix(port, "network://")
+	host, tcpPort, err := net.SplitHostPort(address)
+	if err != nil {
+		host, tcpPort = address, ""
+	}
+	if address == port && net.ParseIP(host) == nil {
+		return "", "", false
+	}
+	return host, tcpPort, host != ""
+}
+
+// BurnBootloaderReq is the request for BurnBootloader.
+type BurnBootloaderReq struct {
+	FQBN       string // Fully Qualified Board Name of the board to provision.
+	Port       string // The port of the programmer, needed only by the programmers connected to a serial port.
+	Programmer string // The programmer to use, e.g.: avrispmkii or arduino:usbasp.
+	Verbose    bool   // Turns on the verbose output of the tool.
+	Verify     bool   // Verify the bootloader after writing it.
+}
+
+// BurnBootloader writes the bootloader of a board and sets its fuses, as
+// defined in boards.txt, by running the erase.pattern and bootloader.pattern
+// recipes. The output of the tool is written to stdout and stderr.
+func BurnBootloader(pm *packagemanager.PackageManager, req *BurnBootloaderReq, stdout, stderr io.Writer) error {
+	_, board, boardProperties, buildPlatformRelease, err := resolveUploadFQBN(pm, req.FQBN)
+	if err != nil {
+		return err
+	}
+	var programmer *properties.Map
+	if req.Programmer != "" {
+		if programmer, err = findProgrammer(pm, board, buildPlatformRelease, req.Programmer); err != nil {
+			return err
+		}
+	}
+	bootloaderProperties, err := uploadRecipeProperties(pm, board, boardProperties, programmer, "bootloader.tool")
+	if err != nil {
+		return err
+	}
+	setVerboseAndVerifyProperties(bootloaderProperties, req.Verbose, req.Verify, "erase")
+	setVerboseAndVerifyProperties(bootloaderProperties, req.Verbose, req.Verify, "bootloader")
+	setSerialPortProperties(bootloaderProperties, req.Port)
+
+	if _, ok := bootloaderProperties.GetOk("erase.pattern"); ok {
+		if err := runRecipe(bootloaderProperties, "erase.pattern", "erasing error", stdout, stderr); err != nil {
+			return err
+		}
+	}
+	return runRecipe(bootloaderProperties, "bootloader.pattern", "burning bootloader error", stdout, stderr)
+}
+
+// resolveUploadFQBN parses the FQBN and finds the board, its properties
+// and the platform release providing its core.
+func resolveUploadFQBN(pm *packagemanager.PackageManager, fqbnIn string) (*cores.FQBN, *cores.Board, *properties.Map, *cores.PlatformRelease, error) {
+	if fqbnIn == "" {
+		return nil, nil, nil, nil, &InvalidArgumentError{Message: "no Fully Qualified Board Name provided"}
+	}
+	fqbn, err := cores.ParseFQBN(fqbnIn)
+	if err != nil {
+		return nil, nil, nil, nil, &InvalidArgumentError{Message: "incorrect FQBN", Cause: err}
+	}
+
+	// Find target board and board properties
+	_, _, board, boardProperties, buildPlatformRelease, err := pm.ResolveFQBN(fqbn)
+	if err != nil {
+		return nil, nil, nil, nil, &NotFoundError{Message: "incorrect FQBN", Cause: err}
+	}
+	return fqbn, board, boardProperties, buildPlatformRelease, nil
+}
+
+// findProgrammer returns the properties of a programmer, looked up in the
+// platform of the board and then in the platform providing its core. An ID
+// like "arduino:avrispmkii" refers to a programmer of the platform with the
+// same architecture in another package.
+func findProgrammer(pm *packagemanager.PackageManager, board *cores.Board, buildPlatformRelease *cores.PlatformRelease,
+	programmerID string) (*properties.Map, error) {
+	var candidates []*cores.PlatformRelease
+	id := programmerID
+	if split := strings.Split(programmerID, ":"); len(split) == 2 {
+		id = split[1]
+		if referencedPackage := pm.GetPackages().Packages[split[0]]; referencedPackage != nil {
+			referencedPlatform := referencedPackage.Platforms[board.PlatformRelease.Platform.Architecture]
+			if referencedPlatform != nil {
+				if release := pm.GetInstalledPlatformRelease(referencedPlatform); release != nil {
+					candidates = append(candidates, release)
+				}
+			}
+		}
+	} else if len(split) == 1 {
+		candidates = append(candidates, board.PlatformRelease)
+		if buildPlatformRelease != nil {
+			candidates = append(candidates, buildPlatformRelease)
+		}
+	} else {
+		return nil, &InvalidArgumentError{Message: "invalid programmer " + programmerID}
+	}
+
+	for _, platformRelease := range candidates {
+		if programmer, ok := platformRelease.Programmers[id]; ok {
+			return programmer, nil
+		}
+	}
+	return nil, &NotFoundError{Message: fmt.Sprintf("programmer %s not found", programmerID)}
+}
+
+// uploadRecipeProperties builds the properties for the upload recipes: the
+// ones of the platform, of the board and of the programmer, if not nil,
+// together with the ones of the tool specified in the toolKey property.
+func uploadRecipeProperties(pm *packagemanager.PackageManager, board *cores.Board, boardProperties, programmer *properties.Map,
+	toolKey string) (*properties.Map, error) {
+	toolProperties := boardProperties.Clone()
+	if programmer != nil {
+		toolProperties.Merge(programmer)
+	}
+
+	// Load programmer tool
+	uploadToolID, have := toolProperties.GetOk(toolKey)
+	if !have || uploadToolID == "" {
+		return nil, &ConfigurationError{Message: fmt.Sprintf("cannot get programmer tool: undefined '%s' property", toolKey)}
+	}
+
+	var referencedPlatformRelease *cores.PlatformRelease
+	var uploadTool *cores.Tool
+	if split := strings.Split(uploadToolID, ":"); len(split) == 1 {
+		uploadTool = board.PlatformRelease.Platform.Package.Tools[uploadToolID]
+	} else if len(split) == 2 {
+		referencedPackage := pm.GetPackages().Packages[split[0]]
+		if referencedPackage == nil {
+			return nil, &FailedPreconditionError{
+				Message: fmt.Sprintf("required tool %s from a package not installed: %s", uploadToolID, split[0]),
+			}
+		}
+		uploadTool = referencedPackage.Tools[split[1]]
+
+		referencedPlatform := referencedPackage.Platforms[board.PlatformRelease.Platform.Architecture]
+		if referencedPlatform != nil {
+			referencedPlatformRelease = pm.GetInstalledPlatformRelease(referencedPlatform)
+		}
+	} else {
+		return nil, &ConfigurationError{Message: fmt.Sprintf("invalid '%s' property: %s", toolKey, uploadToolID)}
+	}
+	if uploadTool == nil {
+		return nil, &NotFoundError{Message: fmt.Sprintf("upload tool %s not found", uploadToolID)}
+	}
+
+	// Use the release of the tool selected for the board, the tools are
+	// resolved by name so another package may provide the selected one.
+	requiredTools, err := pm.ResolveToolsForBoard(board)
+	if err != nil {
+		return nil, &FailedPreconditionError{Message: "resolving tools", Cause: err}
+	}
+	var uploadToolRelease *cores.ToolRelease
+	for _, requiredTool := range requiredTools {
+		if requiredTool.Release.Tool == uploadTool {
+			uploadToolRelease = requiredTool.Release
+		} else if requiredTool.Release.Tool.Name == uploadTool.Name {
+			return nil, &FailedPreconditionError{
+				Message: fmt.Sprintf("upload tool %s conflicts with %s selected for platform %s",
+					uploadToolID, requiredTool.Release, board.PlatformRelease),
+			}
+		}
+	}
+	if uploadToolRelease == nil {
+		return nil, &FailedPreconditionError{
+			Message: fmt.Sprintf("upload tool %s required by platform %s is not installed", uploadToolID, board.PlatformRelease),
+		}
+	}
+
+	// Build configuration for upload
+	uploadProperties := properties.NewMap()
+	if referencedPlatformRelease != nil {
+		uploadProperties.Merge(referencedPlatformRelease.Properties)
+	}
+	uploadProperties.Merge(board.PlatformRelease.Properties)
+	uploadProperties.Merge(board.PlatformRelease.RuntimeProperties())
+	uploadProperties.Merge(toolProperties)
+
+	uploadToolProperties := uploadProperties.SubTree("tools." + uploadTool.Name)
+	uploadProperties.Merge(uploadToolProperties)
+
+	for _, requiredTool := range requiredTools {
+		uploadProperties.Merge(requiredTool.Release.RuntimeProperties())
+	}
+	uploadProperties.Merge(uploadToolRelease.RuntimeProperties())
+	return uploadProperties, nil
+}
+
+// setVerboseAndVerifyProperties sets the <action>.verbose and
+// <action>.verify properties used by the recipes from the corresponding
+// <action>.params.* properties.
+func setVerboseAndVerifyProperties(props *properties.Map, verbose, verify bool, action string) {
+	if verbose {
+		if v, ok := props.GetOk(action + ".params.verbose"); ok {
+			props.Set(action+".verbose", v)
+		}
+	} else {
+		if v, ok := props.GetOk(action + ".params.quiet"); ok {
+			props.Set(action+".verbose", v)
+		}
+	}
+
+	if verify {
+		props.Set(action+".verify", props.Get(action+".params.verify"))
+	} else {
+		props.Set(action+".verify", props.Get(action+".params.noverify"))
+	}
+}
+
+// setSerialPortProperties sets the serial.port properties used by the
+// recipes, nothing is set if port is empty.
+func setSerialPortProperties(props *properties.Map, port string) {
+	if port == "" {
+		return
+	}
+	props.Set("serial.port", port)
+	if strings.HasPrefix(port, "/dev/") {
+		props.Set("serial.port.file", port[5:])
+	} else {
+		props.Set("serial.port.file", port)
+	}
+}
+
+// runRecipe expands the given recipe and runs it, a failure of the tool is
+// reported prefixed by errMsg.
+func runRecipe(props *properties.Map, recipeID, errMsg string, stdout, stderr io.Writer) error {
+	recipe, ok := props.GetOk(recipeID)
+	if !ok || recipe == "" {
+		return &ConfigurationError{Message: fmt.Sprintf("undefined '%s' recipe in platform", recipeID)}
+	}
+	cmdLine := props.ExpandPropsInString(recipe)
+	cmdArgs, err := properties.SplitQuotedString(cmdLine, `"'`, false)
+	if err != nil {
+		return &ConfigurationError{Message: "invalid recipe in platform", Cause: err}
+	}
+
+	// Run Tool
+	logrus.WithField("recipe", recipeID).Infof("Running %s", cmdLine)
+	cmd, err := executils.Command(cmdArgs)
+	if err != nil {
+		return fmt.Errorf("cannot execute tool: %s", err)
+	}
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("cannot execute tool: %s", err)
+	}
+	if err := cmd.Wait(); err != nil {
+		return fmt.Errorf("%s: %s", errMsg, err)
+	}
+	return nil
+}
+
+// touchSerialPortAt1200bps open and close the serial port at 1200 bps. This
+// is used on many Arduino boards as a signal to put the board in "bootloader"
+// mode.
+func touchSerialPortAt1200bps(port string) error {
+	logrus.Infof("Touching port %s at 1200bps", port)
+
+	// Open port
+	p, err := serial.Open(port, &serial.Mode{BaudRate: 1200})
+	if err != nil {
+		return fmt.Errorf("open port: %s", err)
+	}
+	defer p.Close()
+
+	if err = p.SetDTR(false); err != nil {
+		return fmt.Errorf("can't set DTR")
+	}
+	return nil
+}
+
+// waitForNewSerialPort is meant to be called just after a reset. It watches the ports connected
+// to the machine until a port appears. The new appeared port is returned
+func waitForNewSerialPort() (string, error) {
+	logrus.Infof("Waiting for upload port...")
+
+	getPortMap := func() (map[string]bool, error) {
+		ports, err := serial.GetPortsList()
+		if err != nil {
+			return nil, err
+		}
+		res := map[string]bool{}
+		for _, port := range ports {
+			res[port] = true
+		}
+		return res, nil
+	}
+
+	last, err := getPortMap()
+	if err != nil {
+		return "", fmt.Errorf("scanning serial port: %s", err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for time.Now().Before(deadline) {
+		now, err := getPortMap()
+		if err != nil {
+			return "", fmt.Errorf("scanning serial port: %s", err)
+		}
+
+		for p := range now {
+			if !last[p] {
+				return p, nil // Found it!
+			}
+		}
+
+		last = now
+		time.Sleep(250 * time.Millisecond)
+	}
+
+	return "", nil
+}
@@ -0,0 +1,197 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package api_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHelperProcess is the upload tool run by the recipes of the
+// upload_hardware platform, it prints its arguments.
+func TestHelperProcess(t *testing.T) {
+	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
+		return
+	}
+	args := os.Args
+	for len(args) > 0 && args[0] != "--" {
+		args = args[1:]
+	}
+	if len(args) > 1 && args[1] == "--fail" {
+		fmt.Fprintln(os.Stderr, strings.Join(args[2:], " "))
+		os.Exit(1)
+	}
+	fmt.Println(strings.Join(args[1:], " "))
+	os.Exit(0)
+}
+
+// newUploadTestEnv copies the upload_hardware platform in a temp dir and
+// makes its recipes run TestHelperProcess.
+func newUploadTestEnv(t *testing.T) (*packagemanager.PackageManager, *paths.Path) {
+	tmp, err := paths.MkTempDir("", "upload_test")
+	require.NoError(t, err)
+	hardware := tmp.Join("hardware")
+	require.NoError(t, paths.New("testdata", "upload_hardware").CopyDirTo(hardware))
+	require.NoError(t, hardware.Join("test", "tools", "fake", "1.0.0").MkdirAll())
+	require.NoError(t, hardware.Join("test", "tools", "fakeota", "1.0.0").MkdirAll())
+	platformLocal := fmt.Sprintf("tools.fake.cmd=%s -test.run=TestHelperProcess --\n", os.Args[0])
+	require.NoError(t, hardware.Join("test", "hardware", "avr", "1.0.0", "platform.local.txt").WriteFile([]byte(platformLocal)))
+	os.Setenv("GO_WANT_HELPER_PROCESS", "1")
+
+	pm := packagemanager.NewPackageManager(hardware, hardware, hardware, hardware)
+	require.NoError(t, pm.LoadHardwareFromDirectory(hardware))
+	return pm, tmp
+}
+
+func TestUploadWithProgrammer(t *testing.T) {
+	pm, tmp := newUploadTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	sketch := tmp.Join("Sketch")
+	require.NoError(t, sketch.MkdirAll())
+	require.NoError(t, sketch.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketch.Join("Sketch.test.avr.uno.hex").WriteFile([]byte{}))
+	hex := sketch.Join("Sketch.test.avr.uno.hex").String()
+
+	upload := func(req *api.UploadReq) (string, error) {
+		req.SketchPath = sketch
+		req.FQBN = "test:avr:uno"
+		stdout := &bytes.Buffer{}
+		err := api.Upload(pm, req, stdout, os.Stderr)
+		return stdout.String(), err
+	}
+
+	out, err := upload(&api.UploadReq{Port: "/dev/ttyFAKE"})
+	require.NoError(t, err)
+	require.Equal(t, "upload -q /dev/ttyFAKE "+hex+"\n", out)
+
+	_, err = upload(&api.UploadReq{})
+	require.IsType(t, &api.InvalidArgumentError{}, err, "port required without a programmer")
+
+	out, err = upload(&api.UploadReq{Programmer: "isp"})
+	require.NoError(t, err)
+	require.Equal(t, "program -q -V -cstk500v1 "+hex+"\n", out)
+
+	out, err = upload(&api.UploadReq{Programmer: "test:isp", Verbose: true, Verify: true})
+	require.NoError(t, err)
+	require.Equal(t, "program -v -cstk500v1 "+hex+"\n", out)
+
+	_, err = upload(&api.UploadReq{Programmer: "usbasp"})
+	require.IsType(t, &api.NotFoundError{}, err)
+	_, err = upload(&api.UploadReq{Programmer: "other:isp"})
+	require.IsType(t, &api.NotFoundError{}, err)
+}
+
+func TestBurnBootloader(t *testing.T) {
+	pm, tmp := newUploadTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+	platformDir := tmp.Join("hardware", "test", "hardware", "avr", "1.0.0")
+
+	stdout := &bytes.Buffer{}
+	err := api.BurnBootloader(pm, &api.BurnBootloaderReq{FQBN: "test:avr:uno", Programmer: "isp"}, stdout, os.Stderr)
+	require.NoError(t, err)
+	require.Equal(t, ""+
+		"erase -q -cstk500v1 -Ulfuse:w:0xFF:m -Uhfuse:w:0xDE:m\n"+
+		"bootloader -q -cstk500v1 "+platformDir.String()+"/bootloaders/optiboot/optiboot_atmega328.hex\n",
+		stdout.String())
+
+	err = api.BurnBootloader(pm, &api.BurnBootloaderReq{}, stdout, os.Stderr)
+	require.IsType(t, &api.InvalidArgumentError{}, err)
+}
+
+func TestUploadToolNotSelected(t *testing.T) {
+	pm, tmp := newUploadTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	// other:fake has the same name of test:fake, that is selected for the
+	// platform test:avr
+	hardware := tmp.Join("hardware")
+	require.NoError(t, hardware.Join("other", "tools", "fake", "2.0.0").MkdirAll())
+	boards := hardware.Join("test", "hardware", "avr", "1.0.0", "boards.txt")
+	content, err := boards.ReadFile()
+	require.NoError(t, err)
+	content = append(content, []byte("\nother.name=Other\nother.upload.tool=other:fake\n")...)
+	require.NoError(t, boards.WriteFile(content))
+	pm = packagemanager.NewPackageManager(hardware, hardware, hardware, hardware)
+	require.NoError(t, pm.LoadHardwareFromDirectory(hardware))
+
+	sketch := tmp.Join("Sketch")
+	require.NoError(t, sketch.MkdirAll())
+	require.NoError(t, sketch.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketch.Join("Sketch.test.avr.other.hex").WriteFile([]byte{}))
+
+	err = api.Upload(pm, &api.UploadReq{SketchPath: sketch, FQBN: "test:avr:other", Port: "/dev/ttyFAKE"}, &bytes.Buffer{}, os.Stderr)
+	require.IsType(t, &api.FailedPreconditionError{}, err)
+	require.EqualError(t, err, "upload tool other:fake conflicts with test:fake@1.0.0 selected for platform test:avr@1.0.0")
+}
+
+func TestNetworkUpload(t *testing.T) {
+	pm, tmp := newUploadTestEnv(t)
+	defer tmp.RemoveAll()
+	defer os.Unsetenv("GO_WANT_HELPER_PROCESS")
+
+	sketch := tmp.Join("Sketch")
+	require.NoError(t, sketch.MkdirAll())
+	require.NoError(t, sketch.Join("Sketch.ino").WriteFile([]byte("void setup() {}\nvoid loop() {}\n")))
+	require.NoError(t, sketch.Join("Sketch.test.avr.uno.hex").WriteFile([]byte{}))
+	require.NoError(t, sketch.Join("Sketch.test.avr.wifi.hex").WriteFile([]byte{}))
+
+	upload := func(fqbn string, req *api.UploadReq) (string, error) {
+		req.SketchPath = sketch
+		req.FQBN = fqbn
+		stdout := &bytes.Buffer{}
+		err := api.Upload(pm, req, stdout, os.Stderr)
+		return stdout.String(), err
+	}
+
+	out, err := upload("test:avr:uno", &api.UploadReq{Port: "192.168.1.5:8266", Password: "secret"})
+	require.NoError(t, err)
+	require.Equal(t, "ota -q -address 192.168.1.5 -port 8266 -password secret "+sketch.Join("Sketch.test.avr.uno.hex").String()+"\n", out)
+
+	out, err = upload("test:avr:uno", &api.UploadReq{Port: "network://myboard.local:8266", Verbose: true})
+	require.NoError(t, err)
+	require.Equal(t, "ota -v -address myboard.local -port 8266 -password "+sketch.Join("Sketch.test.avr.uno.hex").String()+"\n", out)
+
+	_, err = upload("test:avr:uno", &api.UploadReq{Port: "192.168.1.5"})
+	require.IsType(t, &api.InvalidArgumentError{}, err, "network.port is required by the recipe")
+
+	// upload.tool.network selects the tool used for the network uploads
+	out, err = upload("test:avr:wifi", &api.UploadReq{Port: "192.168.1.5", Password: "secret"})
+	require.NoError(t, err)
+	require.Equal(t, "fakeota -q -address 192.168.1.5 -port 65280 -password secret "+sketch.Join("Sketch.test.avr.wifi.hex").String()+"\n", out)
+
+	// fakeota requires a password
+	_, err = upload("test:avr:wifi", &api.UploadReq{Port: "192.168.1.5"})
+	require.IsType(t, &api.InvalidArgumentError{}, err)
+	require.EqualError(t, err, "board test:avr:wifi requires a password for the upload over the network")
+
+	out, err = upload("test:avr:wifi", &api.UploadReq{Port: "/dev/ttyFAKE"})
+	require.NoError(t, err)
+	require.Equal(t, "upload -q /dev/ttyFAKE "+sketch.Join("Sketch.test.avr.wifi.hex").String()+"\n", out)
+}
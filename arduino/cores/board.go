@@ -45,6 +45,39 @@ func (b *Board) HasUsbID(reqVid, reqPid string) bool {
 	return false
 }
 
+// IsBoardMatchingIDProperties returns true if the board matches the
+// identification properties of a port found by a discovery: all the
+// properties of one of the upload_port.N sets of the board must be equal
+// to the port ones. The legacy vid.N/pid.N properties are matched against
+// the port vid/pid, and the board ID against the port "board" property
+// announced by network boards.
+func (b *Board) IsBoardMatchingIDProperties(query *properties.Map) bool {
+	for _, idProps := range b.Properties.SubTree("upload_port").FirstLevelOf() {
+		if idProps.Size() == 0 {
+			continue
+		}
+		matching := true
+		for key, value := range idProps.AsMap() {
+			if queryValue, ok := query.GetOk(key); !ok || !strings.EqualFold(queryValue, value) {
+				matching = false
+				break
+			}
+		}
+		if matching {
+			return true
+		}
+	}
+	if vid, ok := query.GetOk("vid"); ok {
+		if b.HasUsbID(vid, query.Get("pid")) {
+			return true
+		}
+	}
+	if boardID, ok := query.GetOk("board"); ok && boardID == b.BoardID {
+		return true
+	}
+	return false
+}
+
 // Name returns the board name as defined in boards.txt properties
 func (b *Board) Name() string {
 	return b.Properties.Get("name")
@@ -105,8 +138,12 @@ func (b *Board) GetBuildProperties(userConfigs *properties.Map) (*properties.Map
 				return nil, fmt.Errorf("invalid value '%s' for option '%s'", userValue, option)
 			}
 		} else {
-			// apply default
-			userValue = optionMenu.FirstLevelKeys()[0]
+			// apply default, the first value if any
+			values := optionMenu.FirstLevelKeys()
+			if len(values) == 0 {
+				continue
+			}
+			userValue = values[0]
 		}
 
 		optionsConf := optionMenu.SubTree(userValue)
@@ -187,6 +187,35 @@ func TestBoard(t *testing.T) {
 	require.False(t, boardMega.HasUsbID("0x2A03", "0x0043"), "has usb 2A03:0043")
 }
 
+func TestBoardMatchingIDProperties(t *testing.T) {
+	port := func(props map[string]string) *properties.Map {
+		return properties.NewFromHashmap(props)
+	}
+	require.True(t, boardUno.IsBoardMatchingIDProperties(port(map[string]string{"vid": "0x2341", "pid": "0x0043"})))
+	require.True(t, boardUno.IsBoardMatchingIDProperties(port(map[string]string{"vid": "0x2a03", "pid": "0x0043", "serialNumber": "1234"})))
+	require.False(t, boardUno.IsBoardMatchingIDProperties(port(map[string]string{"vid": "0x2341", "pid": "0x0010"})))
+	require.True(t, boardUno.IsBoardMatchingIDProperties(port(map[string]string{"board": "uno"})))
+	require.False(t, boardMega.IsBoardMatchingIDProperties(port(map[string]string{"board": "uno"})))
+	require.False(t, boardUno.IsBoardMatchingIDProperties(port(map[string]string{})))
+
+	boardBLE := &Board{
+		BoardID: "nano33ble",
+		Properties: properties.NewFromHashmap(map[string]string{
+			"name":                   "Arduino Nano 33 BLE",
+			"upload_port.0.mfgdata":  "0x2341",
+			"upload_port.0.name":     "Nano 33 BLE",
+			"upload_port.1.mfgdata":  "0x2341",
+			"upload_port.1.name":     "Nano 33 BLE Sense",
+			"upload_port.2.protocol": "can",
+		}),
+	}
+	require.True(t, boardBLE.IsBoardMatchingIDProperties(port(map[string]string{"mfgdata": "0x2341", "name": "nano 33 ble"})))
+	require.True(t, boardBLE.IsBoardMatchingIDProperties(port(map[string]string{"mfgdata": "0x2341", "name": "Nano 33 BLE Sense", "rssi": "-40"})))
+	require.False(t, boardBLE.IsBoardMatchingIDProperties(port(map[string]string{"mfgdata": "0x2341"})))
+	require.False(t, boardBLE.IsBoardMatchingIDProperties(port(map[string]string{"mfgdata": "0x2341", "name": "Nano 33 IoT"})))
+	require.True(t, boardBLE.IsBoardMatchingIDProperties(port(map[string]string{"protocol": "can"})))
+}
+
 func TestBoardOptions(t *testing.T) {
 	expConf2560 := properties.NewFromHashmap(map[string]string{
 		"bootloader.extended_fuses": "0xFD",
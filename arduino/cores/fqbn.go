@@ -22,14 +22,18 @@ import (
 	"strings"
 
 	properties "github.com/arduino/go-properties-orderedmap"
+	"go.bug.st/relaxed-semver"
 )
 
 // FQBN represents a Board with a specific configuration
 type FQBN struct {
 	Package      string
 	PlatformArch string
-	BoardID      string
-	Configs      *properties.Map
+	// PlatformVersion pins the release of the platform to use, it's nil if
+	// the FQBN doesn't specify it (ex: arduino:avr@1.6.21:uno).
+	PlatformVersion *semver.Version
+	BoardID         string
+	Configs         *properties.Map
 }
 
 // ParseFQBN extract an FQBN object from the input string
@@ -49,6 +53,17 @@ func ParseFQBN(fqbnIn string) (*FQBN, error) {
 	if fqbn.BoardID == "" {
 		return nil, fmt.Errorf("invalid fqbn: empty board identifier")
 	}
+	if split := strings.SplitN(fqbn.PlatformArch, "@", 2); len(split) == 2 {
+		if split[1] == "" {
+			return nil, fmt.Errorf("invalid fqbn: empty platform version")
+		}
+		version, err := semver.Parse(split[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid fqbn platform version: %s", err)
+		}
+		fqbn.PlatformArch = split[0]
+		fqbn.PlatformVersion = version
+	}
 	if len(fqbnParts) > 3 {
 		for _, pair := range strings.Split(fqbnParts[3], ",") {
 			parts := strings.SplitN(pair, "=", 2)
@@ -67,7 +82,11 @@ func ParseFQBN(fqbnIn string) (*FQBN, error) {
 }
 
 func (fqbn *FQBN) String() string {
-	res := fmt.Sprintf("%s:%s:%s", fqbn.Package, fqbn.PlatformArch, fqbn.BoardID)
+	platform := fqbn.PlatformArch
+	if fqbn.PlatformVersion != nil {
+		platform += "@" + fqbn.PlatformVersion.String()
+	}
+	res := fmt.Sprintf("%s:%s:%s", fqbn.Package, platform, fqbn.BoardID)
 	if fqbn.Configs.Size() > 0 {
 		sep := ":"
 		for _, k := range fqbn.Configs.Keys() {
@@ -122,4 +122,19 @@ func TestFQBN(t *testing.T) {
 	require.Equal(t,
 		"properties.Map{\n  \"cpu\": \"atmega\",\n  \"speed\": \"1000\",\n  \"extra\": \"core=arduino\",\n}",
 		f.Configs.Dump())
+
+	// Allow pinning the platform version
+	g, err := ParseFQBN("arduino:avr@1.6.21:uno:cpu=atmega")
+	require.NoError(t, err)
+	require.Equal(t, "arduino:avr@1.6.21:uno:cpu=atmega", g.String())
+	require.Equal(t, g.PlatformArch, "avr")
+	require.Equal(t, "1.6.21", g.PlatformVersion.String())
+	require.Equal(t, g.BoardID, "uno")
+	require.Nil(t, a.PlatformVersion)
+
+	// Do not allow invalid versions
+	_, err = ParseFQBN("arduino:avr@:uno")
+	require.Error(t, err)
+	_, err = ParseFQBN("arduino:avr@1.x:uno")
+	require.Error(t, err)
 }
@@ -0,0 +1,299 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packageindex
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"go.bug.st/relaxed-semver"
+)
+
+// Descriptor describes the platform and tool archives to publish in a
+// package index, see GenerateIndex. The archive paths are relative to
+// the descriptor file.
+type Descriptor struct {
+	Package struct {
+		Name       string `json:"name"`
+		Maintainer string `json:"maintainer"`
+		WebsiteURL string `json:"websiteUrl"`
+		Email      string `json:"email"`
+	} `json:"package"`
+	// BaseURL is the URL where the archives are published, if empty the
+	// file:// URLs of the archives are used.
+	BaseURL  string              `json:"baseUrl"`
+	Platform *DescriptorPlatform `json:"platform"`
+	Tools    []*DescriptorTool   `json:"tools"`
+
+	dir *paths.Path
+}
+
+// DescriptorPlatform describes a platform release. The name and version
+// are taken from the platform.txt in the archive when not set.
+type DescriptorPlatform struct {
+	Name              string `json:"name"`
+	Architecture      string `json:"architecture"`
+	Version           string `json:"version"`
+	Category          string `json:"category"`
+	Archive           string `json:"archive"`
+	ToolsDependencies []struct {
+		Packager string `json:"packager"`
+		Name     string `json:"name"`
+		Version  string `json:"version"`
+	} `json:"toolsDependencies"`
+}
+
+// DescriptorTool describes a tool release, with an archive for each
+// host, e.g. "x86_64-linux-gnu".
+type DescriptorTool struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	Systems []struct {
+		Host    string `json:"host"`
+		Archive string `json:"archive"`
+	} `json:"systems"`
+}
+
+// LoadDescriptor reads a Descriptor from a JSON file.
+func LoadDescriptor(descriptorFile *paths.Path) (*Descriptor, error) {
+	buff, err := descriptorFile.ReadFile()
+	if err != nil {
+		return nil, err
+	}
+	var descriptor Descriptor
+	if err := json.Unmarshal(buff, &descriptor); err != nil {
+		return nil, fmt.Errorf("invalid descriptor %s: %s", descriptorFile, err)
+	}
+	dir, err := descriptorFile.Parent().Abs()
+	if err != nil {
+		return nil, err
+	}
+	descriptor.dir = dir
+	return &descriptor, nil
+}
+
+// GenerateIndex creates an Index with the platform and tools of the
+// descriptor, computing the size and checksum of the archives. The
+// platform archive is extracted in tempDir to list the boards, with their
+// USB ids, from boards.txt.
+func GenerateIndex(descriptor *Descriptor, tempDir *paths.Path) (*Index, error) {
+	if descriptor.Package.Name == "" {
+		return nil, fmt.Errorf("missing package name")
+	}
+	baseURL, err := url.Parse(descriptor.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL: %s", err)
+	}
+
+	targetPackage := cores.NewPackages().GetOrCreatePackage(descriptor.Package.Name)
+	targetPackage.Maintainer = descriptor.Package.Maintainer
+	targetPackage.WebsiteURL = descriptor.Package.WebsiteURL
+	targetPackage.Email = descriptor.Package.Email
+
+	generator := &indexGenerator{
+		descriptor: descriptor,
+		tempDir:    tempDir,
+		archives:   map[*resources.DownloadResource]*paths.Path{},
+	}
+	platforms := []*cores.PlatformRelease{}
+	if descriptor.Platform != nil {
+		release, err := generator.platformRelease(targetPackage, descriptor.Platform)
+		if err != nil {
+			return nil, fmt.Errorf("platform %s: %s", descriptor.Platform.Archive, err)
+		}
+		platforms = append(platforms, release)
+	}
+	tools := []*cores.ToolRelease{}
+	for _, descTool := range descriptor.Tools {
+		release, err := generator.toolRelease(targetPackage, descTool)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, release)
+	}
+
+	archiveURL := func(r *resources.DownloadResource) string {
+		if descriptor.BaseURL == "" {
+			return resources.FileURL(generator.archives[r]).String()
+		}
+		res := *baseURL
+		res.Path = path.Join(res.Path, r.ArchiveFileName)
+		return res.String()
+	}
+	keepAll := func(*cores.Flavor) bool { return true }
+	return IndexFromPlatformReleases(platforms, tools, keepAll, archiveURL), nil
+}
+
+type indexGenerator struct {
+	descriptor *Descriptor
+	tempDir    *paths.Path
+	// archives are the paths of the archives of the resources created.
+	archives map[*resources.DownloadResource]*paths.Path
+}
+
+// resource creates the DownloadResource of an archive of the descriptor.
+func (generator *indexGenerator) resource(archive string) (*resources.DownloadResource, error) {
+	if archive == "" {
+		return nil, fmt.Errorf("missing archive")
+	}
+	archivePath := paths.New(archive)
+	if !archivePath.IsAbs() {
+		archivePath = generator.descriptor.dir.Join(archive)
+	}
+	info, err := archivePath.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("reading archive: %s", err)
+	}
+	checksum, err := resources.ComputeChecksum(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	res := &resources.DownloadResource{
+		ArchiveFileName: archivePath.Base(),
+		Checksum:        checksum,
+		Size:            info.Size(),
+		CachePath:       "packages",
+	}
+	generator.archives[res] = archivePath
+	return res, nil
+}
+
+func (generator *indexGenerator) platformRelease(targetPackage *cores.Package, descPlatform *DescriptorPlatform) (*cores.PlatformRelease, error) {
+	if descPlatform.Architecture == "" {
+		return nil, fmt.Errorf("missing architecture")
+	}
+	resource, err := generator.resource(descPlatform.Archive)
+	if err != nil {
+		return nil, err
+	}
+
+	// Extract the archive as done when installing the platform
+	extractDir, err := generator.tempDir.MkTempDir("index-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp dir for extraction: %s", err)
+	}
+	defer extractDir.RemoveAll()
+	platformDir := extractDir.Join("platform")
+	archivePath := generator.archives[resource]
+	archive := &resources.DownloadResource{ArchiveFileName: archivePath.Base()}
+	if err := archive.Install(archivePath.Parent(), extractDir, platformDir); err != nil {
+		return nil, err
+	}
+	boardsProperties, err := properties.LoadFromPath(platformDir.Join("boards.txt"))
+	if err != nil {
+		return nil, fmt.Errorf("loading boards.txt: %s", err)
+	}
+	platformProperties, err := properties.SafeLoadFromPath(platformDir.Join("platform.txt"))
+	if err != nil {
+		return nil, fmt.Errorf("loading platform.txt: %s", err)
+	}
+
+	name := descPlatform.Name
+	if name == "" {
+		name = platformProperties.Get("name")
+	}
+	version := descPlatform.Version
+	if version == "" {
+		version = platformProperties.Get("version")
+	}
+	if name == "" || version == "" {
+		return nil, fmt.Errorf("missing name or version, set them in the descriptor or in platform.txt")
+	}
+	parsedVersion, err := semver.Parse(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version %s: %s", version, err)
+	}
+
+	platform := targetPackage.GetOrCreatePlatform(descPlatform.Architecture)
+	platform.Name = name
+	platform.Category = descPlatform.Category
+	release, err := platform.GetOrCreateRelease(parsedVersion)
+	if err != nil {
+		return nil, err
+	}
+	release.Resource = resource
+	release.BoardsManifest = boardsManifest(boardsProperties)
+	for _, dep := range descPlatform.ToolsDependencies {
+		if dep.Packager == "" || dep.Name == "" || dep.Version == "" {
+			return nil, fmt.Errorf("missing packager, name or version of a tool dependency")
+		}
+		release.Dependencies = append(release.Dependencies, &cores.ToolDependency{
+			ToolPackager: dep.Packager,
+			ToolName:     dep.Name,
+			ToolVersion:  semver.ParseRelaxed(dep.Version),
+		})
+	}
+	return release, nil
+}
+
+func (generator *indexGenerator) toolRelease(targetPackage *cores.Package, descTool *DescriptorTool) (*cores.ToolRelease, error) {
+	if descTool.Name == "" || descTool.Version == "" {
+		return nil, fmt.Errorf("missing tool name or version")
+	}
+	release := targetPackage.GetOrCreateTool(descTool.Name).GetOrCreateRelease(semver.ParseRelaxed(descTool.Version))
+	if len(descTool.Systems) == 0 {
+		return nil, fmt.Errorf("tool %s: no archives", release)
+	}
+	for _, system := range descTool.Systems {
+		if system.Host == "" {
+			return nil, fmt.Errorf("tool %s: missing host of %s", release, system.Archive)
+		}
+		resource, err := generator.resource(system.Archive)
+		if err != nil {
+			return nil, fmt.Errorf("tool %s: %s", release, err)
+		}
+		release.Flavors = append(release.Flavors, &cores.Flavor{OS: system.Host, Resource: resource})
+	}
+	return release, nil
+}
+
+// boardsManifest lists the boards defined in boards.txt, in the same
+// order, with the USB ids from the vid.N/pid.N pairs.
+func boardsManifest(boardsProperties *properties.Map) []*cores.BoardManifest {
+	res := []*cores.BoardManifest{}
+	propertiesByBoard := boardsProperties.FirstLevelOf()
+	for _, boardID := range boardsProperties.FirstLevelKeys() {
+		board := propertiesByBoard[boardID]
+		if boardID == "menu" || board.Get("name") == "" {
+			continue
+		}
+		manifest := &cores.BoardManifest{Name: board.Get("name"), ID: []*cores.BoardManifestID{}}
+		vids := board.SubTree("vid")
+		pids := board.SubTree("pid")
+		for _, id := range vids.Keys() {
+			if pid, ok := pids.GetOk(id); ok {
+				manifest.ID = append(manifest.ID, &cores.BoardManifestID{USB: usbID(vids.Get(id)) + ":" + usbID(pid)})
+			}
+		}
+		res = append(res, manifest)
+	}
+	return res
+}
+
+// usbID converts a vid or pid from boards.txt, e.g. 0x2341, to the format
+// of the index, e.g. 2341.
+func usbID(id string) string {
+	return strings.TrimPrefix(strings.ToLower(id), "0x")
+}
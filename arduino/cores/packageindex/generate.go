@@ -0,0 +1,204 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packageindex
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/go-paths-helper"
+)
+
+// IndexFromPlatformReleases creates an Index containing the given platform
+// and tool releases. The tool flavors not accepted by keepFlavor are left
+// out and the URL of each archive is replaced with the one returned by
+// archiveURL.
+func IndexFromPlatformReleases(platforms []*cores.PlatformRelease, tools []*cores.ToolRelease,
+	keepFlavor func(*cores.Flavor) bool, archiveURL func(*resources.DownloadResource) string) *Index {
+	packages := map[string]*indexPackage{}
+	getPackage := func(pkg *cores.Package) *indexPackage {
+		if res, ok := packages[pkg.Name]; ok {
+			return res
+		}
+		res := &indexPackage{
+			Name:       pkg.Name,
+			Maintainer: pkg.Maintainer,
+			WebsiteURL: pkg.WebsiteURL,
+			Email:      pkg.Email,
+			Platforms:  []*indexPlatformRelease{},
+			Tools:      []*indexToolRelease{},
+		}
+		packages[pkg.Name] = res
+		return res
+	}
+
+	for _, release := range platforms {
+		outPackage := getPackage(release.Platform.Package)
+		outPackage.Platforms = append(outPackage.Platforms, newIndexPlatformRelease(release, archiveURL))
+	}
+	for _, release := range tools {
+		outPackage := getPackage(release.Tool.Package)
+		outTool := &indexToolRelease{
+			Name:    release.Tool.Name,
+			Version: release.Version,
+			Systems: []indexToolReleaseFlavour{},
+		}
+		for _, flavor := range release.Flavors {
+			if !keepFlavor(flavor) {
+				continue
+			}
+			outTool.Systems = append(outTool.Systems, indexToolReleaseFlavour{
+				OS:              flavor.OS,
+				URL:             archiveURL(flavor.Resource),
+				ArchiveFileName: flavor.Resource.ArchiveFileName,
+				Size:            json.Number(strconv.FormatInt(flavor.Resource.Size, 10)),
+				Checksum:        flavor.Resource.Checksum,
+			})
+		}
+		outPackage.Tools = append(outPackage.Tools, outTool)
+	}
+
+	index := &Index{Packages: []*indexPackage{}}
+	for _, outPackage := range packages {
+		sort.Slice(outPackage.Platforms, func(i, j int) bool {
+			a, b := outPackage.Platforms[i], outPackage.Platforms[j]
+			if a.Architecture != b.Architecture {
+				return a.Architecture < b.Architecture
+			}
+			return a.Version.LessThan(b.Version)
+		})
+		sort.Slice(outPackage.Tools, func(i, j int) bool {
+			a, b := outPackage.Tools[i], outPackage.Tools[j]
+			if a.Name != b.Name {
+				return a.Name < b.Name
+			}
+			return a.Version.LessThan(b.Version)
+		})
+		index.Packages = append(index.Packages, outPackage)
+	}
+	sort.Slice(index.Packages, func(i, j int) bool {
+		return index.Packages[i].Name < index.Packages[j].Name
+	})
+	return index
+}
+
+func newIndexPlatformRelease(release *cores.PlatformRelease, archiveURL func(*resources.DownloadResource) string) *indexPlatformRelease {
+	res := &indexPlatformRelease{
+		Name:             release.Platform.Name,
+		Architecture:     release.Platform.Architecture,
+		Version:          release.Version,
+		Category:         release.Platform.Category,
+		URL:              archiveURL(release.Resource),
+		ArchiveFileName:  release.Resource.ArchiveFileName,
+		Checksum:         release.Resource.Checksum,
+		Size:             json.Number(strconv.FormatInt(release.Resource.Size, 10)),
+		Boards:           []indexBoard{},
+		ToolDependencies: []indexToolDependency{},
+	}
+	for _, board := range release.BoardsManifest {
+		outBoard := indexBoard{Name: board.Name, ID: []indexBoardID{}}
+		for _, id := range board.ID {
+			outBoard.ID = append(outBoard.ID, indexBoardID{USB: id.USB})
+		}
+		res.Boards = append(res.Boards, outBoard)
+	}
+	for _, dep := range release.Dependencies {
+		res.ToolDependencies = append(res.ToolDependencies, indexToolDependency{
+			Packager: dep.ToolPackager,
+			Name:     dep.ToolName,
+			Version:  dep.ToolVersion,
+		})
+	}
+	return res
+}
+
+// SaveIndex writes the index in the package_index.json format.
+func (index *Index) SaveIndex(jsonIndexFile *paths.Path) error {
+	data, err := json.MarshalIndent(index, "", "  ")
+	if err != nil {
+		return err
+	}
+	return jsonIndexFile.WriteFile(data)
+}
+
+// Merge adds the packages of other to the index. The platform and tool
+// releases of other replace the ones of the index with the same version,
+// the others are added. The maintainer, website and email of a package
+// are updated when set in other.
+func (index *Index) Merge(other *Index) {
+	for _, inPackage := range other.Packages {
+		var outPackage *indexPackage
+		for _, candidate := range index.Packages {
+			if candidate.Name == inPackage.Name {
+				outPackage = candidate
+				break
+			}
+		}
+		if outPackage == nil {
+			outPackage = &indexPackage{
+				Name:      inPackage.Name,
+				Platforms: []*indexPlatformRelease{},
+				Tools:     []*indexToolRelease{},
+			}
+			index.Packages = append(index.Packages, outPackage)
+		}
+		if inPackage.Maintainer != "" {
+			outPackage.Maintainer = inPackage.Maintainer
+		}
+		if inPackage.WebsiteURL != "" {
+			outPackage.WebsiteURL = inPackage.WebsiteURL
+		}
+		if inPackage.Email != "" {
+			outPackage.Email = inPackage.Email
+		}
+		if inPackage.Help.Online != "" {
+			outPackage.Help = inPackage.Help
+		}
+
+		for _, inPlatform := range inPackage.Platforms {
+			replaced := false
+			for i, outPlatform := range outPackage.Platforms {
+				if outPlatform.Architecture == inPlatform.Architecture && outPlatform.Version.Equal(inPlatform.Version) {
+					outPackage.Platforms[i] = inPlatform
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				outPackage.Platforms = append(outPackage.Platforms, inPlatform)
+			}
+		}
+
+		for _, inTool := range inPackage.Tools {
+			replaced := false
+			for i, outTool := range outPackage.Tools {
+				if outTool.Name == inTool.Name && outTool.Version.Equal(inTool.Version) {
+					outPackage.Tools[i] = inTool
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				outPackage.Tools = append(outPackage.Tools, inTool)
+			}
+		}
+	}
+}
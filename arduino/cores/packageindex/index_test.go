@@ -18,9 +18,13 @@
 package packageindex
 
 import (
+	"archive/zip"
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/go-paths-helper"
 	"github.com/stretchr/testify/require"
 	semver "go.bug.st/relaxed-semver"
@@ -40,3 +44,175 @@ func TestIndexParsing(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestIndexFromPlatformReleases(t *testing.T) {
+	index, err := LoadIndex(paths.New("testdata", "package_esp8266com_index.json"))
+	require.NoError(t, err)
+	packages := cores.NewPackages()
+	index.MergeIntoPackages(packages)
+
+	esp8266 := packages.Packages["esp8266"]
+	platform := esp8266.Platforms["esp8266"].Releases["2.3.0"]
+	require.NotNil(t, platform)
+	tools := []*cores.ToolRelease{}
+	for _, dep := range platform.Dependencies {
+		tool := esp8266.Tools[dep.ToolName].FindReleaseWithRelaxedVersion(dep.ToolVersion)
+		require.NotNil(t, tool)
+		tools = append(tools, tool)
+	}
+
+	mirror := IndexFromPlatformReleases(
+		[]*cores.PlatformRelease{platform}, tools,
+		func(f *cores.Flavor) bool { return f.OS == "x86_64-pc-linux-gnu" },
+		func(r *resources.DownloadResource) string { return "file:///mirror/packages/" + r.ArchiveFileName })
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	indexFile := tmp.Join("package_index.json")
+	require.NoError(t, mirror.SaveIndex(indexFile))
+
+	reloaded, err := LoadIndex(indexFile)
+	require.NoError(t, err)
+	require.Len(t, reloaded.Packages, 1)
+	pkg := reloaded.Packages[0]
+	require.Equal(t, "esp8266", pkg.Name)
+	require.Equal(t, esp8266.Maintainer, pkg.Maintainer)
+	require.Len(t, pkg.Platforms, 1)
+	require.Equal(t, "2.3.0", pkg.Platforms[0].Version.String())
+	require.Equal(t, "file:///mirror/packages/"+platform.Resource.ArchiveFileName, pkg.Platforms[0].URL)
+	require.Equal(t, platform.Resource.Checksum, pkg.Platforms[0].Checksum)
+	require.Len(t, pkg.Platforms[0].ToolDependencies, len(platform.Dependencies))
+	require.Len(t, pkg.Platforms[0].Boards, len(platform.BoardsManifest))
+	require.Len(t, pkg.Tools, len(tools))
+	for _, tool := range pkg.Tools {
+		require.Len(t, tool.Systems, 1)
+		require.Equal(t, "x86_64-pc-linux-gnu", tool.Systems[0].OS)
+		require.Equal(t, "file:///mirror/packages/"+tool.Systems[0].ArchiveFileName, tool.Systems[0].URL)
+	}
+}
+
+func TestIndexMerge(t *testing.T) {
+	index, err := LoadIndex(paths.New("testdata", "package_esp8266com_index.json"))
+	require.NoError(t, err)
+	esp8266 := index.Packages[0]
+	platformsCount, toolsCount := len(esp8266.Platforms), len(esp8266.Tools)
+	replaced := *esp8266.Platforms[0]
+	replaced.Checksum = "SHA-256:0000"
+	added := replaced
+	added.Version = semver.MustParse("99.0.0")
+
+	other := &Index{Packages: []*indexPackage{
+		{
+			Name:      "esp8266",
+			Email:     "new@example.com",
+			Platforms: []*indexPlatformRelease{&replaced, &added},
+			Tools: []*indexToolRelease{
+				{Name: "newtool", Version: semver.ParseRelaxed("1.0.0"), Systems: []indexToolReleaseFlavour{}},
+			},
+		},
+		{Name: "other", Maintainer: "Other", Platforms: []*indexPlatformRelease{}, Tools: []*indexToolRelease{}},
+	}}
+	index.Merge(other)
+
+	require.Len(t, index.Packages, 2)
+	require.Equal(t, "new@example.com", esp8266.Email)
+	require.NotEmpty(t, esp8266.Maintainer)
+	require.Len(t, esp8266.Platforms, platformsCount+1)
+	require.Equal(t, "SHA-256:0000", esp8266.Platforms[0].Checksum)
+	require.Equal(t, "99.0.0", esp8266.Platforms[platformsCount].Version.String())
+	require.Len(t, esp8266.Tools, toolsCount+1)
+	require.Equal(t, "other", index.Packages[1].Name)
+	require.Equal(t, "Other", index.Packages[1].Maintainer)
+}
+
+func TestGenerateIndex(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "generate_index")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	writeZip := func(name string, files map[string]string) {
+		file, err := os.Create(tmp.Join(name).String())
+		require.NoError(t, err)
+		defer file.Close()
+		w := zip.NewWriter(file)
+		for name, content := range files {
+			f, err := w.Create(name)
+			require.NoError(t, err)
+			f.Write([]byte(content))
+		}
+		require.NoError(t, w.Close())
+	}
+	writeZip("myboards-1.2.0.zip", map[string]string{
+		"myboards/platform.txt": "name=My Boards\nversion=1.2.0\n",
+		"myboards/boards.txt": "menu.cpu=Processor\n" +
+			"one.name=Board One\none.vid.0=0x2341\none.pid.0=0x0043\none.vid.1=0x2A03\none.pid.1=0x0043\n" +
+			"two.name=Board Two\ntwo.menu.cpu.fast=Fast\n",
+	})
+	writeZip("mytool-linux.zip", map[string]string{"mytool/mytool": "linux"})
+	writeZip("mytool-windows.zip", map[string]string{"mytool/mytool.exe": "windows"})
+	descriptor := `{
+		"package": {"name": "mycompany", "maintainer": "My Company", "email": "boards@example.com"},
+		"baseUrl": "https://example.com/arduino/",
+		"platform": {
+			"architecture": "avr",
+			"category": "Contributed",
+			"archive": "myboards-1.2.0.zip",
+			"toolsDependencies": [{"packager": "mycompany", "name": "mytool", "version": "1.0.0"}]
+		},
+		"tools": [{
+			"name": "mytool",
+			"version": "1.0.0",
+			"systems": [
+				{"host": "x86_64-linux-gnu", "archive": "mytool-linux.zip"},
+				{"host": "i686-mingw32", "archive": "mytool-windows.zip"}
+			]
+		}]
+	}`
+	require.NoError(t, tmp.Join("descriptor.json").WriteFile([]byte(descriptor)))
+
+	desc, err := LoadDescriptor(tmp.Join("descriptor.json"))
+	require.NoError(t, err)
+	index, err := GenerateIndex(desc, tmp)
+	require.NoError(t, err)
+	indexFile := tmp.Join("package_mycompany_index.json")
+	require.NoError(t, index.SaveIndex(indexFile))
+
+	reloaded, err := LoadIndex(indexFile)
+	require.NoError(t, err)
+	packages := cores.NewPackages()
+	reloaded.MergeIntoPackages(packages)
+	mycompany := packages.Packages["mycompany"]
+	require.NotNil(t, mycompany)
+	require.Equal(t, "My Company", mycompany.Maintainer)
+
+	platform := mycompany.Platforms["avr"].Releases["1.2.0"]
+	require.NotNil(t, platform)
+	require.Equal(t, "My Boards", platform.Platform.Name)
+	require.Equal(t, "https://example.com/arduino/myboards-1.2.0.zip", platform.Resource.URL)
+	require.Regexp(t, "^SHA-256:[0-9a-f]{64}$", platform.Resource.Checksum)
+	info, err := tmp.Join("myboards-1.2.0.zip").Stat()
+	require.NoError(t, err)
+	require.Equal(t, info.Size(), platform.Resource.Size)
+	require.Len(t, platform.BoardsManifest, 2)
+	require.Equal(t, "Board One", platform.BoardsManifest[0].Name)
+	require.True(t, platform.BoardsManifest[0].HasUsbID("2a03", "0043"))
+	require.Empty(t, platform.BoardsManifest[1].ID)
+	require.Equal(t, "mycompany:mytool@1.0.0", platform.Dependencies[0].String())
+
+	tool := mycompany.Tools["mytool"].Releases["1.0.0"]
+	require.NotNil(t, tool)
+	require.Len(t, tool.Flavors, 2)
+
+	// Archives without base URL are referenced by file:// URL
+	desc.BaseURL = ""
+	desc.Platform = nil
+	index, err = GenerateIndex(desc, tmp)
+	require.NoError(t, err)
+	require.Empty(t, index.Packages[0].Platforms)
+	require.Equal(t, "file://"+tmp.Join("mytool-linux.zip").String(), index.Packages[0].Tools[0].Systems[0].URL)
+
+	desc.Package.Name = ""
+	_, err = GenerateIndex(desc, tmp)
+	require.Error(t, err)
+}
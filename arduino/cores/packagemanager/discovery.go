@@ -0,0 +1,119 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packagemanager
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/sirupsen/logrus"
+)
+
+// LoadDiscoveries returns the pluggable discoveries declared by the
+// installed platforms in platform.txt as:
+//
+//	discovery.<ID>.pattern=<command line>
+//
+// The command line is expanded with the platform properties and the
+// runtime properties of the installed tools. If more platforms declare a
+// discovery with the same ID only the first one, in FQBN order, is loaded.
+// The discoveries are not started.
+func (pm *PackageManager) LoadDiscoveries() []discovery.Discovery {
+	toolsProps := properties.NewMap()
+	for _, tool := range pm.GetAllInstalledToolsReleases() {
+		toolsProps.Merge(tool.RuntimeProperties())
+	}
+
+	platformReleases := []*cores.PlatformRelease{}
+	for _, targetPackage := range pm.packages.Packages {
+		for _, platform := range targetPackage.Platforms {
+			if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
+				platformReleases = append(platformReleases, platformRelease)
+			}
+		}
+	}
+	sort.Slice(platformReleases, func(i, j int) bool {
+		return platformReleases[i].String() < platformReleases[j].String()
+	})
+
+	res := []discovery.Discovery{}
+	loaded := map[string]bool{}
+	for _, platformRelease := range platformReleases {
+		discoveries := platformRelease.Properties.SubTree("discovery").FirstLevelOf()
+		ids := []string{}
+		for id := range discoveries {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+
+		props := platformRelease.Properties.Clone()
+		props.Merge(platformRelease.RuntimeProperties())
+		props.Merge(toolsProps)
+		for _, id := range ids {
+			log := logrus.WithField("platform", platformRelease).WithField("discovery", id)
+			if loaded[id] {
+				log.Info("Discovery already loaded from another platform")
+				continue
+			}
+			pattern, ok := discoveries[id].GetOk("pattern")
+			if !ok {
+				log.Warn("Missing discovery pattern")
+				continue
+			}
+			cmdLine := props.ExpandPropsInString(pattern)
+			if strings.Contains(cmdLine, "{") {
+				log.Warnf("Discovery pattern has undefined properties, is a tool missing? %s", cmdLine)
+				continue
+			}
+			args, err := properties.SplitQuotedString(cmdLine, `"'`, false)
+			if err != nil {
+				log.WithError(err).Warn("Invalid discovery pattern")
+				continue
+			}
+			loaded[id] = true
+			res = append(res, discovery.NewPluggableDiscovery(id, args))
+		}
+	}
+	return res
+}
+
+// IdentifyBoard returns the installed boards matching the identification
+// properties of a port found by a discovery, see
+// cores.Board.IsBoardMatchingIDProperties.
+func (pm *PackageManager) IdentifyBoard(port *discovery.Port) []*cores.Board {
+	query := port.Properties
+	if query == nil {
+		query = properties.NewMap()
+	}
+	res := []*cores.Board{}
+	for _, targetPackage := range pm.packages.Packages {
+		for _, targetPlatform := range targetPackage.Platforms {
+			if platform := pm.GetInstalledPlatformRelease(targetPlatform); platform != nil {
+				for _, board := range platform.Boards {
+					if board.IsBoardMatchingIDProperties(query) {
+						res = append(res, board)
+					}
+				}
+			}
+		}
+	}
+	return res
+}
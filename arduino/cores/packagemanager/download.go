@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"go.bug.st/downloader"
 	"go.bug.st/relaxed-semver"
 )
@@ -110,6 +111,12 @@ func (pm *PackageManager) DownloadToolRelease(tool *cores.ToolRelease) (*downloa
 	return resource.Download(pm.DownloadDir)
 }
 
+// NewDownloadScheduler returns a DownloadScheduler saving the archives in
+// the download directory of the PackageManager.
+func (pm *PackageManager) NewDownloadScheduler() *resources.DownloadScheduler {
+	return resources.NewDownloadScheduler(pm.DownloadDir, pm.MaxParallelDownloads)
+}
+
 // DownloadPlatformRelease downloads a PlatformRelease. If the platform is already downloaded a
 // nil Downloader is returned.
 func (pm *PackageManager) DownloadPlatformRelease(platform *cores.PlatformRelease) (*downloader.Downloader, error) {
@@ -0,0 +1,225 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packagemanager
+
+import (
+	"fmt"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// PlatformInstallTransaction installs a platform release and its tools so
+// that a failure in any step leaves the previously installed release in
+// place. The platform is extracted into a staging directory by Stage, the
+// tools are installed with InstallTool, and Commit swaps the staged
+// release with the previous one, that is kept as backup for
+// RollbackPlatform. If any step fails Rollback undoes the changes.
+type PlatformInstallTransaction struct {
+	pm             *PackageManager
+	release        *cores.PlatformRelease
+	previous       *cores.PlatformRelease
+	stagingDir     *paths.Path
+	restoreDir     *paths.Path // The backup the release has been staged from, if any.
+	installedTools []*cores.ToolRelease
+}
+
+// NewPlatformInstallTransaction starts the installation of platformRelease.
+// If replaced is not nil, and is a managed release, it's replaced on Commit,
+// otherwise the new release is installed side by side with the installed
+// ones.
+func (pm *PackageManager) NewPlatformInstallTransaction(platformRelease, replaced *cores.PlatformRelease) *PlatformInstallTransaction {
+	tx := &PlatformInstallTransaction{
+		pm:         pm,
+		release:    platformRelease,
+		stagingDir: pm.platformDir(platformRelease.Platform).Join(".staging", platformRelease.Version.String()),
+	}
+	if replaced != nil && replaced.IsInstalled() && replaced != platformRelease && pm.IsManagedPlatformRelease(replaced) {
+		tx.previous = replaced
+	}
+	return tx
+}
+
+// Release returns the platform release being installed.
+func (tx *PlatformInstallTransaction) Release() *cores.PlatformRelease {
+	return tx.release
+}
+
+// Previous returns the platform release that will be replaced, nil if the
+// release is installed side by side.
+func (tx *PlatformInstallTransaction) Previous() *cores.PlatformRelease {
+	return tx.previous
+}
+
+// Stage extracts the archive of the platform release, that must have been
+// already downloaded, into the staging directory.
+func (tx *PlatformInstallTransaction) Stage() error {
+	tx.stagingDir.RemoveAll()
+	return tx.release.Resource.Install(tx.pm.DownloadDir, tx.pm.TempDir, tx.stagingDir)
+}
+
+// InstallTool installs a tool required by the platform release. Tools are
+// installed in place, since a new tool release doesn't affect the
+// installed ones, and removed on Rollback.
+func (tx *PlatformInstallTransaction) InstallTool(toolRelease *cores.ToolRelease) error {
+	if toolRelease.IsInstalled() {
+		return nil
+	}
+	if err := tx.pm.InstallTool(toolRelease); err != nil {
+		return err
+	}
+	tx.installedTools = append(tx.installedTools, toolRelease)
+	return nil
+}
+
+// Commit moves the staged platform release in its final location. The
+// previous release is moved into the backup directory of the platform,
+// replacing an older backup, and is restored if the swap fails.
+func (tx *PlatformInstallTransaction) Commit() error {
+	if !tx.stagingDir.IsDir() {
+		return fmt.Errorf("platform %s has not been staged", tx.release)
+	}
+	destDir := tx.pm.platformReleaseDir(tx.release)
+	if destDir.Exist() {
+		if err := destDir.RemoveAll(); err != nil {
+			return fmt.Errorf("removing old files in %s: %s", destDir, err)
+		}
+	}
+
+	var previousDir, backupDir *paths.Path
+	if tx.previous != nil {
+		previousDir = tx.previous.InstallDir
+		backupDir = tx.pm.platformBackupDir(tx.release.Platform)
+		if err := backupDir.RemoveAll(); err != nil {
+			return fmt.Errorf("removing old backup: %s", err)
+		}
+		if err := backupDir.MkdirAll(); err != nil {
+			return fmt.Errorf("creating backup dir: %s", err)
+		}
+		backupDir = backupDir.Join(tx.previous.Version.String())
+		if err := previousDir.Rename(backupDir); err != nil {
+			return fmt.Errorf("moving %s to backup dir: %s", tx.previous, err)
+		}
+	}
+
+	if err := tx.stagingDir.Rename(destDir); err != nil {
+		if backupDir != nil {
+			if err := backupDir.Rename(previousDir); err != nil {
+				return fmt.Errorf("restoring %s: %s", tx.previous, err)
+			}
+		}
+		return fmt.Errorf("moving %s to destination dir: %s", tx.release, err)
+	}
+	removeIfEmpty(tx.stagingDir.Parent())
+
+	if tx.previous != nil {
+		tx.previous.InstallDir = nil
+	}
+	tx.installedTools = nil
+	return tx.pm.loadPlatformRelease(tx.release, destDir)
+}
+
+// Rollback undoes the changes made by the transaction: the staged
+// platform release is removed, or moved back into the backup directory if
+// it has been restored from it, and the installed tools are uninstalled.
+func (tx *PlatformInstallTransaction) Rollback() error {
+	if tx.restoreDir != nil {
+		if tx.stagingDir.Exist() {
+			if err := tx.stagingDir.Rename(tx.restoreDir); err != nil {
+				return fmt.Errorf("restoring backup: %s", err)
+			}
+		}
+	} else if err := tx.stagingDir.RemoveAll(); err != nil {
+		return fmt.Errorf("removing staged files: %s", err)
+	}
+	removeIfEmpty(tx.stagingDir.Parent())
+
+	for _, tool := range tx.installedTools {
+		if err := tx.pm.UninstallTool(tool); err != nil {
+			return err
+		}
+	}
+	tx.installedTools = nil
+	return nil
+}
+
+// NewPlatformRollbackTransaction starts the restore of the release of the
+// platform kept as backup by the last upgrade: the backup is staged and
+// Commit swaps it with the installed release replaced, that becomes the new
+// backup. If replaced is nil the default installed release is swapped (see
+// GetInstalledPlatformRelease).
+func (pm *PackageManager) NewPlatformRollbackTransaction(platform *cores.Platform, replaced *cores.PlatformRelease) (*PlatformInstallTransaction, error) {
+	backups, err := pm.platformBackupDir(platform).ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("no previous release of %s found", platform)
+	}
+	backups.FilterDirs()
+	if len(backups) != 1 {
+		return nil, fmt.Errorf("no previous release of %s found", platform)
+	}
+	version, err := semver.Parse(backups[0].Base())
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup of %s: %s", platform, err)
+	}
+	release, err := platform.GetOrCreateRelease(version)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup of %s: %s", platform, err)
+	}
+
+	if replaced == nil {
+		replaced = pm.GetInstalledPlatformRelease(platform)
+	}
+	tx := pm.NewPlatformInstallTransaction(release, replaced)
+	tx.restoreDir = backups[0]
+	if err := tx.stagingDir.Parent().MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating staging dir: %s", err)
+	}
+	if err := tx.stagingDir.RemoveAll(); err != nil {
+		return nil, fmt.Errorf("removing old staged files: %s", err)
+	}
+	if err := tx.restoreDir.Rename(tx.stagingDir); err != nil {
+		return nil, fmt.Errorf("staging backup of %s: %s", platform, err)
+	}
+	return tx, nil
+}
+
+// platformDir returns the directory containing the managed releases of a
+// platform.
+func (pm *PackageManager) platformDir(platform *cores.Platform) *paths.Path {
+	return pm.PackagesDir.Join(platform.Package.Name, "hardware", platform.Architecture)
+}
+
+// platformReleaseDir returns the directory where a platform release is
+// installed.
+func (pm *PackageManager) platformReleaseDir(platformRelease *cores.PlatformRelease) *paths.Path {
+	return pm.platformDir(platformRelease.Platform).Join(platformRelease.Version.String())
+}
+
+// platformBackupDir returns the directory where the release replaced by the
+// last upgrade of a platform is kept. It's a hidden directory so that it's
+// ignored when loading the hardware.
+func (pm *PackageManager) platformBackupDir(platform *cores.Platform) *paths.Path {
+	return pm.platformDir(platform).Join(".previous")
+}
+
+func removeIfEmpty(dir *paths.Path) {
+	if files, err := dir.ReadDir(); err == nil && len(files) == 0 {
+		dir.Remove()
+	}
+}
@@ -0,0 +1,184 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packagemanager_test
+
+import (
+	"archive/zip"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/resources"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"go.bug.st/relaxed-semver"
+)
+
+// writeZip creates in the download dir a zip archive with the given files.
+func writeZip(t *testing.T, downloadDir *paths.Path, name string, files map[string]string) *resources.DownloadResource {
+	require.NoError(t, downloadDir.Join("packages").MkdirAll())
+	file, err := os.Create(downloadDir.Join("packages", name).String())
+	require.NoError(t, err)
+	defer file.Close()
+	archive := zip.NewWriter(file)
+	for path, content := range files {
+		w, err := archive.Create(path)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	return &resources.DownloadResource{CachePath: "packages", ArchiveFileName: name}
+}
+
+func TestPlatformInstallTransaction(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "install_transaction_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	packagesDir := tmp.Join("packages")
+	downloadDir := tmp.Join("staging")
+	pm := packagemanager.NewPackageManager(tmp, packagesDir, downloadDir, tmp.Join("tmp"))
+
+	platform := pm.GetPackages().GetOrCreatePackage("test").GetOrCreatePlatform("avr")
+	newRelease := func(version string) *cores.PlatformRelease {
+		release, err := platform.GetOrCreateRelease(semver.MustParse(version))
+		require.NoError(t, err)
+		release.Resource = writeZip(t, downloadDir, "avr-"+version+".zip", map[string]string{
+			"avr/boards.txt":   "uno.name=Uno " + version + "\n",
+			"avr/platform.txt": "version=" + version + "\n",
+		})
+		return release
+	}
+	avrDir := packagesDir.Join("test", "hardware", "avr")
+
+	// First install
+	release1 := newRelease("1.0.0")
+	tx := pm.NewPlatformInstallTransaction(release1, nil)
+	require.Nil(t, tx.Previous())
+	require.NoError(t, tx.Stage())
+	require.NoError(t, tx.Commit())
+	require.Equal(t, avrDir.Join("1.0.0").String(), release1.InstallDir.String())
+	require.Equal(t, "Uno 1.0.0", release1.Boards["uno"].Name())
+
+	// A failed upgrade leaves the installed release untouched
+	release2 := newRelease("1.0.1")
+	tool := pm.GetPackages().GetOrCreatePackage("test").GetOrCreateTool("gcc").GetOrCreateRelease(semver.ParseRelaxed("1.0"))
+	tool.Flavors = []*cores.Flavor{{OS: "all", Resource: writeZip(t, downloadDir, "gcc-1.0.zip", map[string]string{"gcc/bin": "gcc"})}}
+	tx = pm.NewPlatformInstallTransaction(release2, release1)
+	require.Equal(t, release1, tx.Previous())
+	require.NoError(t, tx.Stage())
+	require.NoError(t, tx.InstallTool(tool))
+	require.True(t, tool.IsInstalled())
+	require.NoError(t, tx.Rollback())
+	require.False(t, tool.IsInstalled())
+	require.False(t, packagesDir.Join("test", "tools", "gcc", "1.0").Exist())
+	require.False(t, avrDir.Join(".staging").Exist())
+	require.False(t, avrDir.Join("1.0.1").Exist())
+	require.True(t, release1.IsInstalled())
+
+	// A missing archive fails the staging
+	release3 := newRelease("1.0.2")
+	require.NoError(t, downloadDir.Join("packages", "avr-1.0.2.zip").Remove())
+	require.Error(t, pm.NewPlatformInstallTransaction(release3, release1).Stage())
+	require.True(t, avrDir.Join("1.0.0", "boards.txt").Exist())
+
+	// Upgrade keeps the previous release as backup
+	tx = pm.NewPlatformInstallTransaction(release2, release1)
+	require.NoError(t, tx.Stage())
+	require.NoError(t, tx.Commit())
+	require.True(t, release2.IsInstalled())
+	require.False(t, release1.IsInstalled())
+	require.True(t, avrDir.Join(".previous", "1.0.0", "boards.txt").Exist())
+	require.False(t, avrDir.Join("1.0.0").Exist())
+	require.Equal(t, release2, pm.GetInstalledPlatformRelease(platform))
+
+	// Rollback swaps the backup with the installed release
+	tx, err = pm.NewPlatformRollbackTransaction(platform, nil)
+	require.NoError(t, err)
+	require.Equal(t, release1, tx.Release())
+	require.Equal(t, release2, tx.Previous())
+	require.NoError(t, tx.Commit())
+	require.True(t, release1.IsInstalled())
+	require.False(t, release2.IsInstalled())
+	require.True(t, avrDir.Join("1.0.0", "boards.txt").Exist())
+	require.True(t, avrDir.Join(".previous", "1.0.1", "boards.txt").Exist())
+	require.False(t, avrDir.Join(".previous", "1.0.0").Exist())
+
+	// An aborted rollback restores the backup
+	tx, err = pm.NewPlatformRollbackTransaction(platform, nil)
+	require.NoError(t, err)
+	require.False(t, avrDir.Join(".previous", "1.0.1").Exist())
+	require.NoError(t, tx.Rollback())
+	require.True(t, avrDir.Join(".previous", "1.0.1", "boards.txt").Exist())
+	require.True(t, release1.IsInstalled())
+
+	// Side by side install
+	release3 = newRelease("1.0.2")
+	tx = pm.NewPlatformInstallTransaction(release3, nil)
+	require.Nil(t, tx.Previous())
+	require.NoError(t, tx.Stage())
+	require.NoError(t, tx.Commit())
+	require.True(t, release1.IsInstalled())
+	require.True(t, release3.IsInstalled())
+	require.Equal(t, release3, pm.GetInstalledPlatformRelease(platform))
+
+	// The FQBN selects the release to use
+	resolve := func(fqbnIn string) (*cores.PlatformRelease, error) {
+		fqbn, err := cores.ParseFQBN(fqbnIn)
+		require.NoError(t, err)
+		_, release, _, _, _, err := pm.ResolveFQBN(fqbn)
+		return release, err
+	}
+	resolved, err := resolve("test:avr:uno")
+	require.NoError(t, err)
+	require.Equal(t, release3, resolved)
+	resolved, err = resolve("test:avr@1.0.0:uno")
+	require.NoError(t, err)
+	require.Equal(t, release1, resolved)
+	require.Equal(t, "Uno 1.0.0", release1.Boards["uno"].Name())
+	_, err = resolve("test:avr@1.0.1:uno")
+	require.EqualError(t, err, "platform test:avr@1.0.1 is not installed")
+
+	// Tools required by any installed release are required
+	release1.Dependencies = cores.ToolDependencies{
+		{ToolPackager: "test", ToolName: "gcc", ToolVersion: semver.ParseRelaxed("1.0")},
+	}
+	require.True(t, pm.IsToolRequired(tool))
+
+	// Loading the hardware ignores the backup
+	pm2 := packagemanager.NewPackageManager(tmp, packagesDir, downloadDir, tmp.Join("tmp"))
+	require.NoError(t, pm2.LoadHardwareFromDirectory(packagesDir))
+	require.Len(t, pm2.GetPackages().Packages["test"].Platforms["avr"].Releases, 2)
+
+	// Rollback replaces the pinned release, not the default one
+	tx, err = pm.NewPlatformRollbackTransaction(platform, pm.FindInstalledPlatformRelease(platform, release1.Version))
+	require.NoError(t, err)
+	require.Equal(t, release2, tx.Release())
+	require.Equal(t, release1, tx.Previous())
+	require.NoError(t, tx.Commit())
+	require.True(t, release2.IsInstalled())
+	require.True(t, release3.IsInstalled())
+	require.False(t, release1.IsInstalled())
+	require.True(t, avrDir.Join(".previous", "1.0.0", "boards.txt").Exist())
+	require.Nil(t, pm.FindInstalledPlatformRelease(platform, release1.Version))
+
+	other := pm.GetPackages().GetOrCreatePackage("test").GetOrCreatePlatform("sam")
+	_, err = pm.NewPlatformRollbackTransaction(other, nil)
+	require.Error(t, err)
+}
@@ -25,11 +25,7 @@ import (
 
 // InstallPlatform installs a specific release of a platform.
 func (pm *PackageManager) InstallPlatform(platformRelease *cores.PlatformRelease) error {
-	destDir := pm.PackagesDir.Join(
-		platformRelease.Platform.Package.Name,
-		"hardware",
-		platformRelease.Platform.Architecture,
-		platformRelease.Version.String())
+	destDir := pm.platformReleaseDir(platformRelease)
 	return platformRelease.Resource.Install(pm.DownloadDir, pm.TempDir, destDir)
 }
 
@@ -82,7 +78,11 @@ func (pm *PackageManager) InstallTool(toolRelease *cores.ToolRelease) error {
 		"tools",
 		toolRelease.Tool.Name,
 		toolRelease.Version.String())
-	return toolResource.Install(pm.DownloadDir, pm.TempDir, destDir)
+	if err := toolResource.Install(pm.DownloadDir, pm.TempDir, destDir); err != nil {
+		return err
+	}
+	toolRelease.InstallDir = destDir
+	return nil
 }
 
 // IsManagedToolRelease returns true if the ToolRelease is managed by the PackageManager
@@ -123,13 +123,13 @@ func (pm *PackageManager) UninstallTool(toolRelease *cores.ToolRelease) error {
 	return nil
 }
 
-// IsToolRequired returns true if any of the installed platforms requires the toolRelease
-// passed as parameter
+// IsToolRequired returns true if any of the installed platform releases requires the
+// toolRelease passed as parameter
 func (pm *PackageManager) IsToolRequired(toolRelease *cores.ToolRelease) bool {
 	// Search in all installed platforms
 	for _, targetPackage := range pm.packages.Packages {
 		for _, platform := range targetPackage.Platforms {
-			if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
+			for _, platformRelease := range platform.GetAllInstalled() {
 				if platformRelease.RequiresToolRelease(toolRelease) {
 					return true
 				}
@@ -0,0 +1,400 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packagemanager
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	"go.bug.st/relaxed-semver"
+)
+
+// builderProperties are the properties set by the builder and by the
+// upload when running the recipes, they are not defined in the platform.
+var builderProperties = map[string]bool{
+	"build.path":                    true,
+	"build.project_name":            true,
+	"build.arch":                    true,
+	"build.board":                   true,
+	"build.fqbn":                    true,
+	"build.core.path":               true,
+	"build.system.path":             true,
+	"build.variant.path":            true,
+	"build.source.path":             true,
+	"build.library_discovery_phase": true,
+	"compiler.warning_flags":        true,
+	"compiler.libraries.ldflags":    true,
+	"includes":                      true,
+	"source_file":                   true,
+	"object_file":                   true,
+	"object_files":                  true,
+	"archive_file":                  true,
+	"archive_file_path":             true,
+	"preprocessed_file_path":        true,
+	"ide_version":                   true,
+	"software":                      true,
+	"fqbn":                          true,
+	"codecomplete":                  true,
+	"serial.port":                   true,
+	"serial.port.file":              true,
+	"network.port":                  true,
+	"network.password":              true,
+	"upload.verbose":                true,
+	"upload.verify":                 true,
+	"program.verbose":               true,
+	"program.verify":                true,
+	"erase.verbose":                 true,
+	"bootloader.verbose":            true,
+}
+
+var placeholderRegexp = regexp.MustCompile(`\{([^{}]+)\}`)
+var usbIDRegexp = regexp.MustCompile(`^0[xX][0-9a-fA-F]{4}$`)
+
+// LintPlatform loads the platform in dir, a folder containing boards.txt
+// and platform.txt, and checks it for problems: undefined properties
+// referenced in the recipes, menu options without defaults, boards
+// without upload.tool or build.core, tools not declared in the package
+// index and invalid vid.N/pid.N pairs. The package and the architecture
+// are taken from the path, PACKAGER/hardware/ARCH/VERSION or
+// PACKAGER/ARCH. The results are sorted from the most severe.
+func (pm *PackageManager) LintPlatform(dir *paths.Path) (*cores.PlatformRelease, []*lint.Result, error) {
+	if err := dir.ToAbs(); err != nil {
+		return nil, nil, fmt.Errorf("find abs path: %s", err)
+	}
+	packager, architecture := dir.Parent().Base(), dir.Base()
+	if _, err := semver.Parse(dir.Base()); err == nil && dir.Parent().Parent().Base() == "hardware" {
+		packager, architecture = dir.Parent().Parent().Parent().Base(), dir.Parent().Base()
+	}
+
+	platform := cores.NewPackages().GetOrCreatePackage(packager).GetOrCreatePlatform(architecture)
+	release, err := platform.GetOrCreateRelease(semver.MustParse(""))
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := pm.loadPlatformRelease(release, dir); err != nil {
+		return nil, nil, fmt.Errorf("loading platform: %s", err)
+	}
+
+	linter := &platformLinter{pm: pm, platform: release, problems: map[string]*platformProblem{}}
+	linter.lintPlatformTxt()
+	linter.lintTools()
+	boardIDs := []string{}
+	for boardID := range release.Boards {
+		boardIDs = append(boardIDs, boardID)
+	}
+	sort.Strings(boardIDs)
+	for _, boardID := range boardIDs {
+		linter.lintBoard(release.Boards[boardID])
+	}
+	return release, linter.results(len(boardIDs)), nil
+}
+
+// platformProblem is a problem found in some of the boards of a
+// platform, or in the platform itself if boards is empty.
+type platformProblem struct {
+	result *lint.Result
+	boards []string
+}
+
+type platformLinter struct {
+	pm       *PackageManager
+	platform *cores.PlatformRelease
+	problems map[string]*platformProblem
+	order    []string
+	// inIndex is true if the platform is in the package index, then
+	// toolDependencies are the tools it depends on.
+	inIndex          bool
+	toolDependencies cores.ToolDependencies
+}
+
+// report records a problem, the same problem found in many boards is
+// reported once listing the boards. board is nil for problems of the
+// platform.
+func (linter *platformLinter) report(severity lint.Severity, check string, board *cores.Board, format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	key := severity.String() + "|" + check + "|" + message
+	problem, ok := linter.problems[key]
+	if !ok {
+		problem = &platformProblem{result: &lint.Result{Severity: severity, Check: check, Message: message}}
+		linter.problems[key] = problem
+		linter.order = append(linter.order, key)
+	}
+	if board != nil {
+		problem.boards = append(problem.boards, board.BoardID)
+	}
+}
+
+func (linter *platformLinter) results(boardsCount int) []*lint.Result {
+	res := []*lint.Result{}
+	for severity := lint.Error; severity >= lint.Info; severity-- {
+		for _, key := range linter.order {
+			problem := linter.problems[key]
+			if problem.result.Severity != severity {
+				continue
+			}
+			switch {
+			case len(problem.boards) == 0:
+			case len(problem.boards) == boardsCount && boardsCount > 1:
+				problem.result.Message += " (all boards)"
+			case len(problem.boards) == 1:
+				problem.result.Message += " (board " + problem.boards[0] + ")"
+			default:
+				problem.result.Message += " (boards " + strings.Join(problem.boards, ", ") + ")"
+			}
+			res = append(res, problem.result)
+		}
+	}
+	return res
+}
+
+// lintPlatformTxt checks the platform.txt file.
+func (linter *platformLinter) lintPlatformTxt() {
+	if !linter.platform.InstallDir.Join("platform.txt").Exist() {
+		linter.report(lint.Error, "missing-platform-txt", nil, "platform.txt not found")
+		return
+	}
+	for _, key := range []string{"name", "version"} {
+		if linter.platform.Properties.Get(key) == "" {
+			linter.report(lint.Warning, "missing-property", nil, "missing %s in platform.txt", key)
+		}
+	}
+	if len(linter.platform.Boards) == 0 {
+		linter.report(lint.Error, "missing-boards", nil, "no boards defined in boards.txt")
+	}
+}
+
+// lintTools looks for the platform in the package index to know the tools
+// it depends on.
+func (linter *platformLinter) lintTools() {
+	platform := linter.platform.Platform
+	var indexed *cores.PlatformRelease
+	if targetPackage := linter.pm.packages.Packages[platform.Package.Name]; targetPackage != nil {
+		if candidate := targetPackage.Platforms[platform.Architecture]; candidate != nil {
+			// Prefer the release with the same version, otherwise the latest
+			version, _ := semver.Parse(linter.platform.Properties.Get("version"))
+			for _, release := range candidate.Releases {
+				if release.Resource == nil {
+					continue
+				}
+				if version != nil && release.Version.Equal(version) {
+					indexed = release
+					break
+				}
+				if indexed == nil || release.Version.GreaterThan(indexed.Version) {
+					indexed = release
+				}
+			}
+		}
+	}
+	if indexed == nil {
+		linter.report(lint.Info, "not-in-index", nil,
+			"platform %s:%s not found in the package index, tools are checked against all the known tools",
+			platform.Package.Name, platform.Architecture)
+		return
+	}
+	linter.inIndex = true
+	linter.toolDependencies = indexed.Dependencies
+}
+
+// toolDeclared returns true if the tool is declared in the package index
+// as a dependency of the platform or, if the platform is not in the
+// index, if any package provides it. name may be TOOL, TOOL-VERSION or
+// PACKAGER:TOOL.
+func (linter *platformLinter) toolDeclared(name string) bool {
+	packager := ""
+	if split := strings.SplitN(name, ":", 2); len(split) == 2 {
+		packager, name = split[0], split[1]
+	}
+	if linter.inIndex {
+		for _, dep := range linter.toolDependencies {
+			if packager != "" && dep.ToolPackager != packager {
+				continue
+			}
+			if dep.ToolName == name || dep.ToolName+"-"+dep.ToolVersion.String() == name {
+				return true
+			}
+		}
+		return false
+	}
+	for _, targetPackage := range linter.pm.packages.Packages {
+		if packager != "" && targetPackage.Name != packager {
+			continue
+		}
+		for _, tool := range targetPackage.Tools {
+			if tool.Name == name {
+				return true
+			}
+			for _, release := range tool.Releases {
+				if tool.Name+"-"+release.Version.String() == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// lintBoard checks a board with the default configuration and the
+// recipes of the platform used to build and upload it.
+func (linter *platformLinter) lintBoard(board *cores.Board) {
+	if board.Name() == "" {
+		linter.report(lint.Error, "missing-property", board, "missing name")
+	}
+	linter.lintMenus(board)
+	linter.lintUSBIDs(board)
+
+	boardProperties, err := board.GetBuildProperties(properties.NewMap())
+	if err != nil {
+		linter.report(lint.Error, "invalid-menu", board, "%s", err)
+		return
+	}
+	buildProperties := linter.platform.Properties.Clone()
+	buildProperties.Merge(boardProperties)
+
+	if buildProperties.Get("build.core") == "" {
+		linter.report(lint.Error, "missing-build-core", board, "missing build.core")
+	}
+	for _, recipe := range buildProperties.Keys() {
+		if strings.HasPrefix(recipe, "recipe.") {
+			linter.lintRecipe(board, buildProperties, recipe)
+		}
+	}
+
+	uploadTool := buildProperties.Get("upload.tool")
+	if uploadTool == "" {
+		linter.report(lint.Error, "missing-upload-tool", board, "missing upload.tool")
+		return
+	}
+	if !linter.toolDeclared(uploadTool) {
+		linter.report(lint.Error, "undeclared-tool", board, "upload.tool %s is not declared in the package index", uploadTool)
+	}
+	toolName := uploadTool
+	if i := strings.Index(toolName, ":"); i != -1 {
+		toolName = toolName[i+1:]
+	}
+	recipe := "tools." + toolName + ".upload.pattern"
+	if !buildProperties.ContainsKey(recipe) {
+		linter.report(lint.Error, "missing-recipe", board, "missing %s in platform.txt", recipe)
+		return
+	}
+	uploadProperties := buildProperties.Clone()
+	uploadProperties.Merge(buildProperties.SubTree("tools." + toolName))
+	linter.lintRecipe(board, uploadProperties, recipe)
+}
+
+// lintRecipe checks that the properties referenced by the recipe, and by
+// the properties it references, are defined.
+func (linter *platformLinter) lintRecipe(board *cores.Board, props *properties.Map, recipe string) {
+	visited := map[string]bool{}
+	var walk func(value string)
+	walk = func(value string) {
+		for _, match := range placeholderRegexp.FindAllStringSubmatch(value, -1) {
+			name := match[1]
+			if visited[name] {
+				continue
+			}
+			visited[name] = true
+			if strings.HasPrefix(name, "runtime.tools.") {
+				tool := strings.TrimPrefix(name, "runtime.tools.")
+				for _, suffix := range []string{".path", ".version"} {
+					tool = strings.TrimSuffix(tool, suffix)
+				}
+				if !linter.toolDeclared(tool) {
+					linter.report(lint.Error, "undeclared-tool", board,
+						"%s references %s but tool %s is not declared in the package index", recipe, name, tool)
+				}
+				continue
+			}
+			if builderProperties[name] || strings.HasPrefix(name, "runtime.") || strings.HasPrefix(name, "extra.time.") {
+				continue
+			}
+			if v, ok := props.GetOk(name); ok {
+				walk(v)
+				continue
+			}
+			linter.report(lint.Warning, "undefined-property", board,
+				"%s references undefined property %s", recipe, name)
+		}
+	}
+	walk(props.Get(recipe))
+}
+
+// lintMenus checks the menu options of the board: they must be declared
+// with a title in boards.txt and the default value, the first one, must
+// define all the properties defined by the other values.
+func (linter *platformLinter) lintMenus(board *cores.Board) {
+	menu := board.Properties.SubTree("menu")
+	for _, option := range menu.FirstLevelKeys() {
+		if linter.platform.Menus == nil || !linter.platform.Menus.ContainsKey(option) {
+			linter.report(lint.Error, "undeclared-menu", board, "menu %s is not declared, add menu.%s=TITLE to boards.txt", option, option)
+		}
+		optionMenu := menu.SubTree(option)
+		values := optionMenu.FirstLevelKeys()
+		if len(values) == 0 {
+			linter.report(lint.Error, "invalid-menu", board, "menu %s has no values, add menu.%s.VALUE=LABEL to boards.txt", option, option)
+			continue
+		}
+		for _, value := range values {
+			if !optionMenu.ContainsKey(value) {
+				linter.report(lint.Warning, "missing-menu-label", board, "value %s of menu %s has no label", value, option)
+			}
+		}
+		defaults := optionMenu.SubTree(values[0])
+		for _, value := range values[1:] {
+			for _, key := range optionMenu.SubTree(value).Keys() {
+				if !defaults.ContainsKey(key) && !board.Properties.ContainsKey(key) {
+					linter.report(lint.Warning, "missing-menu-default", board,
+						"menu %s: %s is defined by %s but not by the default value %s", option, key, value, values[0])
+				}
+			}
+		}
+	}
+}
+
+// lintUSBIDs checks that the vid.N/pid.N properties come in pairs of
+// valid USB ids.
+func (linter *platformLinter) lintUSBIDs(board *cores.Board) {
+	vids := board.Properties.SubTree("vid")
+	pids := board.Properties.SubTree("pid")
+	for _, id := range vids.Keys() {
+		if !pids.ContainsKey(id) {
+			linter.report(lint.Error, "invalid-usb-id", board, "vid.%s without pid.%s", id, id)
+		}
+	}
+	for _, id := range pids.Keys() {
+		if !vids.ContainsKey(id) {
+			linter.report(lint.Error, "invalid-usb-id", board, "pid.%s without vid.%s", id, id)
+		}
+	}
+	for _, id := range vids.Keys() {
+		if vid := vids.Get(id); !usbIDRegexp.MatchString(vid) {
+			linter.report(lint.Error, "invalid-usb-id", board, "vid.%s=%s is not a valid USB id, e.g. 0x2341", id, vid)
+		}
+	}
+	for _, id := range pids.Keys() {
+		if pid := pids.Get(id); !usbIDRegexp.MatchString(pid) {
+			linter.report(lint.Error, "invalid-usb-id", board, "pid.%s=%s is not a valid USB id, e.g. 0x0043", id, pid)
+		}
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/arduino/resources"
 	"github.com/arduino/go-paths-helper"
 	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/sirupsen/logrus"
@@ -45,6 +46,10 @@ type PackageManager struct {
 	PackagesDir *paths.Path
 	DownloadDir *paths.Path
 	TempDir     *paths.Path
+
+	// MaxParallelDownloads is the number of archives downloaded in
+	// parallel, resources.DefaultMaxParallelDownloads if not positive.
+	MaxParallelDownloads int
 }
 
 // NewPackageManager returns a new instance of the PackageManager
@@ -131,7 +136,8 @@ func (pm *PackageManager) FindBoardWithFQBN(fqbnIn string) (*cores.Board, error)
 
 // ResolveFQBN returns, in order:
 // - the Package pointed by the fqbn
-// - the PlatformRelease pointed by the fqbn
+// - the PlatformRelease pointed by the fqbn: the release pinned by the
+//   fqbn if any, otherwise the one chosen by GetInstalledPlatformRelease
 // - the Board pointed by the fqbn
 // - the build properties for the board considering also the
 //   configuration part of the fqbn
@@ -159,8 +165,12 @@ func (pm *PackageManager) ResolveFQBN(fqbn *cores.FQBN) (
 		return targetPackage, nil, nil, nil, nil,
 			fmt.Errorf("unknown platform %s:%s", targetPackage, fqbn.PlatformArch)
 	}
-	platformRelease := pm.GetInstalledPlatformRelease(platform)
+	platformRelease := pm.FindInstalledPlatformRelease(platform, fqbn.PlatformVersion)
 	if platformRelease == nil {
+		if fqbn.PlatformVersion != nil {
+			return targetPackage, nil, nil, nil, nil,
+				fmt.Errorf("platform %s@%s is not installed", platform, fqbn.PlatformVersion)
+		}
 		return targetPackage, nil, nil, nil, nil,
 			fmt.Errorf("platform %s is not installed", platform)
 	}
@@ -179,27 +189,38 @@ func (pm *PackageManager) ResolveFQBN(fqbn *cores.FQBN) (
 	}
 
 	// Determine the platform used for the build (in case the board refers
-	// to a core contained in another platform)
+	// to a core contained in another platform). The pinned version applies
+	// only to the platform of the fqbn.
 	buildPlatformRelease := platformRelease
 	coreParts := strings.Split(buildProperties.Get("build.core"), ":")
-	if len(coreParts) > 1 {
-		referredPackage := coreParts[1]
+	if len(coreParts) > 1 && coreParts[0] != fqbn.Package {
+		referredPackage := coreParts[0]
 		buildPackage := pm.packages.Packages[referredPackage]
 		if buildPackage == nil {
 			return targetPackage, platformRelease, board, buildProperties, nil,
 				fmt.Errorf("missing package %s:%s required for build", referredPackage, platform)
 		}
-		buildPlatform := buildPackage.Platforms[fqbn.PlatformArch]
-		buildPlatformRelease = pm.GetInstalledPlatformRelease(buildPlatform)
+		if buildPlatform := buildPackage.Platforms[fqbn.PlatformArch]; buildPlatform != nil {
+			buildPlatformRelease = pm.GetInstalledPlatformRelease(buildPlatform)
+		}
+		if buildPlatformRelease == nil {
+			return targetPackage, platformRelease, board, buildProperties, nil,
+				fmt.Errorf("missing platform %s:%s required for build", referredPackage, fqbn.PlatformArch)
+		}
 	}
 
 	// No errors... phew!
 	return targetPackage, platformRelease, board, buildProperties, buildPlatformRelease, nil
 }
 
-// LoadPackageIndex loads a package index by looking up the local cached file from the specified URL
+// LoadPackageIndex loads a package index by looking up the local cached file from the specified URL,
+// file:// URLs are loaded directly from their location.
 func (pm *PackageManager) LoadPackageIndex(URL *url.URL) error {
-	_, err := pm.LoadPackageIndexFromFile(pm.IndexDir.Join(path.Base(URL.Path)))
+	indexPath := resources.LocalFilePath(URL)
+	if indexPath == nil {
+		indexPath = pm.IndexDir.Join(path.Base(URL.Path))
+	}
+	_, err := pm.LoadPackageIndexFromFile(indexPath)
 	return err
 }
 
@@ -318,6 +339,8 @@ func (tr *ToolReleaseActions) Get() (*cores.ToolRelease, error) {
 }
 
 // GetInstalledPlatformRelease returns the PlatformRelease installed (it is chosen)
+// or, if more releases are installed side by side, the default one: the
+// highest managed release. An FQBN may pin another release, see ResolveFQBN.
 func (pm *PackageManager) GetInstalledPlatformRelease(platform *cores.Platform) *cores.PlatformRelease {
 	releases := platform.GetAllInstalled()
 	if len(releases) == 0 {
@@ -340,6 +363,21 @@ func (pm *PackageManager) GetInstalledPlatformRelease(platform *cores.Platform)
 	return best
 }
 
+// FindInstalledPlatformRelease returns the installed release of the
+// platform with the given version or, if version is nil, the one chosen by
+// GetInstalledPlatformRelease. It returns nil if the release is not
+// installed.
+func (pm *PackageManager) FindInstalledPlatformRelease(platform *cores.Platform, version *semver.Version) *cores.PlatformRelease {
+	if version == nil {
+		return pm.GetInstalledPlatformRelease(platform)
+	}
+	release := platform.FindReleaseWithVersion(version)
+	if release == nil || !release.IsInstalled() {
+		return nil
+	}
+	return release
+}
+
 func (pm *PackageManager) GetAllInstalledToolsReleases() []*cores.ToolRelease {
 	tools := []*cores.ToolRelease{}
 	for _, targetPackage := range pm.packages.Packages {
@@ -354,39 +392,16 @@ func (pm *PackageManager) GetAllInstalledToolsReleases() []*cores.ToolRelease {
 	return tools
 }
 
+// FindToolsRequiredForBoard returns the tool releases selected by
+// ResolveToolsForBoard.
 func (pm *PackageManager) FindToolsRequiredForBoard(board *cores.Board) ([]*cores.ToolRelease, error) {
-	pm.Log.Infof("Searching tools required for board %s", board)
-
-	// core := board.Properties["build.core"]
-	platform := board.PlatformRelease
-
-	// maps "PACKAGER:TOOL" => ToolRelease
-	foundTools := map[string]*cores.ToolRelease{}
-
-	// a Platform may not specify required tools (because it's a platform that comes from a
-	// sketchbook/hardware dir without a package_index.json) then add all available tools
-	for _, targetPackage := range pm.packages.Packages {
-		for _, tool := range targetPackage.Tools {
-			rel := tool.GetLatestInstalled()
-			if rel != nil {
-				foundTools[rel.Tool.Name] = rel
-			}
-		}
-	}
-
-	// replace the default tools above with the specific required by the current platform
-	for _, toolDep := range platform.Dependencies {
-		pm.Log.WithField("tool", toolDep).Infof("Required tool")
-		tool := pm.FindToolDependency(toolDep)
-		if tool == nil {
-			return nil, fmt.Errorf("tool release not found: %s", toolDep)
-		}
-		foundTools[tool.Tool.Name] = tool
+	resolutions, err := pm.ResolveToolsForBoard(board)
+	if err != nil {
+		return nil, err
 	}
-
 	requiredTools := []*cores.ToolRelease{}
-	for _, toolRel := range foundTools {
-		requiredTools = append(requiredTools, toolRel)
+	for _, resolution := range resolutions {
+		requiredTools = append(requiredTools, resolution.Release)
 	}
 	return requiredTools, nil
 }
@@ -25,6 +25,7 @@ import (
 
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/configs"
 	"github.com/arduino/go-paths-helper"
 	"github.com/arduino/go-properties-orderedmap"
@@ -49,6 +50,29 @@ func TestFindBoardWithFQBN(t *testing.T) {
 	require.Equal(t, board.Name(), "Arduino/Genuino Mega or Mega 2560")
 }
 
+func TestIdentifyBoard(t *testing.T) {
+	pm := packagemanager.NewPackageManager(customHardware, customHardware, customHardware, customHardware)
+	pm.LoadHardwareFromDirectory(customHardware)
+
+	boards := pm.IdentifyBoard(&discovery.Port{
+		Address:    "/dev/ttyACM0",
+		Protocol:   "serial",
+		Properties: properties.NewFromHashmap(map[string]string{"vid": "0x2341", "pid": "0x0043"}),
+	})
+	require.Len(t, boards, 1)
+	require.Equal(t, "arduino:avr:uno", boards[0].FQBN())
+
+	boards = pm.IdentifyBoard(&discovery.Port{
+		Address:    "/dev/ttyUSB0",
+		Protocol:   "serial",
+		Properties: properties.NewFromHashmap(map[string]string{"vid": "0x0403", "pid": "0x6001"}),
+	})
+	require.Empty(t, boards)
+
+	boards = pm.IdentifyBoard(&discovery.Port{Address: "192.168.1.5", Protocol: "network"})
+	require.Empty(t, boards)
+}
+
 func TestBoardOptionsFunctions(t *testing.T) {
 	pm := packagemanager.NewPackageManager(customHardware, customHardware, customHardware, customHardware)
 	pm.LoadHardwareFromDirectory(customHardware)
@@ -139,3 +163,112 @@ func TestFindToolsRequiredForBoard(t *testing.T) {
 	testConflictingToolsInDifferentPackages()
 	testConflictingToolsInDifferentPackages()
 }
+
+func TestResolveToolsForBoard(t *testing.T) {
+	pm := packagemanager.NewPackageManager(
+		dataDir1,
+		dataDir1.Join("packages"),
+		dataDir1.Join("staging"),
+		dataDir1)
+	loadIndex := func(addr string) {
+		res, err := url.Parse(addr)
+		require.NoError(t, err)
+		require.NoError(t, pm.LoadPackageIndex(res))
+	}
+	loadIndex("https://dl.espressif.com/dl/package_esp32_index.json")
+	loadIndex("http://arduino.esp8266.com/stable/package_esp8266com_index.json")
+	require.NoError(t, pm.LoadHardware(&configs.Configuration{DataDir: dataDir1}))
+	esp32, err := pm.FindBoardWithFQBN("esp32:esp32:esp32")
+	require.NoError(t, err)
+
+	resolutions, err := pm.ResolveToolsForBoard(esp32)
+	require.NoError(t, err)
+	reasons := map[string]string{}
+	for _, resolution := range resolutions {
+		reasons[resolution.Release.String()] = resolution.Reason
+	}
+	require.Equal(t, "required by esp32:esp32@1.0.0", reasons["esp32:esptool@2.3.1"])
+	require.Equal(t, "required by esp32:esp32@1.0.0", reasons["esp32:mkspiffs@0.2.3"])
+	require.Equal(t, "latest installed, not required by esp32:esp32@1.0.0", reasons["esp8266:xtensa-lx106-elf-gcc@1.20.0-26-gb404fb9-2"])
+	require.NotContains(t, reasons, "esp8266:esptool@0.4.13")
+
+	// The tools of a platform referenced by build.core are pinned too, the
+	// ones of the board platform win
+	refPlatform := pm.GetPackages().GetOrCreatePackage("esp8266").GetOrCreatePlatform("esp32")
+	refRelease, err := refPlatform.GetOrCreateRelease(semver.MustParse("1.0.0"))
+	require.NoError(t, err)
+	refRelease.InstallDir = dataDir1.Join("packages", "esp8266", "hardware", "esp32", "1.0.0")
+	refRelease.Dependencies = cores.ToolDependencies{
+		{ToolPackager: "esp8266", ToolName: "xtensa-lx106-elf-gcc", ToolVersion: semver.ParseRelaxed("1.20.0-26-gb404fb9-2")},
+		{ToolPackager: "esp8266", ToolName: "esptool", ToolVersion: semver.ParseRelaxed("0.4.13")},
+	}
+	esp32.Properties.Set("build.core", "esp8266:esp8266")
+	resolutions, err = pm.ResolveToolsForBoard(esp32)
+	require.NoError(t, err)
+	reasons = map[string]string{}
+	for _, resolution := range resolutions {
+		reasons[resolution.Release.String()] = resolution.Reason
+	}
+	require.Equal(t, "required by esp8266:esp32@1.0.0", reasons["esp8266:xtensa-lx106-elf-gcc@1.20.0-26-gb404fb9-2"])
+	require.Equal(t, "required by esp32:esp32@1.0.0", reasons["esp32:esptool@2.3.1"])
+	require.NotContains(t, reasons, "esp8266:esptool@0.4.13")
+	esp32.Properties.Set("build.core", "esp32")
+
+	// A required tool not installed is an error
+	deps := esp32.PlatformRelease.Dependencies
+	defer func() { esp32.PlatformRelease.Dependencies = deps }()
+	esp32.PlatformRelease.Dependencies = cores.ToolDependencies{
+		{ToolPackager: "esp32", ToolName: "esptool", ToolVersion: semver.ParseRelaxed("2.6.0")},
+	}
+	_, err = pm.ResolveToolsForBoard(esp32)
+	require.EqualError(t, err, "tool esp32:esptool@2.6.0 required by esp32:esp32@1.0.0 is not installed")
+
+	// Without declared tools the latest installed are used, preferring the platform package
+	esp32.PlatformRelease.Dependencies = nil
+	resolutions, err = pm.ResolveToolsForBoard(esp32)
+	require.NoError(t, err)
+	for _, resolution := range resolutions {
+		if resolution.Release.Tool.Name == "esptool" {
+			require.Equal(t, "esp32:esptool@2.3.1", resolution.Release.String())
+			require.Equal(t, "latest installed, esp32:esp32@1.0.0 doesn't declare its tools", resolution.Reason)
+		}
+	}
+}
+
+func TestLintPlatform(t *testing.T) {
+	pm := packagemanager.NewPackageManager(dataDir1, dataDir1.Join("packages"), dataDir1.Join("staging"), dataDir1)
+	_, err := pm.LoadPackageIndexFromFile(dataDir1.Join("package_index.json"))
+	require.NoError(t, err)
+
+	platform, results, err := pm.LintPlatform(paths.New("testdata", "lint_hardware", "arduino", "hardware", "avr", "1.6.4"))
+	require.NoError(t, err)
+	require.Equal(t, "arduino", platform.Platform.Package.Name)
+	require.Equal(t, "avr", platform.Platform.Architecture)
+	require.Len(t, platform.Boards, 3)
+
+	messages := []string{}
+	for _, r := range results {
+		messages = append(messages, r.String())
+	}
+	require.Equal(t, []string{
+		"error: menu clock is not declared, add menu.clock=TITLE to boards.txt (board bad)",
+		"error: vid.0 without pid.0 (board bad)",
+		"error: vid.1=2341 is not a valid USB id, e.g. 0x2341 (board bad)",
+		"error: missing build.core (board bad)",
+		"error: recipe.size.pattern references runtime.tools.avr-size.path but tool avr-size is not declared in the package index (all boards)",
+		"error: missing upload.tool (board bad)",
+		"error: menu cpu has no values, add menu.cpu.VALUE=LABEL to boards.txt (board other)",
+		"error: upload.tool bossac is not declared in the package index (board other)",
+		"error: missing tools.bossac.upload.pattern in platform.txt (board other)",
+		"warning: menu cpu: build.mcu is defined by small but not by the default value fast (board bad)",
+		"warning: value 8mhz of menu clock has no label (board bad)",
+		"warning: recipe.c.o.pattern references undefined property build.mcu (board bad)",
+		"warning: recipe.c.o.pattern references undefined property build.extra_flags (boards bad, other)",
+	}, messages)
+
+	// Without the package index the tools are searched in all the packages
+	pm = packagemanager.NewPackageManager(dataDir1, dataDir1.Join("packages"), dataDir1.Join("staging"), dataDir1)
+	_, results, err = pm.LintPlatform(paths.New("testdata", "lint_hardware", "arduino", "hardware", "avr", "1.6.4"))
+	require.NoError(t, err)
+	require.Equal(t, "not-in-index", results[len(results)-1].Check)
+}
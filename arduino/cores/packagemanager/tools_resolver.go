@@ -0,0 +1,148 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package packagemanager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/cores"
+)
+
+// ToolResolution is a tool release selected by ResolveToolsForBoard
+// together with the reason of the choice.
+type ToolResolution struct {
+	Release *cores.ToolRelease
+	Reason  string
+}
+
+func (res *ToolResolution) String() string {
+	return res.Release.String() + " (" + res.Reason + ")"
+}
+
+// ResolveToolsForBoard selects the tool releases used to build and upload
+// for a board, one for every tool name. The tools declared as dependencies
+// by the platform of the board, and by the platforms it references with a
+// VENDOR:ID build.core or upload.tool, are pinned to the declared version,
+// and it's an error if that version is not installed. For the other tools
+// (all of them if the platforms don't come from a package index and don't
+// declare their dependencies) the latest installed release is selected,
+// preferring the package of the platform when more packages provide a tool
+// with the same name, and a warning is logged. The choices are logged.
+func (pm *PackageManager) ResolveToolsForBoard(board *cores.Board) ([]*ToolResolution, error) {
+	platform := board.PlatformRelease
+	log := pm.Log.WithField("board", board)
+	log.Infof("Resolving tools for platform %s", platform)
+
+	// maps tool name => ToolResolution
+	resolved := map[string]*ToolResolution{}
+	declaring := append([]*cores.PlatformRelease{platform}, pm.referencedPlatforms(board)...)
+	for _, declaringPlatform := range declaring {
+		for _, dep := range declaringPlatform.Dependencies {
+			if _, ok := resolved[dep.ToolName]; ok {
+				continue
+			}
+			toolRelease := pm.FindToolDependency(dep)
+			if toolRelease == nil || !toolRelease.IsInstalled() {
+				return nil, fmt.Errorf("tool %s required by %s is not installed", dep, declaringPlatform)
+			}
+			resolved[dep.ToolName] = &ToolResolution{
+				Release: toolRelease,
+				Reason:  "required by " + declaringPlatform.String(),
+			}
+		}
+	}
+
+	fallbackReason := "latest installed, not required by " + platform.String()
+	if len(resolved) == 0 {
+		fallbackReason = "latest installed, " + platform.String() + " doesn't declare its tools"
+	}
+	// The package of the platform comes first, then the others by name
+	packages := []*cores.Package{platform.Platform.Package}
+	packageNames := []string{}
+	for name := range pm.packages.Packages {
+		packageNames = append(packageNames, name)
+	}
+	sort.Strings(packageNames)
+	for _, name := range packageNames {
+		if targetPackage := pm.packages.Packages[name]; targetPackage != platform.Platform.Package {
+			packages = append(packages, targetPackage)
+		}
+	}
+	for _, targetPackage := range packages {
+		for _, tool := range targetPackage.Tools {
+			if _, ok := resolved[tool.Name]; ok {
+				continue
+			}
+			if toolRelease := tool.GetLatestInstalled(); toolRelease != nil {
+				log.Warnf("Tool %s is not declared by %s, using the latest installed %s", tool, platform, toolRelease)
+				resolved[tool.Name] = &ToolResolution{Release: toolRelease, Reason: fallbackReason}
+			}
+		}
+	}
+
+	res := []*ToolResolution{}
+	for _, resolution := range resolved {
+		res = append(res, resolution)
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Release.String() < res[j].Release.String() })
+	for _, resolution := range res {
+		log.WithField("tool", resolution.Release).Info("Selected tool: " + resolution.Reason)
+	}
+	return res, nil
+}
+
+// referencedPlatforms returns the installed platforms, with the same
+// architecture of the board, of the other packages referenced by the
+// VENDOR:ID build.core and upload.tool of the board, including the ones
+// set by the menu options.
+func (pm *PackageManager) referencedPlatforms(board *cores.Board) []*cores.PlatformRelease {
+	res := []*cores.PlatformRelease{}
+	for _, key := range board.Properties.Keys() {
+		if key != "build.core" && key != "upload.tool" &&
+			!strings.HasSuffix(key, ".build.core") && !strings.HasSuffix(key, ".upload.tool") {
+			continue
+		}
+		parts := strings.Split(board.Properties.Get(key), ":")
+		if len(parts) < 2 || parts[0] == board.PlatformRelease.Platform.Package.Name {
+			continue
+		}
+		targetPackage := pm.packages.Packages[parts[0]]
+		if targetPackage == nil {
+			continue
+		}
+		targetPlatform := targetPackage.Platforms[board.PlatformRelease.Platform.Architecture]
+		if targetPlatform == nil {
+			continue
+		}
+		if release := pm.GetInstalledPlatformRelease(targetPlatform); release != nil && !containsPlatformRelease(res, release) {
+			res = append(res, release)
+		}
+	}
+	return res
+}
+
+func containsPlatformRelease(releases []*cores.PlatformRelease, release *cores.PlatformRelease) bool {
+	for _, r := range releases {
+		if r == release {
+			return true
+		}
+	}
+	return false
+}
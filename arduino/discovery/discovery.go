@@ -0,0 +1,86 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	properties "github.com/arduino/go-properties-orderedmap"
+)
+
+// Port is a communication port where a board may be connected, as
+// reported by a Discovery.
+type Port struct {
+	// Address is the address of the port in the format used by the
+	// protocol, for example "/dev/ttyACM0" or "192.168.1.5".
+	Address string `json:"address"`
+	// AddressLabel is the address in a human readable format.
+	AddressLabel string `json:"label,omitempty"`
+	// Protocol is the protocol used to talk to the port, for example
+	// "serial" or "network".
+	Protocol string `json:"protocol,omitempty"`
+	// ProtocolLabel is the protocol in a human readable format.
+	ProtocolLabel string `json:"protocolLabel,omitempty"`
+	// Properties are the identification properties of the port (for
+	// example the USB VID/PID), they are used to find the boards
+	// connected to the port.
+	Properties *properties.Map `json:"properties,omitempty"`
+}
+
+func (p *Port) String() string {
+	if p.Protocol == "" {
+		return p.Address
+	}
+	return p.Protocol + "://" + p.Address
+}
+
+// Event is a change of the ports detected by a Discovery.
+type Event struct {
+	Type string // "add" or "remove"
+	Port *Port
+}
+
+// Discovery finds the ports where boards may be connected. A Discovery
+// works either in polling mode, started with Start and queried with
+// List, or in event mode, started with StartSync: in this case a
+// stream of "add" and "remove" events is sent on the returned channel.
+type Discovery interface {
+	// ID returns the identifier of the discovery.
+	ID() string
+
+	// Start starts the detection of the ports in background.
+	Start() error
+
+	// List returns the ports detected so far, the discovery must have
+	// been started with Start.
+	List() ([]*Port, error)
+
+	// StartSync starts the detection of the ports in event mode. An
+	// "add" event is sent for every port already present. The channel
+	// is closed when the discovery is stopped.
+	StartSync() (<-chan *Event, error)
+
+	// Stop stops the detection of the ports, it may be started again.
+	Stop() error
+
+	// Quit stops the discovery and releases all its resources.
+	Quit()
+}
+
+// portKey returns a key that identifies a port across List calls.
+func portKey(port *Port) string {
+	return port.Protocol + "://" + port.Address
+}
@@ -0,0 +1,186 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"io"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeScanner provides the ports to a pollingDiscovery
+type fakeScanner struct {
+	mux   sync.Mutex
+	ports []*Port
+}
+
+func (f *fakeScanner) set(ports ...*Port) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	f.ports = ports
+}
+
+func (f *fakeScanner) scan() ([]*Port, error) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+	return append([]*Port{}, f.ports...), nil
+}
+
+func nextEvent(t *testing.T, events <-chan *Event) *Event {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timeout waiting for event")
+		return nil
+	}
+}
+
+func TestPluggableDiscoveryProtocol(t *testing.T) {
+	port1 := &Port{Address: "AA:BB", Protocol: "ble"}
+	port2 := &Port{Address: "CC:DD", Protocol: "ble", AddressLabel: "Nano 33 BLE"}
+
+	scanner := &fakeScanner{}
+	scanner.set(port1)
+	server := newPollingDiscovery("fake", 10*time.Millisecond, scanner.scan)
+
+	// Connect a client to the server through pipes, like a tool launched
+	// as an external process
+	cmdReader, cmdWriter := io.Pipe()
+	replyReader, replyWriter := io.Pipe()
+	served := make(chan error)
+	go func() {
+		served <- Serve(server, cmdReader, replyWriter)
+		replyWriter.Close()
+	}()
+	client := NewPluggableDiscovery("fake", nil)
+	client.connect(cmdWriter, replyReader)
+
+	_, err := client.List()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "discovery not started")
+
+	client.mux.Lock()
+	_, err = client.sendCommand("FOO")
+	client.mux.Unlock()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "command not supported: FOO")
+
+	// Polling mode
+	require.NoError(t, client.Start())
+	require.Error(t, client.Start())
+	var ports []*Port
+	for i := 0; i < 100 && len(ports) == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+		ports, err = client.List()
+		require.NoError(t, err)
+	}
+	require.Len(t, ports, 1)
+	require.Equal(t, "ble://AA:BB", ports[0].String())
+	require.NoError(t, client.Stop())
+
+	// Event mode
+	events, err := client.StartSync()
+	require.NoError(t, err)
+	event := nextEvent(t, events)
+	require.Equal(t, "add", event.Type)
+	require.Equal(t, "AA:BB", event.Port.Address)
+
+	scanner.set(port1, port2)
+	event = nextEvent(t, events)
+	require.Equal(t, "add", event.Type)
+	require.Equal(t, "CC:DD", event.Port.Address)
+	require.Equal(t, "Nano 33 BLE", event.Port.AddressLabel)
+
+	scanner.set(port2)
+	event = nextEvent(t, events)
+	require.Equal(t, "remove", event.Type)
+	require.Equal(t, "AA:BB", event.Port.Address)
+
+	require.NoError(t, client.Stop())
+	_, open := <-events
+	require.False(t, open, "events channel closed")
+
+	client.Quit()
+	require.NoError(t, <-served)
+	require.Error(t, client.Stop())
+}
+
+func TestPluggableDiscoveryRelaunch(t *testing.T) {
+	// A first tool process, whose output is controlled by the test
+	oldCmdReader, oldCmdWriter := io.Pipe()
+	go io.Copy(ioutil.Discard, oldCmdReader)
+	oldReplyReader, oldReplyWriter := io.Pipe()
+	client := NewPluggableDiscovery("fake", nil)
+	client.connect(oldCmdWriter, oldReplyReader)
+	client.mux.Lock()
+	oldReplies := client.replies
+	client.terminate()
+	client.mux.Unlock()
+
+	// The discovery is relaunched and started in event mode
+	scanner := &fakeScanner{}
+	scanner.set(&Port{Address: "AA:BB", Protocol: "ble"})
+	server := newPollingDiscovery("fake", 10*time.Millisecond, scanner.scan)
+	cmdReader, cmdWriter := io.Pipe()
+	replyReader, replyWriter := io.Pipe()
+	served := make(chan error)
+	go func() {
+		served <- Serve(server, cmdReader, replyWriter)
+		replyWriter.Close()
+	}()
+	client.connect(cmdWriter, replyReader)
+	events, err := client.StartSync()
+	require.NoError(t, err)
+	require.Equal(t, "AA:BB", nextEvent(t, events).Port.Address)
+
+	// The first process sends a late event and exits
+	_, err = io.WriteString(oldReplyWriter, `{"eventType":"add","port":{"address":"ZZ:ZZ","protocol":"ble"}}`+"\n")
+	require.NoError(t, err)
+	oldReplyWriter.Close()
+	for range oldReplies {
+	}
+
+	// The events of the relaunched discovery are not affected
+	scanner.set(&Port{Address: "AA:BB", Protocol: "ble"}, &Port{Address: "CC:DD", Protocol: "ble"})
+	event := nextEvent(t, events)
+	require.NotNil(t, event, "events channel still open")
+	require.Equal(t, "CC:DD", event.Port.Address)
+
+	require.NoError(t, client.Stop())
+	client.Quit()
+	require.NoError(t, <-served)
+}
+
+func TestDiffPorts(t *testing.T) {
+	serial1 := &Port{Address: "/dev/ttyACM0", Protocol: "serial"}
+	serial2 := &Port{Address: "/dev/ttyACM1", Protocol: "serial"}
+	network := &Port{Address: "/dev/ttyACM0", Protocol: "network"}
+
+	require.Empty(t, diffPorts([]*Port{serial1}, []*Port{serial1}))
+	events := diffPorts([]*Port{serial1, serial2}, []*Port{serial2, network})
+	require.Len(t, events, 2)
+	require.Equal(t, "remove", events[0].Type)
+	require.Equal(t, serial1, events[0].Port)
+	require.Equal(t, "add", events[1].Type)
+	require.Equal(t, network, events[1].Port)
+}
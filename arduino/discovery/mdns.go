@@ -0,0 +1,109 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	properties "github.com/arduino/go-properties-orderedmap"
+	"github.com/oleksandr/bonjour"
+)
+
+// mdnsBrowseTime is how long the answers to an mDNS query are collected.
+const mdnsBrowseTime = 4 * time.Second
+
+// NewMDNSDiscovery returns the builtin discovery of the boards announcing
+// the "_arduino._tcp" service via mDNS. The ports have the "network"
+// protocol and the IP address of the board as address; the properties
+// are the service TXT records (for example "board=yun") plus "name",
+// "hostname" and "port".
+func NewMDNSDiscovery() Discovery {
+	return newPollingDiscovery("mdns", time.Second, scanMDNS)
+}
+
+func scanMDNS() ([]*Port, error) {
+	entries, err := browseMDNS("_arduino._tcp", mdnsBrowseTime)
+	if err != nil {
+		return nil, err
+	}
+	ports := []*Port{}
+	for _, entry := range entries {
+		if entry.AddrIPv4 == nil {
+			continue
+		}
+		props := properties.NewMap()
+		for _, record := range entry.Text {
+			if split := strings.SplitN(record, "=", 2); len(split) == 2 {
+				props.Set(split[0], split[1])
+			}
+		}
+		props.Set("name", entry.Instance)
+		props.Set("hostname", entry.HostName)
+		props.Set("port", strconv.Itoa(entry.Port))
+
+		address := entry.AddrIPv4.String()
+		ports = append(ports, &Port{
+			Address:       address,
+			AddressLabel:  fmt.Sprintf("%s (%s)", address, entry.Instance),
+			Protocol:      "network",
+			ProtocolLabel: "Network Port",
+			Properties:    props,
+		})
+	}
+	return ports, nil
+}
+
+// browseMDNS collects the announcements of the given service for the
+// given time.
+func browseMDNS(service string, browseTime time.Duration) ([]*bonjour.ServiceEntry, error) {
+	resolver, err := bonjour.NewResolver(nil)
+	if err != nil {
+		return nil, fmt.Errorf("initializing mDNS resolver: %s", err)
+	}
+	results := make(chan *bonjour.ServiceEntry)
+	if err := resolver.Browse(service, "", results); err != nil {
+		return nil, fmt.Errorf("browsing mDNS services: %s", err)
+	}
+
+	entries := []*bonjour.ServiceEntry{}
+	timeout := time.After(browseTime)
+	for {
+		select {
+		case entry := <-results:
+			entries = append(entries, entry)
+		case <-timeout:
+			// The resolver may be blocked sending a result: keep draining
+			// the results until it acknowledges the exit request.
+			exited := make(chan struct{})
+			go func() {
+				resolver.Exit <- true
+				close(exited)
+			}()
+			for {
+				select {
+				case <-results:
+				case <-exited:
+					return entries, nil
+				}
+			}
+		}
+	}
+}
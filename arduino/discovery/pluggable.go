@@ -0,0 +1,332 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// replyTimeout is how long a PluggableDiscovery waits for the reply to a
+// command.
+const replyTimeout = 10 * time.Second
+
+// message is a message of the pluggable discovery protocol sent by the
+// discovery tool: either the reply to a command or an event.
+type message struct {
+	EventType string  `json:"eventType"`
+	Message   string  `json:"message,omitempty"`
+	Error     bool    `json:"error,omitempty"`
+	Ports     []*Port `json:"ports,omitempty"`
+	Port      *Port   `json:"port,omitempty"`
+}
+
+// PluggableDiscovery is a Discovery implemented by an external tool,
+// usually shipped with a platform, that speaks the pluggable discovery
+// protocol on its standard input and output (see README.adoc). The tool
+// is launched by the first command and terminated by Quit.
+type PluggableDiscovery struct {
+	id   string
+	args []string
+
+	// mux serializes the commands sent to the tool
+	mux     sync.Mutex
+	process *exec.Cmd
+	stderr  io.WriteCloser
+	in      io.WriteCloser
+	replies chan *message
+
+	eventsMux sync.Mutex
+	events    chan *Event
+	// eventsSource is the replies channel of the tool process feeding
+	// events, so that a terminated process can't send to, or close, the
+	// events channel of the process launched after it
+	eventsSource chan *message
+}
+
+// NewPluggableDiscovery returns a discovery running the given command
+// line.
+func NewPluggableDiscovery(id string, args []string) *PluggableDiscovery {
+	return &PluggableDiscovery{
+		id:   id,
+		args: args,
+	}
+}
+
+// ID returns the identifier of the discovery.
+func (d *PluggableDiscovery) ID() string {
+	return d.id
+}
+
+func (d *PluggableDiscovery) String() string {
+	return d.id
+}
+
+// launch runs the discovery tool.
+func (d *PluggableDiscovery) launch() error {
+	if len(d.args) == 0 {
+		return errors.New("missing command line")
+	}
+	logrus.WithField("discovery", d.id).Infof("Launching %s", strings.Join(d.args, " "))
+	process := exec.Command(d.args[0], d.args[1:]...)
+	in, err := process.StdinPipe()
+	if err != nil {
+		return err
+	}
+	out, err := process.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr := logrus.WithField("discovery", d.id).WriterLevel(logrus.DebugLevel)
+	process.Stderr = stderr
+	if err := process.Start(); err != nil {
+		stderr.Close()
+		return err
+	}
+	d.process = process
+	d.stderr = stderr
+	d.connect(in, out)
+	return nil
+}
+
+// connect starts talking the protocol with a discovery tool on the given
+// streams.
+func (d *PluggableDiscovery) connect(in io.WriteCloser, out io.Reader) {
+	d.in = in
+	d.replies = make(chan *message, 10)
+	go d.readMessages(out, d.replies)
+}
+
+// readMessages reads the messages sent by the tool: replies are
+// forwarded to the replies channel, events to the events channel.
+func (d *PluggableDiscovery) readMessages(out io.Reader, replies chan<- *message) {
+	defer close(replies)
+	scanner := bufio.NewScanner(out)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		msg := &message{}
+		if err := json.Unmarshal(scanner.Bytes(), msg); err != nil {
+			logrus.WithField("discovery", d.id).WithError(err).Warnf("Invalid message: %s", scanner.Text())
+			continue
+		}
+		if msg.EventType == "add" || msg.EventType == "remove" {
+			if msg.Port == nil {
+				logrus.WithField("discovery", d.id).Warnf("Missing port in %s event", msg.EventType)
+				continue
+			}
+			d.sendEvent(replies, &Event{Type: msg.EventType, Port: msg.Port})
+			continue
+		}
+		select {
+		case replies <- msg:
+		default:
+			logrus.WithField("discovery", d.id).Warnf("Unexpected message: %s", scanner.Text())
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		logrus.WithField("discovery", d.id).WithError(err).Warn("Error reading from discovery")
+	}
+	d.closeEventsFrom(replies)
+}
+
+// sendEvent forwards an event read from the tool process with the given
+// replies channel.
+func (d *PluggableDiscovery) sendEvent(source chan<- *message, event *Event) {
+	d.eventsMux.Lock()
+	defer d.eventsMux.Unlock()
+	if d.events == nil || d.eventsSource != source {
+		return
+	}
+	select {
+	case d.events <- event:
+	default:
+		logrus.WithField("discovery", d.id).Warnf("Event queue full, dropping %s event", event.Type)
+	}
+}
+
+// closeEventsFrom closes the events channel if it's fed by the tool process
+// with the given replies channel.
+func (d *PluggableDiscovery) closeEventsFrom(source chan<- *message) {
+	d.eventsMux.Lock()
+	defer d.eventsMux.Unlock()
+	if d.events != nil && d.eventsSource == source {
+		close(d.events)
+		d.events = nil
+		d.eventsSource = nil
+	}
+}
+
+func (d *PluggableDiscovery) closeEvents() {
+	d.eventsMux.Lock()
+	defer d.eventsMux.Unlock()
+	if d.events != nil {
+		close(d.events)
+		d.events = nil
+		d.eventsSource = nil
+	}
+}
+
+// sendCommand sends a command to the tool and waits for the reply. The
+// caller must hold d.mux.
+func (d *PluggableDiscovery) sendCommand(command string) (*message, error) {
+	if d.in == nil {
+		if err := d.launch(); err != nil {
+			return nil, fmt.Errorf("launching discovery %s: %s", d.id, err)
+		}
+	}
+	if _, err := io.WriteString(d.in, command+"\n"); err != nil {
+		d.terminate()
+		return nil, fmt.Errorf("sending %s to discovery %s: %s", command, d.id, err)
+	}
+
+	expected := strings.ToLower(command)
+	timeout := time.After(replyTimeout)
+	for {
+		select {
+		case msg, ok := <-d.replies:
+			if !ok {
+				d.terminate()
+				return nil, fmt.Errorf("discovery %s exited while waiting for reply to %s", d.id, command)
+			}
+			if msg.EventType == "command_error" {
+				return nil, fmt.Errorf("discovery %s: %s: %s", d.id, command, msg.Message)
+			}
+			if msg.EventType != expected {
+				// Late reply to a timed out command
+				logrus.WithField("discovery", d.id).Warnf("Unexpected %s message", msg.EventType)
+				continue
+			}
+			if msg.Error {
+				return nil, fmt.Errorf("discovery %s: %s: %s", d.id, command, msg.Message)
+			}
+			return msg, nil
+		case <-timeout:
+			return nil, fmt.Errorf("discovery %s: timeout waiting for reply to %s", d.id, command)
+		}
+	}
+}
+
+// terminate closes the streams and waits for the tool to exit, killing
+// it if needed. The caller must hold d.mux.
+func (d *PluggableDiscovery) terminate() {
+	if d.in == nil {
+		return
+	}
+	d.in.Close()
+	d.in = nil
+	d.closeEvents()
+	if d.process == nil {
+		return
+	}
+	exited := make(chan struct{})
+	go func(process *exec.Cmd, stderr io.Closer) {
+		process.Wait()
+		stderr.Close()
+		close(exited)
+	}(d.process, d.stderr)
+	select {
+	case <-exited:
+	case <-time.After(replyTimeout):
+		logrus.WithField("discovery", d.id).Warn("Discovery not responding, killing it")
+		d.process.Process.Kill()
+	}
+	d.process = nil
+}
+
+// Start sends the START command to the tool.
+func (d *PluggableDiscovery) Start() error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	_, err := d.sendCommand("START")
+	return err
+}
+
+// List sends the LIST command to the tool and returns the ports reported.
+func (d *PluggableDiscovery) List() ([]*Port, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	msg, err := d.sendCommand("LIST")
+	if err != nil {
+		return nil, err
+	}
+	if msg.Ports == nil {
+		return []*Port{}, nil
+	}
+	return msg.Ports, nil
+}
+
+// StartSync sends the START_SYNC command to the tool and returns the
+// channel where the events are forwarded.
+func (d *PluggableDiscovery) StartSync() (<-chan *Event, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+
+	// The events may arrive right after the reply: prepare the channel,
+	// bound to the running tool, before sending the command.
+	if d.in == nil {
+		if err := d.launch(); err != nil {
+			return nil, fmt.Errorf("launching discovery %s: %s", d.id, err)
+		}
+	}
+	d.eventsMux.Lock()
+	if d.events != nil {
+		d.eventsMux.Unlock()
+		return nil, errors.New("discovery already started in event mode")
+	}
+	events := make(chan *Event, 100)
+	d.events = events
+	d.eventsSource = d.replies
+	d.eventsMux.Unlock()
+
+	if _, err := d.sendCommand("START_SYNC"); err != nil {
+		d.closeEvents()
+		return nil, err
+	}
+	return events, nil
+}
+
+// Stop sends the STOP command to the tool.
+func (d *PluggableDiscovery) Stop() error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	_, err := d.sendCommand("STOP")
+	d.closeEvents()
+	return err
+}
+
+// Quit sends the QUIT command to the tool and waits for its termination.
+func (d *PluggableDiscovery) Quit() {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.in == nil {
+		return
+	}
+	if _, err := d.sendCommand("QUIT"); err != nil {
+		logrus.WithField("discovery", d.id).WithError(err).Warn("Error quitting discovery")
+	}
+	d.terminate()
+}
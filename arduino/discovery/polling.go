@@ -0,0 +1,187 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// pollingDiscovery is a Discovery that periodically calls a scan function
+// and computes the events by comparing the result with the previous one.
+// It's used to implement the builtin discoveries.
+type pollingDiscovery struct {
+	id       string
+	interval time.Duration
+	scan     func() ([]*Port, error)
+
+	mux sync.Mutex
+	run *pollingRun
+}
+
+// pollingRun is the state of a started pollingDiscovery. A scan may take
+// a while (the mDNS discovery waits for the answers) so Stop doesn't wait
+// for it: the run is simply abandoned and exits as soon as the scan ends.
+type pollingRun struct {
+	stop   chan struct{}
+	ports  []*Port
+	events chan *Event
+}
+
+func newPollingDiscovery(id string, interval time.Duration, scan func() ([]*Port, error)) *pollingDiscovery {
+	return &pollingDiscovery{
+		id:       id,
+		interval: interval,
+		scan:     scan,
+	}
+}
+
+// ID returns the identifier of the discovery.
+func (d *pollingDiscovery) ID() string {
+	return d.id
+}
+
+// Start starts the detection of the ports in background.
+func (d *pollingDiscovery) Start() error {
+	_, err := d.start(false)
+	return err
+}
+
+// StartSync starts the detection of the ports in event mode.
+func (d *pollingDiscovery) StartSync() (<-chan *Event, error) {
+	return d.start(true)
+}
+
+func (d *pollingDiscovery) start(sync bool) (chan *Event, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.run != nil {
+		return nil, errors.New("discovery already started")
+	}
+	run := &pollingRun{stop: make(chan struct{})}
+	if sync {
+		run.events = make(chan *Event, 10)
+	}
+	d.run = run
+	go d.loop(run)
+	return run.events, nil
+}
+
+func (d *pollingDiscovery) loop(run *pollingRun) {
+	if run.events != nil {
+		defer close(run.events)
+	}
+	lastError := ""
+	for {
+		ports, err := d.scan()
+		if err != nil {
+			// Log only once while the same error repeats
+			if err.Error() != lastError {
+				logrus.WithError(err).Warnf("Error in %s discovery", d.id)
+				lastError = err.Error()
+			}
+		} else {
+			lastError = ""
+			if !d.update(run, ports) {
+				return
+			}
+		}
+
+		select {
+		case <-run.stop:
+			return
+		case <-time.After(d.interval):
+		}
+	}
+}
+
+// update stores the ports of the last scan and sends the events to the
+// event channel. It returns false if the run has been stopped.
+func (d *pollingDiscovery) update(run *pollingRun, ports []*Port) bool {
+	d.mux.Lock()
+	events := diffPorts(run.ports, ports)
+	run.ports = ports
+	d.mux.Unlock()
+
+	if run.events == nil {
+		return true
+	}
+	for _, event := range events {
+		select {
+		case run.events <- event:
+		case <-run.stop:
+			return false
+		}
+	}
+	return true
+}
+
+// diffPorts returns the events that transform the list of ports before
+// into the list of ports after.
+func diffPorts(before, after []*Port) []*Event {
+	events := []*Event{}
+	present := map[string]bool{}
+	for _, port := range after {
+		present[portKey(port)] = true
+	}
+	for _, port := range before {
+		if !present[portKey(port)] {
+			events = append(events, &Event{Type: "remove", Port: port})
+		}
+	}
+	present = map[string]bool{}
+	for _, port := range before {
+		present[portKey(port)] = true
+	}
+	for _, port := range after {
+		if !present[portKey(port)] {
+			events = append(events, &Event{Type: "add", Port: port})
+		}
+	}
+	return events
+}
+
+// List returns the ports detected by the last scan.
+func (d *pollingDiscovery) List() ([]*Port, error) {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.run == nil {
+		return nil, errors.New("discovery not started")
+	}
+	return append([]*Port{}, d.run.ports...), nil
+}
+
+// Stop stops the detection of the ports.
+func (d *pollingDiscovery) Stop() error {
+	d.mux.Lock()
+	defer d.mux.Unlock()
+	if d.run == nil {
+		return errors.New("discovery not started")
+	}
+	close(d.run.stop)
+	d.run = nil
+	return nil
+}
+
+// Quit stops the discovery.
+func (d *pollingDiscovery) Quit() {
+	d.Stop()
+}
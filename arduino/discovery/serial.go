@@ -0,0 +1,58 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"fmt"
+	"time"
+
+	properties "github.com/arduino/go-properties-orderedmap"
+	"go.bug.st/serial.v1/enumerator"
+)
+
+// NewSerialDiscovery returns the builtin discovery of the USB serial
+// ports. The ports have the "serial" protocol and the "vid", "pid" and
+// "serialNumber" properties.
+func NewSerialDiscovery() Discovery {
+	return newPollingDiscovery("serial", time.Second, scanSerialPorts)
+}
+
+func scanSerialPorts() ([]*Port, error) {
+	list, err := enumerator.GetDetailedPortsList()
+	if err != nil {
+		return nil, fmt.Errorf("listing serial ports: %s", err)
+	}
+	ports := []*Port{}
+	for _, details := range list {
+		if !details.IsUSB {
+			continue
+		}
+		props := properties.NewMap()
+		props.Set("vid", "0x"+details.VID)
+		props.Set("pid", "0x"+details.PID)
+		props.Set("serialNumber", details.SerialNumber)
+		ports = append(ports, &Port{
+			Address:       details.Name,
+			AddressLabel:  details.Name,
+			Protocol:      "serial",
+			ProtocolLabel: "Serial Port (USB)",
+			Properties:    props,
+		})
+	}
+	return ports, nil
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package discovery
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+)
+
+// Serve talks the pluggable discovery protocol on the given streams on
+// behalf of d, until the QUIT command is received or in is closed. It may
+// be used to implement a discovery tool in Go:
+//
+//	discovery.Serve(myDiscovery, os.Stdin, os.Stdout)
+func Serve(d Discovery, in io.Reader, out io.Writer) error {
+	var outMux sync.Mutex
+	send := func(msg *message) {
+		outMux.Lock()
+		defer outMux.Unlock()
+		data, _ := json.Marshal(msg)
+		out.Write(append(data, '\n'))
+	}
+	reply := func(eventType string, err error) {
+		if err != nil {
+			send(&message{EventType: eventType, Error: true, Message: err.Error()})
+		} else {
+			send(&message{EventType: eventType, Message: "OK"})
+		}
+	}
+
+	scanner := bufio.NewScanner(in)
+	for scanner.Scan() {
+		command := strings.ToUpper(strings.TrimSpace(scanner.Text()))
+		switch command {
+		case "":
+		case "START":
+			reply("start", d.Start())
+		case "LIST":
+			ports, err := d.List()
+			if err != nil {
+				reply("list", err)
+			} else {
+				send(&message{EventType: "list", Ports: ports})
+			}
+		case "START_SYNC":
+			events, err := d.StartSync()
+			reply("start_sync", err)
+			if err == nil {
+				go func() {
+					for event := range events {
+						send(&message{EventType: event.Type, Port: event.Port})
+					}
+				}()
+			}
+		case "STOP":
+			reply("stop", d.Stop())
+		case "QUIT":
+			d.Quit()
+			reply("quit", nil)
+			return nil
+		default:
+			send(&message{EventType: "command_error", Error: true, Message: "command not supported: " + command})
+		}
+	}
+	d.Quit()
+	return scanner.Err()
+}
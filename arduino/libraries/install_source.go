@@ -0,0 +1,80 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package libraries
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// InstallSourceFileName is the file, in the folder of a library installed
+// from outside of the libraries index, recording where it comes from.
+const InstallSourceFileName = ".install_source.json"
+
+// InstallSource describes where a library installed from outside of the
+// libraries index comes from.
+type InstallSource struct {
+	Type   string `json:"type"`             // "git" or "zip"
+	URL    string `json:"url"`              // the repository URL or the path of the zip file
+	Ref    string `json:"ref,omitempty"`    // the branch, tag or commit requested
+	Commit string `json:"commit,omitempty"` // the commit installed
+}
+
+func (s *InstallSource) String() string {
+	res := s.Type + " " + s.URL
+	if s.Ref != "" {
+		res += "#" + s.Ref
+	}
+	if s.Commit != "" && s.Commit != s.Ref {
+		commit := s.Commit
+		if len(commit) > 7 {
+			commit = commit[:7]
+		}
+		res += " (" + commit + ")"
+	}
+	return res
+}
+
+// Save writes the source in the folder of the library.
+func (s *InstallSource) Save(libDir *paths.Path) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding library source: %s", err)
+	}
+	if err := libDir.Join(InstallSourceFileName).WriteFile(data); err != nil {
+		return fmt.Errorf("writing library source: %s", err)
+	}
+	return nil
+}
+
+// loadInstallSource reads the source of the library installed in libDir,
+// nil if the library has been installed from the libraries index or the
+// source can't be read.
+func loadInstallSource(libDir *paths.Path) *InstallSource {
+	data, err := libDir.Join(InstallSourceFileName).ReadFile()
+	if err != nil {
+		return nil
+	}
+	var source InstallSource
+	if err := json.Unmarshal(data, &source); err != nil {
+		return nil
+	}
+	return &source
+}
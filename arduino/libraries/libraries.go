@@ -68,6 +68,9 @@ type Library struct {
 	Version           *semver.Version
 	License           string
 	Properties        *properties.Map
+	// InstalledFrom is where the library has been installed from, nil if
+	// it has been installed from the libraries index or by hand.
+	InstalledFrom *InstallSource `json:",omitempty"`
 }
 
 func (library *Library) String() string {
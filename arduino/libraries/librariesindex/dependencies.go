@@ -0,0 +1,207 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// Dependency is a dependency of a library Release on another library
+type Dependency struct {
+	Name       string
+	Constraint Constraint // The allowed versions, nil means any version.
+}
+
+func (d *Dependency) String() string {
+	if d.Constraint == nil {
+		return d.Name
+	}
+	return d.Name + " (" + d.Constraint.String() + ")"
+}
+
+// Match returns true if the constraint of the dependency is satisfied by
+// the given version
+func (d *Dependency) Match(version *semver.Version) bool {
+	return d.Constraint == nil || d.Constraint.Match(version)
+}
+
+// Constraint is a condition on the version of a library
+type Constraint interface {
+	Match(version *semver.Version) bool
+	String() string
+}
+
+// ParseConstraint parses a version constraint. A constraint is one or more
+// conditions joined by "&&" (and) or "||" (or), "&&" takes precedence over
+// "||". A condition is a version prefixed by one of the operators =, !=, >,
+// >=, <, <= or ^ (compatible version, same major number or, for 0.x versions,
+// same minor number); a version without operator must match exactly.
+// For example: ">=1.2.0 && <2.0.0" or "^1.2.0".
+func ParseConstraint(in string) (Constraint, error) {
+	in = strings.TrimSpace(in)
+	if in == "" {
+		return nil, fmt.Errorf("empty constraint")
+	}
+	or := orConstraint{}
+	for _, orTerm := range strings.Split(in, "||") {
+		and := andConstraint{}
+		for _, andTerm := range strings.Split(orTerm, "&&") {
+			c, err := parseCondition(strings.TrimSpace(andTerm))
+			if err != nil {
+				return nil, fmt.Errorf("invalid constraint '%s': %s", in, err)
+			}
+			and = append(and, c...)
+		}
+		if len(and) == 1 {
+			or = append(or, and[0])
+		} else {
+			or = append(or, and)
+		}
+	}
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+func parseCondition(in string) ([]Constraint, error) {
+	if in == "" {
+		return nil, fmt.Errorf("empty condition")
+	}
+	op := ""
+	for _, candidate := range []string{">=", "<=", "!=", "=", ">", "<", "^"} {
+		if strings.HasPrefix(in, candidate) {
+			op = candidate
+			break
+		}
+	}
+	versionString := strings.TrimSpace(in[len(op):])
+	if versionString == "" {
+		return nil, fmt.Errorf("missing version")
+	}
+	version, err := semver.Parse(versionString)
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "":
+		return []Constraint{&versionCondition{op: "=", version: version}}, nil
+	case "^":
+		upper, err := nextCompatibleVersion(version)
+		if err != nil {
+			return nil, err
+		}
+		return []Constraint{
+			&versionCondition{op: ">=", version: version},
+			&versionCondition{op: "<", version: upper},
+		}, nil
+	default:
+		return []Constraint{&versionCondition{op: op, version: version}}, nil
+	}
+}
+
+// nextCompatibleVersion returns the first version that is not compatible
+// with the given one: the next major version or, for 0.x versions, the next
+// minor version.
+func nextCompatibleVersion(version *semver.Version) (*semver.Version, error) {
+	numbers := strings.SplitN(strings.SplitN(version.String(), "-", 2)[0], ".", 3)
+	major, err := strconv.Atoi(numbers[0])
+	if err != nil {
+		return nil, err
+	}
+	if major > 0 || len(numbers) < 2 {
+		return semver.Parse(strconv.Itoa(major+1) + ".0.0")
+	}
+	minor, err := strconv.Atoi(numbers[1])
+	if err != nil {
+		return nil, err
+	}
+	return semver.Parse("0." + strconv.Itoa(minor+1) + ".0")
+}
+
+type versionCondition struct {
+	op      string
+	version *semver.Version
+}
+
+func (c *versionCondition) Match(version *semver.Version) bool {
+	if version == nil {
+		return false
+	}
+	switch c.op {
+	case "=":
+		return version.Equal(c.version)
+	case "!=":
+		return !version.Equal(c.version)
+	case ">":
+		return version.GreaterThan(c.version)
+	case ">=":
+		return version.GreaterThanOrEqual(c.version)
+	case "<":
+		return version.LessThan(c.version)
+	case "<=":
+		return version.LessThanOrEqual(c.version)
+	}
+	return false
+}
+
+func (c *versionCondition) String() string {
+	return c.op + c.version.String()
+}
+
+type andConstraint []Constraint
+
+func (c andConstraint) Match(version *semver.Version) bool {
+	for _, term := range c {
+		if !term.Match(version) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c andConstraint) String() string {
+	terms := []string{}
+	for _, term := range c {
+		terms = append(terms, term.String())
+	}
+	return strings.Join(terms, " && ")
+}
+
+type orConstraint []Constraint
+
+func (c orConstraint) Match(version *semver.Version) bool {
+	for _, term := range c {
+		if term.Match(version) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c orConstraint) String() string {
+	terms := []string{}
+	for _, term := range c {
+		terms = append(terms, term.String())
+	}
+	return strings.Join(terms, " || ")
+}
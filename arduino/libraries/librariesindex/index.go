@@ -50,6 +50,10 @@ type Release struct {
 	Architectures []string
 	Types         []string
 	Resource      *resources.DownloadResource
+	Dependencies  []*Dependency
+	// Includes are the headers provided by the library, empty if the
+	// index doesn't list them.
+	Includes []string
 
 	Library *Library `json:"-"`
 }
@@ -58,6 +62,32 @@ func (r *Release) String() string {
 	return r.Library.Name + "@" + r.Version.String()
 }
 
+// SupportsAnyArchitectureIn returns true if the release supports at least
+// one of the given architectures, is architecture independent or doesn't
+// specify its architectures, see libraries.Library.
+func (r *Release) SupportsAnyArchitectureIn(archs ...string) bool {
+	if len(r.Architectures) == 0 || r.IsOptimizedForArchitecture("*") {
+		return true
+	}
+	for _, arch := range archs {
+		if arch == "*" || r.IsOptimizedForArchitecture(arch) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOptimizedForArchitecture returns true if the release explicitly
+// declares to be compatible with the architecture.
+func (r *Release) IsOptimizedForArchitecture(arch string) bool {
+	for _, releaseArch := range r.Architectures {
+		if releaseArch == arch {
+			return true
+		}
+	}
+	return false
+}
+
 // FindRelease search a library Release in the index. Returns nil if the
 // release is not found
 func (idx *Index) FindRelease(ref *Reference) *Release {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 
 	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
 	semver "go.bug.st/relaxed-semver"
 
 	"github.com/arduino/arduino-cli/arduino/resources"
@@ -32,20 +33,27 @@ type indexJSON struct {
 }
 
 type indexRelease struct {
-	Name            string          `json:"name,required"`
-	Version         *semver.Version `json:"version,required"`
-	Author          string          `json:"author"`
-	Maintainer      string          `json:"maintainer"`
-	Sentence        string          `json:"sentence"`
-	Paragraph       string          `json:"paragraph"`
-	Website         string          `json:"website"`
-	Category        string          `json:"category"`
-	Architectures   []string        `json:"architectures"`
-	Types           []string        `json:"types"`
-	URL             string          `json:"url"`
-	ArchiveFileName string          `json:"archiveFileName"`
-	Size            int64           `json:"size"`
-	Checksum        string          `json:"checksum"`
+	Name            string            `json:"name,required"`
+	Version         *semver.Version   `json:"version,required"`
+	Author          string            `json:"author"`
+	Maintainer      string            `json:"maintainer"`
+	Sentence        string            `json:"sentence"`
+	Paragraph       string            `json:"paragraph"`
+	Website         string            `json:"website"`
+	Category        string            `json:"category"`
+	Architectures   []string          `json:"architectures"`
+	Types           []string          `json:"types"`
+	URL             string            `json:"url"`
+	ArchiveFileName string            `json:"archiveFileName"`
+	Size            int64             `json:"size"`
+	Checksum        string            `json:"checksum"`
+	Dependencies    []indexDependency `json:"dependencies"`
+	Includes        []string          `json:"providesIncludes,omitempty"`
+}
+
+type indexDependency struct {
+	Name    string `json:"name,required"`
+	Version string `json:"version"`
 }
 
 // LoadIndex reads a library_index.json and create the corresponding Index
@@ -64,6 +72,51 @@ func LoadIndex(indexFile *paths.Path) (*Index, error) {
 	return i.extractIndex()
 }
 
+// SaveIndex writes a library_index.json containing the given releases. The
+// URL of each archive is replaced with the one returned by archiveURL.
+func SaveIndex(indexFile *paths.Path, releases []*Release, archiveURL func(*resources.DownloadResource) string) error {
+	i := indexJSON{Libraries: []indexRelease{}}
+	for _, release := range releases {
+		i.Libraries = append(i.Libraries, newIndexRelease(release, archiveURL(release.Resource)))
+	}
+	data, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding library_index.json: %s", err)
+	}
+	if err := indexFile.WriteFile(data); err != nil {
+		return fmt.Errorf("writing library_index.json: %s", err)
+	}
+	return nil
+}
+
+func newIndexRelease(release *Release, URL string) indexRelease {
+	res := indexRelease{
+		Name:            release.Library.Name,
+		Version:         release.Version,
+		Author:          release.Author,
+		Maintainer:      release.Maintainer,
+		Sentence:        release.Sentence,
+		Paragraph:       release.Paragraph,
+		Website:         release.Website,
+		Category:        release.Category,
+		Architectures:   release.Architectures,
+		Types:           release.Types,
+		URL:             URL,
+		ArchiveFileName: release.Resource.ArchiveFileName,
+		Size:            release.Resource.Size,
+		Checksum:        release.Resource.Checksum,
+		Includes:        release.Includes,
+	}
+	for _, dep := range release.Dependencies {
+		indexDep := indexDependency{Name: dep.Name}
+		if dep.Constraint != nil {
+			indexDep.Version = dep.Constraint.String()
+		}
+		res.Dependencies = append(res.Dependencies, indexDep)
+	}
+	return res
+}
+
 func (i indexJSON) extractIndex() (*Index, error) {
 	index := &Index{
 		Libraries: map[string]*Library{},
@@ -104,10 +157,30 @@ func (indexLib *indexRelease) extractReleaseIn(library *Library) {
 			Checksum:        indexLib.Checksum,
 			CachePath:       "libraries",
 		},
-		Library: library,
+		Dependencies: indexLib.extractDependencies(),
+		Includes:     indexLib.Includes,
+		Library:      library,
 	}
 	library.Releases[indexLib.Version.String()] = release
 	if library.Latest == nil || library.Latest.Version.LessThan(release.Version) {
 		library.Latest = release
 	}
 }
+
+func (indexLib *indexRelease) extractDependencies() []*Dependency {
+	res := []*Dependency{}
+	for _, indexDep := range indexLib.Dependencies {
+		dep := &Dependency{Name: indexDep.Name}
+		if indexDep.Version != "" {
+			// an invalid constraint is ignored and any version is accepted
+			if constraint, err := ParseConstraint(indexDep.Version); err == nil {
+				dep.Constraint = constraint
+			} else {
+				logrus.WithError(err).Warnf("Library %s@%s: ignoring invalid version constraint %q of dependency %s",
+					indexLib.Name, indexLib.Version, indexDep.Version, indexDep.Name)
+			}
+		}
+		res = append(res, dep)
+	}
+	return res
+}
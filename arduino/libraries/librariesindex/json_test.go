@@ -0,0 +1,82 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/resources"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestSaveIndex(t *testing.T) {
+	idx := testIndex(t, map[string]map[string]string{
+		"WiFi@1.0.0":       {"HttpClient": ">=1.0.0 && <2.0.0"},
+		"WiFi@2.0.0":       {"HttpClient": ""},
+		"HttpClient@1.5.0": {},
+	})
+	for _, lib := range idx.Libraries {
+		for _, release := range lib.Releases {
+			release.Resource.ArchiveFileName = release.String() + ".zip"
+			release.Includes = []string{lib.Name + ".h"}
+		}
+	}
+	releases := []*Release{idx.Libraries["WiFi"].Releases["1.0.0"], idx.Libraries["HttpClient"].Releases["1.5.0"]}
+
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	indexFile := tmp.Join("library_index.json")
+	err = SaveIndex(indexFile, releases, func(r *resources.DownloadResource) string {
+		return "file:///mirror/libraries/" + r.ArchiveFileName
+	})
+	require.NoError(t, err)
+
+	saved, err := LoadIndex(indexFile)
+	require.NoError(t, err)
+	require.Len(t, saved.Libraries, 2)
+	require.Len(t, saved.Libraries["WiFi"].Releases, 1)
+	wifi := saved.Libraries["WiFi"].Releases["1.0.0"]
+	require.NotNil(t, wifi)
+	require.Equal(t, "file:///mirror/libraries/WiFi@1.0.0.zip", wifi.Resource.URL)
+	require.Len(t, wifi.Dependencies, 1)
+	require.Equal(t, "HttpClient (>=1.0.0 && <2.0.0)", wifi.Dependencies[0].String())
+	require.Equal(t, []string{"WiFi.h"}, wifi.Includes)
+	require.NotNil(t, saved.Libraries["HttpClient"].Releases["1.5.0"])
+}
+
+func TestExtractInvalidDependency(t *testing.T) {
+	out := &bytes.Buffer{}
+	logrus.SetOutput(out)
+	defer logrus.SetOutput(os.Stderr)
+
+	indexLib := &indexRelease{
+		Name:         "WiFi",
+		Version:      semver.MustParse("1.0.0"),
+		Dependencies: []indexDependency{{Name: "HttpClient", Version: "=>1.0"}},
+	}
+	deps := indexLib.extractDependencies()
+	require.Len(t, deps, 1)
+	require.Equal(t, "HttpClient", deps[0].String(), "any version is accepted")
+	require.Contains(t, out.String(), `Library WiFi@1.0.0: ignoring invalid version constraint \"=>1.0\" of dependency HttpClient`)
+}
@@ -0,0 +1,270 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	semver "go.bug.st/relaxed-semver"
+)
+
+// ResolveDependencies computes a consistent set of releases containing the
+// referenced libraries and all their dependencies, recursively. The versions
+// specified in the references are honored, otherwise the latest release
+// satisfying the constraints is selected.
+//
+// installed contains the versions of the libraries already installed: if an
+// installed version satisfies the constraints of a dependency it's preferred
+// over the latest one. A dependency not available in the index is satisfied
+// only by an installed version and it's not included in the result.
+//
+// The returned releases are sorted so that every release comes after its
+// dependencies. An error is returned if there are conflicting constraints or
+// a dependency cycle.
+func (idx *Index) ResolveDependencies(refs []*Reference, installed map[string]*semver.Version) ([]*Release, error) {
+	r := &dependencyResolver{
+		idx:       idx,
+		installed: installed,
+		requested: map[string]bool{},
+		selected:  map[string]*Release{},
+		required:  map[string][]*requirement{},
+	}
+	pending := []string{}
+	for _, ref := range refs {
+		if _, exists := idx.Libraries[ref.Name]; !exists {
+			return nil, fmt.Errorf("library %s not found", ref.Name)
+		}
+		req := &requirement{}
+		if ref.Version != nil {
+			req.dependency = &Dependency{Name: ref.Name, Constraint: &versionCondition{op: "=", version: ref.Version}}
+		} else {
+			req.dependency = &Dependency{Name: ref.Name}
+		}
+		r.requested[ref.Name] = true
+		r.required[ref.Name] = append(r.required[ref.Name], req)
+		pending = append(pending, ref.Name)
+	}
+	if err := r.resolve(pending); err != nil {
+		return nil, err
+	}
+	return r.sortedReleases()
+}
+
+// requirement is a constraint on the version of a library, by is the
+// release that requires it or nil if it's requested by the user.
+type requirement struct {
+	dependency *Dependency
+	by         *Release
+}
+
+func (req *requirement) String() string {
+	res := "any version"
+	if req.dependency.Constraint != nil {
+		res = req.dependency.Constraint.String()
+	}
+	if req.by == nil {
+		return res + " (requested)"
+	}
+	return res + " (required by " + req.by.String() + ")"
+}
+
+type dependencyResolver struct {
+	idx       *Index
+	installed map[string]*semver.Version
+	requested map[string]bool
+	// selected maps the name of a library to the selected release, the
+	// release is nil if the library is not in the index and the installed
+	// version is used.
+	selected map[string]*Release
+	required map[string][]*requirement
+}
+
+// resolve selects a release for each of the pending libraries and their
+// dependencies, backtracking on the previous choices when a conflict is
+// found.
+func (r *dependencyResolver) resolve(pending []string) error {
+	if len(pending) == 0 {
+		return nil
+	}
+	name := pending[0]
+	if _, selected := r.selected[name]; selected {
+		return r.resolve(pending[1:])
+	}
+
+	candidates, err := r.candidates(name)
+	if err != nil {
+		return err
+	}
+	var firstErr error
+	for _, candidate := range candidates {
+		r.selected[name] = candidate
+		err := r.requireDependenciesOf(candidate)
+		if err == nil {
+			next := append([]string{}, pending[1:]...)
+			if candidate != nil {
+				for _, dep := range candidate.Dependencies {
+					next = append(next, dep.Name)
+				}
+			}
+			if err = r.resolve(next); err == nil {
+				return nil
+			}
+			r.unrequireDependenciesOf(candidate)
+		}
+		delete(r.selected, name)
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// candidates returns the releases of the library that satisfy all the
+// current requirements, in order of preference.
+func (r *dependencyResolver) candidates(name string) ([]*Release, error) {
+	matchAll := func(version *semver.Version) bool {
+		for _, req := range r.required[name] {
+			if !req.dependency.Match(version) {
+				return false
+			}
+		}
+		return true
+	}
+
+	installed := r.installed[name]
+	library, exists := r.idx.Libraries[name]
+	if !exists {
+		if installed != nil && matchAll(installed) {
+			return []*Release{nil}, nil
+		}
+		return nil, fmt.Errorf("library %s not found, %s", name, r.describeRequirements(name))
+	}
+
+	res := []*Release{}
+	for _, release := range library.Releases {
+		if matchAll(release.Version) {
+			res = append(res, release)
+		}
+	}
+	if len(res) == 0 {
+		return nil, fmt.Errorf("no release of %s satisfies %s", name, r.describeRequirements(name))
+	}
+	preferInstalled := installed != nil && !r.requested[name]
+	sort.Slice(res, func(i, j int) bool {
+		if preferInstalled {
+			if res[i].Version.Equal(installed) {
+				return true
+			}
+			if res[j].Version.Equal(installed) {
+				return false
+			}
+		}
+		return res[i].Version.GreaterThan(res[j].Version)
+	})
+	return res, nil
+}
+
+func (r *dependencyResolver) describeRequirements(name string) string {
+	reqs := []string{}
+	for _, req := range r.required[name] {
+		reqs = append(reqs, req.String())
+	}
+	return strings.Join(reqs, ", ")
+}
+
+// requireDependenciesOf adds the requirements of the dependencies of the
+// release, it fails if an already selected release doesn't satisfy them. In
+// case of failure the requirements are not added.
+func (r *dependencyResolver) requireDependenciesOf(release *Release) error {
+	if release == nil {
+		return nil
+	}
+	for i, dep := range release.Dependencies {
+		r.required[dep.Name] = append(r.required[dep.Name], &requirement{dependency: dep, by: release})
+		selected, isSelected := r.selected[dep.Name]
+		if !isSelected {
+			continue
+		}
+		version := r.installed[dep.Name]
+		if selected != nil {
+			version = selected.Version
+		}
+		if !dep.Match(version) {
+			err := fmt.Errorf("%s@%s doesn't satisfy %s", dep.Name, version, r.describeRequirements(dep.Name))
+			r.unrequireDependencies(release.Dependencies[:i+1])
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *dependencyResolver) unrequireDependenciesOf(release *Release) {
+	if release != nil {
+		r.unrequireDependencies(release.Dependencies)
+	}
+}
+
+func (r *dependencyResolver) unrequireDependencies(deps []*Dependency) {
+	for _, dep := range deps {
+		reqs := r.required[dep.Name]
+		r.required[dep.Name] = reqs[:len(reqs)-1]
+	}
+}
+
+// sortedReleases returns the selected releases sorted so that every release
+// comes after its dependencies.
+func (r *dependencyResolver) sortedReleases() ([]*Release, error) {
+	names := []string{}
+	for name := range r.selected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := []*Release{}
+	done := map[string]bool{}
+	visiting := map[string]bool{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		release := r.selected[name]
+		if release == nil || done[name] {
+			return nil
+		}
+		path = append(path, release.String())
+		if visiting[name] {
+			return fmt.Errorf("dependency cycle: %s", strings.Join(path, " -> "))
+		}
+		visiting[name] = true
+		for _, dep := range release.Dependencies {
+			if err := visit(dep.Name, path); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		done[name] = true
+		res = append(res, release)
+		return nil
+	}
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
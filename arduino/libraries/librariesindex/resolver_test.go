@@ -0,0 +1,143 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestParseConstraint(t *testing.T) {
+	match := func(constraint, version string) bool {
+		c, err := ParseConstraint(constraint)
+		require.NoError(t, err)
+		return c.Match(semver.MustParse(version))
+	}
+	require.True(t, match("1.2.0", "1.2.0"))
+	require.False(t, match("=1.2.0", "1.2.1"))
+	require.True(t, match("!=1.2.0", "1.2.1"))
+	require.True(t, match(">=1.2.0", "1.2.0"))
+	require.False(t, match(">1.2.0", "1.2.0"))
+	require.True(t, match("<=1.2.0", "1.2.0"))
+	require.False(t, match("<1.2.0", "1.2.0"))
+	require.True(t, match(">=1.0.0 && <2.0.0", "1.9.0"))
+	require.False(t, match(">=1.0.0 && <2.0.0", "2.0.0"))
+	require.True(t, match("<1.0.0 || >=2.0.0", "2.1.0"))
+	require.False(t, match("<1.0.0 || >=2.0.0", "1.1.0"))
+	require.True(t, match("^1.2.0", "1.9.9"))
+	require.False(t, match("^1.2.0", "2.0.0"))
+	require.True(t, match("^0.2.0", "0.2.5"))
+	require.False(t, match("^0.2.0", "0.3.0"))
+
+	c, err := ParseConstraint(">=1.0.0&&<2.0.0 || 3.0.0")
+	require.NoError(t, err)
+	require.Equal(t, ">=1.0.0 && <2.0.0 || =3.0.0", c.String())
+
+	_, err = ParseConstraint(">=")
+	require.Error(t, err)
+	_, err = ParseConstraint(">=1.0.0 &&")
+	require.Error(t, err)
+}
+
+// testIndex builds an Index from a list of "name@version" and the
+// dependencies of each release.
+func testIndex(t *testing.T, releases map[string]map[string]string) *Index {
+	idx := &Index{Libraries: map[string]*Library{}}
+	for nameVersion, deps := range releases {
+		refs, err := ParseArgs([]string{nameVersion})
+		require.NoError(t, err)
+		ref := refs[0]
+		indexLib := &indexRelease{Name: ref.Name, Version: ref.Version}
+		for depName, depConstraint := range deps {
+			indexLib.Dependencies = append(indexLib.Dependencies, indexDependency{Name: depName, Version: depConstraint})
+		}
+		indexLib.extractLibraryIn(idx)
+	}
+	return idx
+}
+
+func resolvedNames(releases []*Release) []string {
+	res := []string{}
+	for _, release := range releases {
+		res = append(res, release.String())
+	}
+	return res
+}
+
+func TestResolveDependencies(t *testing.T) {
+	idx := testIndex(t, map[string]map[string]string{
+		"WiFi@1.0.0":       {"HttpClient": ">=1.0.0", "Crypto": ""},
+		"WiFi@2.0.0":       {"HttpClient": ">=2.0.0", "Crypto": ""},
+		"HttpClient@1.0.0": {"Crypto": "<2.0.0"},
+		"HttpClient@1.5.0": {"Crypto": "<2.0.0"},
+		"HttpClient@2.0.0": {"Crypto": ">=2.0.0"},
+		"Crypto@1.0.0":     {},
+		"Crypto@1.1.0":     {},
+		"Crypto@2.0.0":     {},
+		"Sensor@1.0.0":     {"Crypto": "=1.0.0"},
+		"Display@1.0.0":    {"Missing": ""},
+		"Cycle@1.0.0":      {"Loop": ""},
+		"Loop@1.0.0":       {"Cycle": ""},
+	})
+	ref := func(arg string) *Reference {
+		refs, err := ParseArgs([]string{arg})
+		require.NoError(t, err)
+		return refs[0]
+	}
+
+	// latest releases, dependencies first
+	res, err := idx.ResolveDependencies([]*Reference{ref("WiFi")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Crypto@2.0.0", "HttpClient@2.0.0", "WiFi@2.0.0"}, resolvedNames(res))
+
+	// backtracking on HttpClient to satisfy the constraint of Sensor on Crypto
+	res, err = idx.ResolveDependencies([]*Reference{ref("Sensor"), ref("WiFi")}, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Crypto@1.0.0", "HttpClient@1.5.0", "Sensor@1.0.0", "WiFi@1.0.0"}, resolvedNames(res))
+
+	// installed versions are preferred for dependencies
+	installed := map[string]*semver.Version{"Crypto": semver.MustParse("1.0.0")}
+	res, err = idx.ResolveDependencies([]*Reference{ref("HttpClient@1.5.0")}, installed)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Crypto@1.0.0", "HttpClient@1.5.0"}, resolvedNames(res))
+	res, err = idx.ResolveDependencies([]*Reference{ref("HttpClient")}, installed)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Crypto@2.0.0", "HttpClient@2.0.0"}, resolvedNames(res))
+
+	// conflicts are reported
+	_, err = idx.ResolveDependencies([]*Reference{ref("Sensor"), ref("WiFi@2.0.0")}, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Crypto")
+
+	_, err = idx.ResolveDependencies([]*Reference{ref("WiFi@3.0.0")}, nil)
+	require.EqualError(t, err, "no release of WiFi satisfies =3.0.0 (requested)")
+
+	// missing dependencies are satisfied only by installed libraries
+	_, err = idx.ResolveDependencies([]*Reference{ref("Display")}, nil)
+	require.EqualError(t, err, "library Missing not found, any version (required by Display@1.0.0)")
+	installed = map[string]*semver.Version{"Missing": semver.MustParse("1.0.0")}
+	res, err = idx.ResolveDependencies([]*Reference{ref("Display")}, installed)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Display@1.0.0"}, resolvedNames(res))
+
+	// cycles are detected
+	_, err = idx.ResolveDependencies([]*Reference{ref("Cycle")}, nil)
+	require.EqualError(t, err, "dependency cycle: Cycle@1.0.0 -> Loop@1.0.0 -> Cycle@1.0.0")
+}
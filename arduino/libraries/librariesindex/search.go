@@ -0,0 +1,160 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SearchResult is a library found by Index.Search. Score is higher for the
+// more relevant libraries.
+type SearchResult struct {
+	Library *Library
+	Score   int
+}
+
+// searchField is a field of a release matched by Index.Search, a match in
+// a field with an higher weight makes a library more relevant.
+type searchField struct {
+	weight int
+	value  func(*Release) string
+}
+
+var searchFields = []searchField{
+	{16, func(r *Release) string { return r.Library.Name }},
+	{4, func(r *Release) string { return r.Sentence }},
+	{2, func(r *Release) string { return r.Author }},
+	{2, func(r *Release) string { return r.Maintainer }},
+	{2, func(r *Release) string { return r.Category }},
+	{1, func(r *Release) string { return r.Paragraph }},
+	{1, func(r *Release) string { return strings.Join(r.Types, " ") }},
+	{1, func(r *Release) string { return strings.Join(r.Architectures, " ") }},
+}
+
+// Search returns the libraries whose latest release matches all the words
+// of the query in any of its fields, sorted from the most relevant. A word
+// matches a word of a field that is equal, starts with it, contains it or
+// differs by a typo, in decreasing order of relevance. An empty query
+// matches all the libraries.
+func (idx *Index) Search(query string) []*SearchResult {
+	terms := searchTokens(query)
+	wholeQuery := strings.ToLower(strings.TrimSpace(query))
+	res := []*SearchResult{}
+	for _, lib := range idx.Libraries {
+		if lib.Latest == nil {
+			continue
+		}
+		score, ok := searchScore(lib.Latest, terms)
+		if !ok {
+			continue
+		}
+		if wholeQuery != "" && strings.ToLower(lib.Name) == wholeQuery {
+			score += 1000
+		}
+		res = append(res, &SearchResult{Library: lib, Score: score})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Score != res[j].Score {
+			return res[i].Score > res[j].Score
+		}
+		return res[i].Library.Name < res[j].Library.Name
+	})
+	return res
+}
+
+// searchScore returns the relevance of the release for the search terms,
+// ok is false if some term doesn't match.
+func searchScore(release *Release, terms []string) (score int, ok bool) {
+	fields := make([][]string, len(searchFields))
+	for i, field := range searchFields {
+		fields[i] = searchTokens(field.value(release))
+	}
+	for _, term := range terms {
+		best := 0
+		for i, field := range searchFields {
+			for _, word := range fields[i] {
+				if s := field.weight * matchQuality(term, word); s > best {
+					best = s
+				}
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		score += best
+	}
+	return score, true
+}
+
+// matchQuality returns how well a search term matches a word, 0 if it
+// doesn't match at all.
+func matchQuality(term, word string) int {
+	switch {
+	case word == term:
+		return 4
+	case strings.HasPrefix(word, term):
+		return 3
+	case strings.Contains(word, term):
+		return 2
+	case len(term) >= 4 && editDistance(term, word) <= len(term)/4:
+		return 1
+	}
+	return 0
+}
+
+// searchTokens splits a text in lowercase words made of letters and
+// digits.
+func searchTokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
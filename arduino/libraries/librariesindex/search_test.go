@@ -0,0 +1,55 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesindex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestSearch(t *testing.T) {
+	idx := &Index{Libraries: map[string]*Library{}}
+	for _, indexLib := range []*indexRelease{
+		{Name: "AudioZero", Sentence: "Play audio files on the DAC", Category: "Signal Input/Output", Architectures: []string{"samd"}},
+		{Name: "ArduinoSound", Sentence: "Play and analyze audio data", Paragraph: "Works with AudioZero boards", Category: "Signal Input/Output"},
+		{Name: "Audio", Sentence: "Play sounds from a SD card", Author: "Arduino"},
+		{Name: "WiFi101", Sentence: "Network driver for WINC1500", Category: "Communication"},
+		{Name: "WiFi101OTA", Sentence: "Update sketches over WiFi", Category: "Other"},
+	} {
+		indexLib.Version = semver.MustParse("1.0.0")
+		indexLib.extractLibraryIn(idx)
+	}
+	names := func(results []*SearchResult) []string {
+		res := []string{}
+		for _, r := range results {
+			res = append(res, r.Library.Name)
+		}
+		return res
+	}
+
+	require.Equal(t, []string{"Audio", "AudioZero", "ArduinoSound"}, names(idx.Search("audio")))
+	require.Equal(t, []string{"AudioZero", "ArduinoSound"}, names(idx.Search("audiozer")))
+	require.Equal(t, []string{"AudioZero"}, names(idx.Search("audio dac")), "all the words must match")
+	require.Equal(t, []string{"WiFi101", "WiFi101OTA"}, names(idx.Search("wifi101")))
+	require.Equal(t, []string{"WiFi101OTA"}, names(idx.Search("Update WiFi")))
+	require.Equal(t, []string{"ArduinoSound"}, names(idx.Search("analize")), "typos are tolerated")
+	require.Empty(t, idx.Search("audiozerooooo"))
+	require.Len(t, idx.Search(""), 5)
+}
@@ -18,17 +18,11 @@
 package librariesmanager
 
 import (
-	"net/url"
-
-	"go.bug.st/downloader"
+	"github.com/arduino/arduino-cli/arduino/resources"
 )
 
-// LibraryIndexURL is the URL where to get library index.
-var LibraryIndexURL, _ = url.Parse("https://downloads.arduino.cc/libraries/library_index.json")
-
-// UpdateIndex downloads the libraries index file from Arduino repository.
-func (lm *LibrariesManager) UpdateIndex() (*downloader.Downloader, error) {
-	lm.IndexFile.Parent().MkdirAll()
-	// TODO: Download from gzipped URL index
-	return downloader.Download(lm.IndexFile.String(), LibraryIndexURL.String(), downloader.NoResume)
+// NewDownloadScheduler returns a DownloadScheduler saving the archives in
+// the download directory of the LibrariesManager.
+func (lm *LibrariesManager) NewDownloadScheduler() *resources.DownloadScheduler {
+	return resources.NewDownloadScheduler(lm.DownloadsDir, lm.MaxParallelDownloads)
 }
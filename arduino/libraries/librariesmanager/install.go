@@ -18,42 +18,277 @@
 package librariesmanager
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/arduino/utils"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/arduino/go-paths-helper"
+	"github.com/codeclysm/extract"
+	semver "go.bug.st/relaxed-semver"
 )
 
 // Install installs a library and returns the installed path.
 func (lm *LibrariesManager) Install(indexLibrary *librariesindex.Release) (*paths.Path, error) {
+	libPath, err := lm.installPath(indexLibrary.Library.Name, indexLibrary.Version, indexLibrary.String(), false)
+	if err != nil {
+		return libPath, err
+	}
+	return libPath, indexLibrary.Resource.Install(lm.DownloadsDir, lm.getSketchbookLibrariesDir(), libPath)
+}
+
+// installPath returns the folder of the sketchbook where the library with
+// the given name and version is going to be installed. Another version of
+// the library already installed in the same folder is replaced, it fails
+// if the same version is already installed, returning its folder, unless
+// reinstall is true, or if the folder is taken by another library. label
+// describes the library being installed in the messages.
+func (lm *LibrariesManager) installPath(name string, version *semver.Version, label string, reinstall bool) (*paths.Path, error) {
+	libsDir := lm.getSketchbookLibrariesDir()
+	if libsDir == nil {
+		return nil, fmt.Errorf("sketchbook directory not set")
+	}
+	libPath := libsDir.Join(utils.SanitizeName(name))
+
 	var replaced *libraries.Library
-	if installedLibs, have := lm.Libraries[indexLibrary.Library.Name]; have {
+	for _, key := range []string{name, libPath.Base()} {
+		installedLibs, have := lm.Libraries[key]
+		if !have {
+			continue
+		}
 		for _, installedLib := range installedLibs.Alternatives {
 			if installedLib.Location != libraries.Sketchbook {
 				continue
 			}
-			if installedLib.Version.Equal(indexLibrary.Version) {
-				return installedLib.InstallDir, fmt.Errorf("%s is already installed", indexLibrary.String())
+			// The version of a library installed by hand may be invalid
+			if !reinstall && installedLib.Version != nil && version != nil && installedLib.Version.Equal(version) {
+				return installedLib.InstallDir, fmt.Errorf("%s is already installed", label)
 			}
 			replaced = installedLib
 		}
 	}
 
+	if replaced != nil && replaced.InstallDir.EquivalentTo(libPath) {
+		formatter.Print(fmt.Sprintf("Replacing %s with %s", replaced, label))
+	} else if libPath.IsDir() {
+		return nil, fmt.Errorf("destination dir %s already exists, cannot install", libPath)
+	}
+	return libPath, nil
+}
+
+// InstallZipLib installs the library contained in a zip archive, see
+// InstallFromDir. The archive may contain the library folder or directly
+// the files of the library.
+func (lm *LibrariesManager) InstallZipLib(archivePath *paths.Path) (*libraries.Library, error) {
+	tempDir, err := lm.installTempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer tempDir.RemoveAll()
+
+	file, err := os.Open(archivePath.String())
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %s", err)
+	}
+	defer file.Close()
+	// Extract in a folder named after the archive, used as library name if
+	// the archive contains directly the files of the library.
+	extractDir := tempDir.Join(strings.TrimSuffix(archivePath.Base(), archivePath.Ext()))
+	if err := extract.Archive(context.Background(), file, extractDir.String(), nil); err != nil {
+		return nil, fmt.Errorf("extracting archive: %s", err)
+	}
+
+	abs, err := archivePath.Abs()
+	if err != nil {
+		return nil, err
+	}
+	return lm.InstallFromDir(extractDir, &libraries.InstallSource{Type: "zip", URL: abs.String()})
+}
+
+// InstallGitLib clones a git repository, checking out ref if not empty,
+// and installs the library it contains, see InstallFromDir. The git
+// command must be available in the PATH.
+func (lm *LibrariesManager) InstallGitLib(url, ref string) (*libraries.Library, error) {
+	tempDir, err := lm.installTempDir()
+	if err != nil {
+		return nil, err
+	}
+	defer tempDir.RemoveAll()
+
+	// Arguments starting with a dash would be parsed as git options
+	if strings.HasPrefix(url, "-") {
+		return nil, fmt.Errorf("invalid repository URL: %s", url)
+	}
+	if strings.HasPrefix(ref, "-") {
+		return nil, fmt.Errorf("invalid git ref: %s", ref)
+	}
+
+	repoName := strings.TrimSuffix(path.Base(strings.TrimRight(url, "/")), ".git")
+	if repoName == "" || repoName == "." || repoName == "/" {
+		return nil, fmt.Errorf("invalid repository URL: %s", url)
+	}
+	cloneDir := tempDir.Join(repoName)
+	if _, err := runGit("clone", "--quiet", "--", url, cloneDir.String()); err != nil {
+		return nil, fmt.Errorf("cloning %s: %s", url, err)
+	}
+	if ref != "" {
+		if _, err := runGit("-C", cloneDir.String(), "checkout", "--quiet", ref, "--"); err != nil {
+			return nil, fmt.Errorf("checking out %s: %s", ref, err)
+		}
+	}
+	commit, err := runGit("-C", cloneDir.String(), "rev-parse", "HEAD")
+	if err != nil {
+		return nil, fmt.Errorf("reading checked out commit: %s", err)
+	}
+	if err := cloneDir.Join(".git").RemoveAll(); err != nil {
+		return nil, fmt.Errorf("removing git metadata: %s", err)
+	}
+
+	return lm.InstallFromDir(cloneDir, &libraries.InstallSource{Type: "git", URL: url, Ref: ref, Commit: commit})
+}
+
+// runGit runs the git command and returns its trimmed output.
+func runGit(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%s: %s", err, msg)
+		}
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// installTempDir creates a temporary folder in the sketchbook libraries
+// folder, so that the library can be moved in place without copying it.
+func (lm *LibrariesManager) installTempDir() (*paths.Path, error) {
 	libsDir := lm.getSketchbookLibrariesDir()
 	if libsDir == nil {
 		return nil, fmt.Errorf("sketchbook directory not set")
 	}
+	if err := libsDir.MkdirAll(); err != nil {
+		return nil, fmt.Errorf("creating libraries dir: %s", err)
+	}
+	tempDir, err := libsDir.MkTempDir("package-")
+	if err != nil {
+		return nil, fmt.Errorf("creating temp dir: %s", err)
+	}
+	return tempDir, nil
+}
 
-	libPath := libsDir.Join(utils.SanitizeName(indexLibrary.Library.Name))
-	if replaced != nil && replaced.InstallDir.EquivalentTo(libPath) {
-		formatter.Print(fmt.Sprintf("Replacing %s with %s", replaced, indexLibrary))
-	} else if libPath.IsDir() {
-		return nil, fmt.Errorf("destination dir %s already exists, cannot install", libPath)
+// InstallFromDir installs in the sketchbook the library found in dir, or
+// in its only subfolder, moving it. The library must be valid for
+// libraries.Load, must have a valid version, if it's not a legacy library,
+// and must contain at least a header. It's installed, and
+// replaced, as Install does, using the name in library.properties or the
+// folder name for legacy libraries. Unlike Install, the same version is
+// reinstalled if the source is git or zip, since their content may change
+// without a new version. The source is saved in the folder of the library,
+// see libraries.InstallSource.
+func (lm *LibrariesManager) InstallFromDir(dir *paths.Path, source *libraries.InstallSource) (*libraries.Library, error) {
+	libDir, err := findLibraryRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+	lib, err := libraries.Load(libDir, libraries.Sketchbook)
+	if err != nil {
+		return nil, fmt.Errorf("loading library: %s", err)
+	}
+	if lib.Version == nil {
+		return nil, fmt.Errorf("invalid library: version %q in library.properties is not valid",
+			strings.TrimSpace(lib.Properties.Get("version")))
+	}
+	headers, err := lib.SourceDir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("reading library: %s", err)
+	}
+	headers.FilterSuffix(".h", ".hpp", ".hh")
+	if len(headers) == 0 {
+		return nil, fmt.Errorf("invalid library: no header files found in %s", lib.SourceDir.Base())
+	}
+
+	name := lib.RealName
+	if name == "" {
+		name = lib.Name
+	}
+	label := name
+	if lib.Version.String() != "" {
+		label += "@" + lib.Version.String()
+	}
+	reinstall := source.Type == "git" || source.Type == "zip"
+	libPath, err := lm.installPath(name, lib.Version, label, reinstall)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := source.Save(libDir); err != nil {
+		return nil, err
+	}
+	if err := lm.moveLibrary(libDir, libPath); err != nil {
+		return nil, err
+	}
+	return libraries.Load(libPath, libraries.Sketchbook)
+}
+
+// moveLibrary moves the library in dir to libPath. A library already
+// installed in libPath is moved aside first, and deleted only after the new
+// one is in place, or restored if the move fails.
+func (lm *LibrariesManager) moveLibrary(dir, libPath *paths.Path) error {
+	if !libPath.IsDir() {
+		if err := dir.Rename(libPath); err != nil {
+			return fmt.Errorf("moving library to %s: %s", libPath, err)
+		}
+		return nil
+	}
+
+	backupDir, err := lm.installTempDir()
+	if err != nil {
+		return err
+	}
+	replaced := backupDir.Join(libPath.Base())
+	if err := libPath.Rename(replaced); err != nil {
+		backupDir.RemoveAll()
+		return fmt.Errorf("moving replaced library aside: %s", err)
+	}
+	if err := dir.Rename(libPath); err != nil {
+		if rbErr := replaced.Rename(libPath); rbErr != nil {
+			return fmt.Errorf("moving library to %s: %s (restoring replaced library from %s: %s)", libPath, err, replaced, rbErr)
+		}
+		backupDir.RemoveAll()
+		return fmt.Errorf("moving library to %s: %s", libPath, err)
+	}
+	if err := backupDir.RemoveAll(); err != nil {
+		return fmt.Errorf("removing replaced library: %s", err)
+	}
+	return nil
+}
+
+// findLibraryRoot returns dir if it contains a library, or its only
+// subfolder if dir contains nothing else.
+func findLibraryRoot(dir *paths.Path) (*paths.Path, error) {
+	files, err := dir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("reading library: %s", err)
+	}
+	// Skip the metadata added by the macOS archiver
+	files.FilterOutPrefix(".", "__MACOSX")
+	if len(files) == 1 && files[0].IsDir() && !dir.Join("library.properties").Exist() {
+		return files[0], nil
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("invalid library: no files found")
 	}
-	return libPath, indexLibrary.Resource.Install(lm.DownloadsDir, libsDir, libPath)
+	return dir, nil
 }
 
 // Uninstall removes a Library
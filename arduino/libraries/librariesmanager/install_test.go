@@ -0,0 +1,57 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesmanager
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveLibraryRestoresReplaced(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "install_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	lm := NewLibraryManager(tmp, tmp.Join("staging"))
+	lm.AddLibrariesDir(tmp.Join("libraries"), libraries.Sketchbook)
+
+	libPath := tmp.Join("libraries", "MyLib")
+	require.NoError(t, libPath.MkdirAll())
+	require.NoError(t, libPath.Join("MyLib.h").WriteFile([]byte("old")))
+
+	// The new library can't be moved in place: the old one is restored
+	err = lm.moveLibrary(tmp.Join("missing"), libPath)
+	require.Error(t, err)
+	content, err := libPath.Join("MyLib.h").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "old", string(content))
+
+	newLib := tmp.Join("new")
+	require.NoError(t, newLib.MkdirAll())
+	require.NoError(t, newLib.Join("MyLib.h").WriteFile([]byte("new")))
+	require.NoError(t, lm.moveLibrary(newLib, libPath))
+	content, err = libPath.Join("MyLib.h").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, "new", string(content))
+
+	files, err := tmp.Join("libraries").ReadDir()
+	require.NoError(t, err)
+	require.Len(t, files, 1, "the replaced library is removed")
+}
@@ -39,6 +39,10 @@ type LibrariesManager struct {
 	Index        *librariesindex.Index
 	IndexFile    *paths.Path
 	DownloadsDir *paths.Path
+
+	// MaxParallelDownloads is the number of archives downloaded in
+	// parallel, resources.DefaultMaxParallelDownloads if not positive.
+	MaxParallelDownloads int
 }
 
 // LibrariesDir is a directory containing libraries
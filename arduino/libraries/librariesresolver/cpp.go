@@ -69,7 +69,7 @@ func (resolver *Cpp) ScanLibrary(lib *libraries.Library) error {
 
 // AlternativesFor returns all the libraries that provides the specified header
 func (resolver *Cpp) AlternativesFor(header string) libraries.List {
-	fmt.Printf("Alternatives for %s: %s\n", header, resolver.headers[header])
+	logrus.Infof("Alternatives for %s: %s", header, resolver.headers[header])
 	return resolver.headers[header]
 }
 
@@ -90,12 +90,19 @@ func (resolver *Cpp) ResolveFor(header, architecture string) *libraries.Library
 		logrus.
 			WithField("lib", lib.Name).
 			WithField("prio", fmt.Sprintf("%03X", libPriority)).
-			Infof(msg)
+			Info(msg)
 	}
 	return found
 }
 
 func computePriority(lib *libraries.Library, header, arch string) int {
+	priority := int(lib.PriorityForArchitecture(arch)) // between 0..255
+	return priority + nameMatchPriority(lib.Name, header)
+}
+
+// nameMatchPriority returns a bonus for a library whose name looks like
+// the header: the closer the match the higher the bonus.
+func nameMatchPriority(libName, header string) int {
 	simplify := func(name string) string {
 		name = utils.SanitizeName(name)
 		name = strings.ToLower(name)
@@ -104,19 +111,18 @@ func computePriority(lib *libraries.Library, header, arch string) int {
 
 	header = strings.TrimSuffix(header, filepath.Ext(header))
 	header = simplify(header)
-	name := simplify(lib.Name)
+	name := simplify(libName)
 
-	priority := int(lib.PriorityForArchitecture(arch)) // between 0..255
 	if name == header {
-		priority += 0x500
+		return 0x500
 	} else if name == header+"-master" {
-		priority += 0x400
+		return 0x400
 	} else if strings.HasPrefix(name, header) {
-		priority += 0x300
+		return 0x300
 	} else if strings.HasSuffix(name, header) {
-		priority += 0x200
+		return 0x200
 	} else if strings.Contains(name, header) {
-		priority += 0x100
+		return 0x100
 	}
-	return priority
+	return 0
 }
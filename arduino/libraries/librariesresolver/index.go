@@ -0,0 +1,95 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesresolver
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/utils"
+)
+
+// Index finds the libraries of the libraries index that provide a C++
+// header, to suggest which library to install when an include can't be
+// resolved.
+type Index struct {
+	headers map[string][]*librariesindex.Library
+}
+
+// NewIndexResolver creates a new Index resolver. The headers provided by a
+// library are the ones listed in the index for its latest release or, if
+// the index doesn't list them, the header named after the library.
+func NewIndexResolver(index *librariesindex.Index) *Index {
+	resolver := &Index{
+		headers: map[string][]*librariesindex.Library{},
+	}
+	for _, lib := range index.Libraries {
+		if lib.Latest == nil {
+			continue
+		}
+		headers := lib.Latest.Includes
+		if len(headers) == 0 {
+			headers = []string{utils.SanitizeName(lib.Name) + ".h"}
+		}
+		for _, header := range headers {
+			key := strings.ToLower(header)
+			libs := resolver.headers[key]
+			if len(libs) > 0 && libs[len(libs)-1] == lib {
+				continue
+			}
+			resolver.headers[key] = append(libs, lib)
+		}
+	}
+	return resolver
+}
+
+// AlternativesFor returns all the libraries that provide the specified
+// header. Headers are compared case insensitive since the header derived
+// from the name of a library may differ in case from the real one.
+func (resolver *Index) AlternativesFor(header string) []*librariesindex.Library {
+	return resolver.headers[strings.ToLower(header)]
+}
+
+// SuggestFor returns the libraries that provide the specified header and
+// support the architecture, sorted from the most suitable using the same
+// criteria of the Cpp resolver. If architecture is empty the libraries
+// are not filtered.
+func (resolver *Index) SuggestFor(header, architecture string) []*librariesindex.Library {
+	res := []*librariesindex.Library{}
+	priorities := map[*librariesindex.Library]int{}
+	for _, lib := range resolver.AlternativesFor(header) {
+		if architecture != "" && !lib.Latest.SupportsAnyArchitectureIn(architecture) {
+			continue
+		}
+		priority := nameMatchPriority(lib.Name, header)
+		if lib.Latest.IsOptimizedForArchitecture(architecture) {
+			// Same bonus given by Library.PriorityForArchitecture
+			priority += 0x10
+		}
+		priorities[lib] = priority
+		res = append(res, lib)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if priorities[res[i]] != priorities[res[j]] {
+			return priorities[res[i]] > priorities[res[j]]
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -0,0 +1,66 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package librariesresolver
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/stretchr/testify/require"
+	semver "go.bug.st/relaxed-semver"
+)
+
+func TestIndexResolver(t *testing.T) {
+	index := &librariesindex.Index{Libraries: map[string]*librariesindex.Library{}}
+	addLib := func(name string, includes []string, archs ...string) {
+		lib := &librariesindex.Library{Name: name, Releases: map[string]*librariesindex.Release{}, Index: index}
+		lib.Latest = &librariesindex.Release{
+			Version:       semver.MustParse("1.0.0"),
+			Architectures: archs,
+			Includes:      includes,
+			Library:       lib,
+		}
+		lib.Releases["1.0.0"] = lib.Latest
+		index.Libraries[name] = lib
+	}
+	addLib("Calculus Lib", nil, "*")
+	addLib("Calculus Lib Improved", []string{"Calculus_Lib.h", "Improved.h"}, "avr")
+	addLib("Another Calculus Lib", []string{"calculus_lib.h"}, "*")
+	addLib("Calculus SAMD", []string{"Calculus_Lib.h"}, "samd")
+	addLib("Unrelated", []string{"Calculus_Lib.h"})
+
+	resolver := NewIndexResolver(index)
+	names := func(libs []*librariesindex.Library) []string {
+		res := []string{}
+		for _, lib := range libs {
+			res = append(res, lib.Name)
+		}
+		return res
+	}
+	require.Len(t, resolver.AlternativesFor("Calculus_Lib.h"), 5)
+	require.Equal(t,
+		[]string{"Calculus Lib", "Calculus Lib Improved", "Another Calculus Lib", "Unrelated"},
+		names(resolver.SuggestFor("Calculus_Lib.h", "avr")))
+	require.Equal(t,
+		[]string{"Calculus Lib", "Another Calculus Lib", "Calculus SAMD", "Unrelated"},
+		names(resolver.SuggestFor("Calculus_Lib.h", "samd")))
+	require.Len(t, resolver.SuggestFor("Calculus_Lib.h", ""), 5)
+	require.Equal(t, []string{"Calculus Lib Improved"}, names(resolver.SuggestFor("Improved.h", "avr")))
+	require.Empty(t, resolver.SuggestFor("Improved.h", "samd"))
+	require.Empty(t, resolver.SuggestFor("Missing.h", "avr"))
+}
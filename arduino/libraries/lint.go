@@ -17,31 +17,254 @@
 
 package libraries
 
-// Lint produce warnings about the formal correctness of a Library
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/arduino-cli/arduino/utils"
+	"github.com/arduino/go-paths-helper"
+	properties "github.com/arduino/go-properties-orderedmap"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// sccsDirs are the folders of the version control systems, they are not
+// reported as spurious hidden folders.
+var sccsDirs = map[string]bool{".git": true, ".svn": true, ".hg": true, ".bzr": true, "CVS": true, "RCS": true, "SCCS": true}
+
+// Lint produce warnings about the formal correctness of a Library, see
+// LintResults. Suggestions are not included.
 func (l *Library) Lint() ([]string, error) {
+	results, err := l.LintResults()
+	if err != nil {
+		return nil, err
+	}
+	warnings := []string{}
+	for _, r := range results {
+		if r.Severity > lint.Info {
+			warnings = append(warnings, r.String())
+		}
+	}
+	return warnings, nil
+}
+
+// LintResults checks the formal correctness of a Library: the content of
+// library.properties, the layout of the sources and of the examples and
+// the name of the folder. The results are sorted from the most severe.
+func (l *Library) LintResults() ([]*lint.Result, error) {
+	linter := &libraryLinter{lib: l, results: []*lint.Result{}}
+	if err := linter.lintProperties(); err != nil {
+		return nil, err
+	}
+	if err := linter.lintLayout(); err != nil {
+		return nil, err
+	}
+	if err := linter.lintExamples(); err != nil {
+		return nil, err
+	}
+	res := []*lint.Result{}
+	for severity := lint.Error; severity >= lint.Info; severity-- {
+		for _, r := range linter.results {
+			if r.Severity == severity {
+				res = append(res, r)
+			}
+		}
+	}
+	return res, nil
+}
+
+type libraryLinter struct {
+	lib     *Library
+	results []*lint.Result
+}
+
+func (linter *libraryLinter) report(severity lint.Severity, check, format string, args ...interface{}) {
+	linter.results = append(linter.results, &lint.Result{
+		Severity: severity,
+		Check:    check,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// lintProperties checks library.properties. The library Properties can't
+// be used since they are normalized while loading the library.
+func (linter *libraryLinter) lintProperties() error {
+	propsPath := linter.lib.InstallDir.Join("library.properties")
+	if !propsPath.Exist() {
+		linter.report(lint.Warning, "missing-library-properties",
+			"library.properties not found, the library is treated as a legacy library")
+		return nil
+	}
+	props, err := properties.LoadFromPath(propsPath)
+	if err != nil {
+		return fmt.Errorf("loading library.properties: %s", err)
+	}
+
+	for _, name := range MandatoryProperties {
+		if strings.TrimSpace(props.Get(name)) != "" {
+			continue
+		}
+		if name == "maintainer" && props.Get("email") != "" {
+			linter.report(lint.Warning, "deprecated-email",
+				"the email property is deprecated, use maintainer instead")
+			continue
+		}
+		linter.report(lint.Error, "missing-property", "missing mandatory property %s", name)
+	}
+	for _, name := range OptionalProperties {
+		if strings.TrimSpace(props.Get(name)) == "" {
+			linter.report(lint.Info, "missing-property", "missing property %s", name)
+		}
+	}
+
+	if name := strings.TrimSpace(props.Get("name")); name != "" {
+		linter.lintName(name)
+	}
+
+	if version := strings.TrimSpace(props.Get("version")); version != "" {
+		if _, err := semver.Parse(version); err != nil {
+			linter.report(lint.Error, "invalid-version", "version %s is not a valid semver version", version)
+		}
+	}
+
+	category := strings.TrimSpace(props.Get("category"))
+	if category == "" {
+		linter.report(lint.Warning, "missing-category", "missing category, Uncategorized is used")
+	} else if !ValidCategories[category] {
+		linter.report(lint.Warning, "invalid-category", "category %s is not valid, Uncategorized is used", category)
+	} else if category == "Uncategorized" {
+		linter.report(lint.Info, "uncategorized", "the library is Uncategorized, choose a category")
+	}
+
+	if strings.TrimSpace(props.Get("architectures")) == "" {
+		linter.report(lint.Warning, "missing-architectures", "missing architectures, * is used")
+	} else {
+		archs := strings.Split(props.Get("architectures"), ",")
+		for _, arch := range archs {
+			arch = strings.TrimSpace(arch)
+			if arch == "" {
+				linter.report(lint.Error, "invalid-architectures", "empty architecture in %s", props.Get("architectures"))
+			} else if strings.ContainsAny(arch, " \t") {
+				linter.report(lint.Error, "invalid-architectures", "architecture %s contains spaces", arch)
+			} else if arch == "*" && len(archs) > 1 {
+				linter.report(lint.Warning, "invalid-architectures", "architectures contains * together with other architectures")
+			}
+		}
+	}
+
+	if website := strings.TrimSpace(props.Get("url")); website != "" {
+		if u, err := url.Parse(website); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			linter.report(lint.Warning, "invalid-url", "url %s is not a valid http or https URL", website)
+		}
+	}
+
+	sentence := strings.TrimSpace(props.Get("sentence"))
+	if sentence != "" && strings.TrimSpace(props.Get("paragraph")) == sentence {
+		linter.report(lint.Info, "paragraph-repeats-sentence", "the paragraph repeats the sentence, use it to add more details")
+	}
+	return nil
+}
+
+// lintName checks the name in library.properties and the name of the
+// folder, that must be the name with invalid characters replaced by _.
+func (linter *libraryLinter) lintName(name string) {
+	for _, c := range name {
+		if !(c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || strings.ContainsRune(" _-.", c)) {
+			linter.report(lint.Error, "invalid-name", "name %s contains invalid characters, use only letters, numbers, spaces, _, - and .", name)
+			break
+		}
+	}
+	if len(name) > 63 {
+		linter.report(lint.Error, "invalid-name", "name %s is longer than 63 characters", name)
+	}
+	folder := linter.lib.InstallDir.Base()
+	if expected := utils.SanitizeName(name); folder != expected && folder != name {
+		linter.report(lint.Warning, "name-folder-mismatch",
+			"folder %s doesn't match the library name %s, it's installed as %s by the Library Manager", folder, name, expected)
+	}
+}
+
+// lintLayout checks the folders and the sources of the library.
+func (linter *libraryLinter) lintLayout() error {
+	files, err := linter.lib.InstallDir.ReadDir()
+	if err != nil {
+		return fmt.Errorf("reading library: %s", err)
+	}
+	for _, file := range files {
+		name := file.Base()
+		if file.IsDir() && strings.HasPrefix(name, ".") && !sccsDirs[name] {
+			linter.report(lint.Warning, "spurious-dir", "spurious %s folder", name)
+		}
+	}
+
+	sourceFiles := func(dir *paths.Path, exts ...string) paths.PathList {
+		files, err := dir.ReadDir()
+		if err != nil {
+			return paths.PathList{}
+		}
+		files.FilterOutHiddenFiles()
+		files.FilterSuffix(exts...)
+		return files
+	}
+	if linter.lib.Layout == RecursiveLayout {
+		if len(sourceFiles(linter.lib.InstallDir, ".h", ".hpp", ".hh", ".c", ".cpp", ".S")) > 0 {
+			linter.report(lint.Warning, "mixed-layout",
+				"the library has a src folder, the sources in the root folder are ignored")
+		}
+		if linter.lib.InstallDir.Join("utility").IsDir() {
+			linter.report(lint.Warning, "mixed-layout",
+				"the library has a src folder, the utility folder is ignored")
+		}
+	}
+	if len(sourceFiles(linter.lib.SourceDir, ".h", ".hpp", ".hh")) == 0 {
+		linter.report(lint.Error, "missing-headers", "no header files found in %s", linter.lib.SourceDir.Base())
+	}
+	return nil
+}
+
+// lintExamples checks that every example is a sketch folder.
+func (linter *libraryLinter) lintExamples() error {
+	examplesDir := linter.lib.InstallDir.Join("examples")
+	if !examplesDir.IsDir() {
+		if linter.lib.InstallDir.Join("example").IsDir() || linter.lib.InstallDir.Join("Examples").IsDir() {
+			linter.report(lint.Warning, "misnamed-examples", "the examples must be in the examples folder")
+		} else {
+			linter.report(lint.Info, "missing-examples", "the library has no examples")
+		}
+		return nil
+	}
+
+	files, err := examplesDir.ReadDir()
+	if err != nil {
+		return fmt.Errorf("reading examples: %s", err)
+	}
+	files.FilterOutHiddenFiles()
+	for _, file := range files {
+		if !file.IsDir() {
+			if file.HasSuffix(sketches.MainFileExtensions...) {
+				linter.report(lint.Error, "invalid-example", "example %s must be in a folder with the same name", file.Base())
+			}
+			continue
+		}
+		found, err := sketches.FindSketches(file)
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 && !sketchFolder(file) {
+			linter.report(lint.Warning, "invalid-example", "no sketch found in examples/%s, the main file must be named as its folder", file.Base())
+		}
+	}
+	return nil
+}
 
-	// TODO: check for spurious dirs
-	// subDirs, err := ioutil.ReadDir(libraryDir)
-	// if err != nil {
-	// 	return nil, fmt.Errorf("reading dir %s: %s", libraryDir, err)
-	// }
-	// 	for _, subDir := range subDirs {
-	// 		if utils.IsSCCSOrHiddenFile(subDir) {
-	// 			if !utils.IsSCCSFile(subDir) && utils.IsHiddenFile(subDir) {
-	// 				logger.Fprintln(os.Stdout, "warn",
-	// 					"WARNING: Spurious {0} directory in '{1}' library",
-	// 					filepath.Base(subDir.Name()), libProperties["name"])
-	// 			}
-	// 		}
-	// 	}
-
-	// TODO: check for invalid category in library.properties
-	// if !ValidCategories[libProperties["category"]] {
-	//  logger.Fprintln(os.Stdout, "warn",
-	// 	"WARNING: Category '{0}' in library {1} is not valid. Setting to '{2}'",
-	// 	libProperties["category"], libProperties["name"], "Uncategorized")
-	// 	libProperties["category"] = "Uncategorized"
-	// }
-
-	return []string{}, nil
+// sketchFolder returns true if dir contains a main file named as dir.
+func sketchFolder(dir *paths.Path) bool {
+	for _, ext := range sketches.MainFileExtensions {
+		if dir.Join(dir.Base() + ext).Exist() {
+			return true
+		}
+	}
+	return false
 }
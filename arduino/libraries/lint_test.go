@@ -0,0 +1,114 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package libraries
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/lint"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func writeLintTestFiles(t *testing.T, dir *paths.Path, files map[string]string) {
+	for name, content := range files {
+		file := dir.Join(name)
+		require.NoError(t, file.Parent().MkdirAll())
+		require.NoError(t, file.WriteFile([]byte(content)))
+	}
+}
+
+func lintChecks(t *testing.T, libDir *paths.Path) map[string]lint.Severity {
+	lib, err := Load(libDir, Sketchbook)
+	require.NoError(t, err)
+	results, err := lib.LintResults()
+	require.NoError(t, err)
+	checks := map[string]lint.Severity{}
+	for _, r := range results {
+		if _, ok := checks[r.Check]; !ok {
+			checks[r.Check] = r.Severity
+		}
+	}
+	return checks
+}
+
+func TestLint(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "lint_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	good := tmp.Join("My_Lib")
+	writeLintTestFiles(t, good, map[string]string{
+		"library.properties": "name=My Lib\nversion=1.0.0\nauthor=me\nmaintainer=me\n" +
+			"sentence=A library.\nparagraph=A library for testing.\ncategory=Other\n" +
+			"url=https://example.com/mylib\narchitectures=avr,samd\n",
+		"src/MyLib.h":                      "#pragma once\n",
+		"src/MyLib.cpp":                    "",
+		"examples/Basic/Basic.ino":         "",
+		"examples/Group/Nested/Nested.ino": "",
+		".git/HEAD":                        "",
+	})
+	require.Empty(t, lintChecks(t, good))
+
+	bad := tmp.Join("Wrong")
+	writeLintTestFiles(t, bad, map[string]string{
+		"library.properties": "name=Bad Lib!\nversion=one\nauthor=me\nemail=me@example.com\n" +
+			"sentence=A library.\nparagraph=A library.\ncategory=Things\n" +
+			"url=www.example.com\narchitectures=*,avr,\n",
+		"src/Bad.cpp":               "",
+		"Bad.h":                     "",
+		"utility/util.h":            "",
+		".development/notes":        "",
+		"examples/Loose.ino":        "",
+		"examples/Broken/Other.ino": "",
+	})
+	checks := lintChecks(t, bad)
+	require.Equal(t, map[string]lint.Severity{
+		"invalid-name":               lint.Error,
+		"invalid-version":            lint.Error,
+		"invalid-architectures":      lint.Error,
+		"missing-headers":            lint.Error,
+		"invalid-example":            lint.Error,
+		"deprecated-email":           lint.Warning,
+		"name-folder-mismatch":       lint.Warning,
+		"invalid-category":           lint.Warning,
+		"invalid-url":                lint.Warning,
+		"mixed-layout":               lint.Warning,
+		"spurious-dir":               lint.Warning,
+		"paragraph-repeats-sentence": lint.Info,
+	}, checks)
+
+	lib, err := Load(bad, Sketchbook)
+	require.NoError(t, err)
+	results, err := lib.LintResults()
+	require.NoError(t, err)
+	for i := 1; i < len(results); i++ {
+		require.True(t, results[i-1].Severity >= results[i].Severity, "results sorted by severity")
+	}
+	warnings, err := lib.Lint()
+	require.NoError(t, err)
+	require.Contains(t, warnings, "error: version one is not a valid semver version")
+	require.NotContains(t, warnings, "info: the paragraph repeats the sentence, use it to add more details")
+
+	legacy := tmp.Join("Legacy")
+	writeLintTestFiles(t, legacy, map[string]string{"Legacy.h": ""})
+	require.Equal(t, map[string]lint.Severity{
+		"missing-library-properties": lint.Warning,
+		"missing-examples":           lint.Info,
+	}, lintChecks(t, legacy))
+}
@@ -28,10 +28,18 @@ import (
 
 // Load loads a library from the given LibraryLocation
 func Load(libDir *paths.Path, location LibraryLocation) (*Library, error) {
+	var library *Library
+	var err error
 	if libDir.Join("library.properties").Exist() {
-		return makeNewLibrary(libDir, location)
+		library, err = makeNewLibrary(libDir, location)
+	} else {
+		library, err = makeLegacyLibrary(libDir, location)
+	}
+	if err != nil {
+		return nil, err
 	}
-	return makeLegacyLibrary(libDir, location)
+	library.InstalledFrom = loadInstallSource(libDir)
+	return library, nil
 }
 
 func addUtilityDirectory(library *Library) {
@@ -89,10 +97,8 @@ func makeNewLibrary(libraryDir *paths.Path, location LibraryLocation) (*Library,
 	library.License = libProperties.Get("license")
 
 	version := strings.TrimSpace(libProperties.Get("version"))
-	if v, err := semver.Parse(version); err != nil {
-		// FIXME: do it in linter?
-		//fmt.Printf("invalid version %s for library in %s: %s", version, libraryDir, err)
-	} else {
+	// An invalid version is reported by Lint
+	if v, err := semver.Parse(version); err == nil {
 		library.Version = v
 	}
 
@@ -0,0 +1,69 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+// Package lint contains the types of the problems reported by the linters
+// of libraries and platforms.
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Severity is the severity of a problem found by a linter
+type Severity int
+
+// The enumeration is listed in ascending order of severity
+const (
+	// Info is a suggestion to improve the library or platform
+	Info Severity = iota
+	// Warning is a problem that doesn't prevent the library or platform
+	// from being used but may confuse the users or the tools
+	Warning
+	// Error is a problem that prevents the library or platform from being
+	// used or published
+	Error
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Info:
+		return "info"
+	case Warning:
+		return "warning"
+	case Error:
+		return "error"
+	}
+	panic(fmt.Sprintf("invalid Severity value %d", s))
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (s Severity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// Result is a problem found by a linter. Check identifies the kind of
+// problem, e.g. "invalid-version".
+type Result struct {
+	Severity Severity `json:"severity"`
+	Check    string   `json:"check"`
+	Message  string   `json:"message"`
+}
+
+func (r *Result) String() string {
+	return r.Severity.String() + ": " + r.Message
+}
@@ -0,0 +1,53 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"io"
+)
+
+// CRLFWriter is an io.Writer that translates the line feeds written to
+// the underlying writer into carriage return and line feed, as needed by
+// a terminal in raw mode. Line feeds already preceded by a carriage return
+// are written unchanged.
+type CRLFWriter struct {
+	out    io.Writer
+	lastCR bool
+}
+
+// NewCRLFWriter creates a CRLFWriter writing to out.
+func NewCRLFWriter(out io.Writer) *CRLFWriter {
+	return &CRLFWriter{out: out}
+}
+
+// Write implements the io.Writer interface. The returned count refers to
+// the bytes of p, not including the carriage returns added.
+func (w *CRLFWriter) Write(p []byte) (int, error) {
+	translated := make([]byte, 0, len(p))
+	for _, b := range p {
+		if b == '\n' && !w.lastCR {
+			translated = append(translated, '\r')
+		}
+		translated = append(translated, b)
+		w.lastCR = b == '\r'
+	}
+	if _, err := w.out.Write(translated); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,116 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	serial "go.bug.st/serial.v1"
+)
+
+// Config is the configuration of the serial port opened by a Monitor
+type Config struct {
+	Port     string
+	BaudRate int
+	DataBits int             // Defaults to 8 if not set.
+	Parity   serial.Parity   // Defaults to no parity.
+	StopBits serial.StopBits // Defaults to one stop bit.
+	DTR      bool            // The state of the DataTerminalReady line after opening the port.
+	RTS      bool            // The state of the RequestToSend line after opening the port.
+}
+
+// Monitor is an open connection to a serial port
+type Monitor struct {
+	Config *Config
+	port   serial.Port
+}
+
+// Open opens the serial port as specified in the configuration and sets
+// the DTR and RTS lines if supported by the port.
+func Open(config *Config) (*Monitor, error) {
+	if config.Port == "" {
+		return nil, fmt.Errorf("missing serial port")
+	}
+	mode := &serial.Mode{
+		BaudRate: config.BaudRate,
+		DataBits: config.DataBits,
+		Parity:   config.Parity,
+		StopBits: config.StopBits,
+	}
+	if mode.DataBits == 0 {
+		mode.DataBits = 8
+	}
+	port, err := serial.Open(config.Port, mode)
+	if err != nil {
+		return nil, fmt.Errorf("opening port %s: %s", config.Port, err)
+	}
+	// Some ports (for example virtual ones) don't have modem control lines,
+	// so failing to set them is not fatal
+	if err := port.SetDTR(config.DTR); err != nil {
+		logrus.WithError(err).WithField("port", config.Port).Warn("Error setting DTR")
+	}
+	if err := port.SetRTS(config.RTS); err != nil {
+		logrus.WithError(err).WithField("port", config.Port).Warn("Error setting RTS")
+	}
+	return &Monitor{Config: config, port: port}, nil
+}
+
+// Read reads the data received from the serial port, it blocks until at
+// least one byte is received.
+func (m *Monitor) Read(p []byte) (int, error) {
+	return m.port.Read(p)
+}
+
+// Write sends data to the serial port
+func (m *Monitor) Write(p []byte) (int, error) {
+	return m.port.Write(p)
+}
+
+// Close closes the serial port
+func (m *Monitor) Close() error {
+	return m.port.Close()
+}
+
+// LineEnding is the sequence appended to each line sent in line mode
+type LineEnding string
+
+// The supported line endings
+const (
+	NoLineEnding LineEnding = ""
+	NL           LineEnding = "\n"
+	CR           LineEnding = "\r"
+	CRLF         LineEnding = "\r\n"
+)
+
+// ParseLineEnding returns the LineEnding with the given name, one of
+// "none", "nl", "cr" or "crlf".
+func ParseLineEnding(name string) (LineEnding, error) {
+	switch strings.ToLower(name) {
+	case "none":
+		return NoLineEnding, nil
+	case "nl", "lf":
+		return NL, nil
+	case "cr":
+		return CR, nil
+	case "crlf":
+		return CRLF, nil
+	}
+	return NoLineEnding, fmt.Errorf("invalid line ending: %s", name)
+}
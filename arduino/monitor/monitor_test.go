@@ -0,0 +1,70 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLineEnding(t *testing.T) {
+	for name, expected := range map[string]LineEnding{
+		"none": NoLineEnding,
+		"nl":   NL,
+		"LF":   NL,
+		"cr":   CR,
+		"crlf": CRLF,
+	} {
+		lineEnding, err := ParseLineEnding(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, lineEnding)
+	}
+	_, err := ParseLineEnding("lfcr")
+	require.Error(t, err)
+}
+
+func TestTimestampWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := NewTimestampWriter(out, "15:04:05")
+	tick := time.Date(2018, 1, 1, 10, 0, 0, 0, time.UTC)
+	w.now = func() time.Time {
+		tick = tick.Add(time.Second)
+		return tick
+	}
+
+	n, err := w.Write([]byte("hello "))
+	require.NoError(t, err)
+	require.Equal(t, 6, n)
+	w.Write([]byte("world\nsecond"))
+	w.Write([]byte(" line\n\nlast"))
+	require.Equal(t, "10:00:01 hello world\n10:00:02 second line\n10:00:03 \n10:00:04 last", out.String())
+}
+
+func TestCRLFWriter(t *testing.T) {
+	out := &bytes.Buffer{}
+	w := NewCRLFWriter(out)
+
+	n, err := w.Write([]byte("first\nsecond\r"))
+	require.NoError(t, err)
+	require.Equal(t, 13, n)
+	w.Write([]byte("\nthird\r\n\n"))
+	require.Equal(t, "first\r\nsecond\r\nthird\r\n\r\n", out.String())
+}
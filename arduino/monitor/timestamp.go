@@ -0,0 +1,72 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"bytes"
+	"io"
+	"time"
+)
+
+// TimestampWriter is an io.Writer that prefixes each line written to the
+// underlying writer with the time it was received. Lines may be written
+// in multiple chunks, the timestamp is added once at the beginning of the
+// line.
+type TimestampWriter struct {
+	out         io.Writer
+	layout      string
+	now         func() time.Time
+	atLineStart bool
+}
+
+// NewTimestampWriter creates a TimestampWriter that formats the timestamps
+// with the given time layout.
+func NewTimestampWriter(out io.Writer, layout string) *TimestampWriter {
+	return &TimestampWriter{
+		out:         out,
+		layout:      layout,
+		now:         time.Now,
+		atLineStart: true,
+	}
+}
+
+// Write implements the io.Writer interface. The returned count refers to
+// the bytes of p, not including the timestamps.
+func (w *TimestampWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if w.atLineStart {
+			if _, err := io.WriteString(w.out, w.now().Format(w.layout)+" "); err != nil {
+				return written, err
+			}
+			w.atLineStart = false
+		}
+		chunk := p
+		if i := bytes.IndexByte(p, '\n'); i != -1 {
+			chunk = p[:i+1]
+			w.atLineStart = true
+		}
+		n, err := w.out.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[len(chunk):]
+	}
+	return written, nil
+}
@@ -74,6 +74,21 @@ func (r *DownloadResource) TestLocalArchiveChecksum(downloadDir *paths.Path) (bo
 	return bytes.Compare(algo.Sum(nil), digest) == 0, nil
 }
 
+// ComputeChecksum returns the SHA-256 checksum of the file in the format
+// used by the package indexes, e.g. "SHA-256:01ab...".
+func ComputeChecksum(file *paths.Path) (string, error) {
+	f, err := os.Open(file.String())
+	if err != nil {
+		return "", fmt.Errorf("opening archive file: %s", err)
+	}
+	defer f.Close()
+	algo := sha256.New()
+	if _, err := io.Copy(algo, f); err != nil {
+		return "", fmt.Errorf("computing hash: %s", err)
+	}
+	return "SHA-256:" + hex.EncodeToString(algo.Sum(nil)), nil
+}
+
 // TestLocalArchiveSize test if the local archive size match the DownloadResource size
 func (r *DownloadResource) TestLocalArchiveSize(downloadDir *paths.Path) (bool, error) {
 	filePath, err := r.ArchivePath(downloadDir)
@@ -19,12 +19,41 @@ package resources
 
 import (
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 
 	paths "github.com/arduino/go-paths-helper"
 	"go.bug.st/downloader"
 )
 
+// LocalFilePath returns the path of the file referenced by a file:// URL,
+// or nil if the URL is not a file:// URL.
+func LocalFilePath(URL *url.URL) *paths.Path {
+	if URL.Scheme != "file" {
+		return nil
+	}
+	path := URL.Path
+	if runtime.GOOS == "windows" {
+		// file:///C:/dir/file has path /C:/dir/file
+		path = strings.TrimPrefix(path, "/")
+	}
+	return paths.New(filepath.FromSlash(path))
+}
+
+// FileURL returns the file:// URL of an absolute path, the inverse of
+// LocalFilePath.
+func FileURL(path *paths.Path) *url.URL {
+	res := &url.URL{Scheme: "file", Path: filepath.ToSlash(path.String())}
+	if !strings.HasPrefix(res.Path, "/") {
+		// Windows paths like C:/dir
+		res.Path = "/" + res.Path
+	}
+	return res
+}
+
 // ArchivePath returns the path of the Archive of the specified DownloadResource relative
 // to the specified downloadDir
 func (r *DownloadResource) ArchivePath(downloadDir *paths.Path) (*paths.Path, error) {
@@ -44,7 +73,9 @@ func (r *DownloadResource) IsCached(downloadDir *paths.Path) (bool, error) {
 	return archivePath.Exist(), nil
 }
 
-// Download a DownloadResource.
+// Download a DownloadResource. Archives with a file:// URL are copied in
+// downloadDir right away and a nil Downloader is returned, like for
+// archives already downloaded.
 func (r *DownloadResource) Download(downloadDir *paths.Path) (*downloader.Downloader, error) {
 	cached, err := r.TestLocalArchiveIntegrity(downloadDir)
 	if err != nil {
@@ -60,6 +91,14 @@ func (r *DownloadResource) Download(downloadDir *paths.Path) (*downloader.Downlo
 		return nil, fmt.Errorf("getting archive path: %s", err)
 	}
 
+	URL, err := url.Parse(r.URL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing archive URL: %s", err)
+	}
+	if localFile := LocalFilePath(URL); localFile != nil {
+		return nil, r.copyLocalArchive(localFile, downloadDir)
+	}
+
 	if stats, err := path.Stat(); os.IsNotExist(err) {
 		// normal download
 	} else if err == nil && stats.Size() > r.Size {
@@ -75,3 +114,22 @@ func (r *DownloadResource) Download(downloadDir *paths.Path) (*downloader.Downlo
 
 	return downloader.Download(path.String(), r.URL)
 }
+
+// copyLocalArchive copies the archive from localFile into downloadDir and
+// checks its integrity.
+func (r *DownloadResource) copyLocalArchive(localFile, downloadDir *paths.Path) error {
+	path, err := r.ArchivePath(downloadDir)
+	if err != nil {
+		return fmt.Errorf("getting archive path: %s", err)
+	}
+	if err := localFile.CopyTo(path); err != nil {
+		return fmt.Errorf("copying archive %s: %s", localFile, err)
+	}
+	if ok, err := r.TestLocalArchiveIntegrity(downloadDir); err != nil {
+		return fmt.Errorf("testing local archive integrity: %s", err)
+	} else if !ok {
+		path.Remove()
+		return fmt.Errorf("archive %s is corrupted", localFile)
+	}
+	return nil
+}
@@ -20,6 +20,7 @@ package resources
 import (
 	"crypto"
 	"encoding/hex"
+	"path/filepath"
 	"testing"
 
 	paths "github.com/arduino/go-paths-helper"
@@ -112,3 +113,37 @@ func TestDownloadAndChecksums(t *testing.T) {
 	_, err = r.TestLocalArchiveChecksum(tmp)
 	require.Error(t, err)
 }
+
+func TestDownloadLocalFile(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	data := []byte("local archive content")
+	algo := crypto.SHA256.New()
+	algo.Write(data)
+	source := tmp.Join("mirror", "archive.zip")
+	require.NoError(t, source.Parent().MkdirAll())
+	require.NoError(t, source.WriteFile(data))
+
+	r := &DownloadResource{
+		ArchiveFileName: "archive.zip",
+		CachePath:       "cache",
+		Checksum:        "SHA-256:" + hex.EncodeToString(algo.Sum(nil)),
+		Size:            int64(len(data)),
+		URL:             "file://" + filepath.ToSlash(source.String()),
+	}
+	d, err := r.Download(tmp)
+	require.NoError(t, err)
+	require.Nil(t, d)
+	copied, err := tmp.Join("cache", "archive.zip").ReadFile()
+	require.NoError(t, err)
+	require.Equal(t, data, copied)
+
+	// Corrupted archive in the mirror
+	require.NoError(t, tmp.Join("cache", "archive.zip").Remove())
+	require.NoError(t, source.WriteFile([]byte("local archive CONTENT")))
+	_, err = r.Download(tmp)
+	require.Error(t, err)
+	require.False(t, tmp.Join("cache", "archive.zip").Exist())
+}
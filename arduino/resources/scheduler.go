@@ -0,0 +1,210 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultMaxParallelDownloads is the number of archives downloaded in
+// parallel by a DownloadScheduler when not specified.
+const DefaultMaxParallelDownloads = 4
+
+// DownloadScheduler downloads a set of DownloadResources in parallel. A
+// partially downloaded archive is resumed and a failed download is retried
+// with an exponential backoff; an archive added more than once is downloaded
+// only once.
+type DownloadScheduler struct {
+	DownloadDir *paths.Path
+	MaxParallel int           // Maximum number of parallel downloads.
+	MaxRetries  int           // Number of retries of a failed download.
+	RetryDelay  time.Duration // Delay before the first retry, doubled at every retry.
+
+	jobs      []*downloadJob
+	byArchive map[string]*downloadJob
+}
+
+type downloadJob struct {
+	resource   *DownloadResource
+	downloaded int64 // Accessed atomically.
+}
+
+// NewDownloadScheduler creates a DownloadScheduler saving the archives in
+// downloadDir. If maxParallel is not positive DefaultMaxParallelDownloads
+// is used.
+func NewDownloadScheduler(downloadDir *paths.Path, maxParallel int) *DownloadScheduler {
+	if maxParallel <= 0 {
+		maxParallel = DefaultMaxParallelDownloads
+	}
+	return &DownloadScheduler{
+		DownloadDir: downloadDir,
+		MaxParallel: maxParallel,
+		MaxRetries:  3,
+		RetryDelay:  time.Second,
+		byArchive:   map[string]*downloadJob{},
+	}
+}
+
+// Add schedules the download of a resource. It fails if a different archive
+// with the same file name has been already added.
+func (s *DownloadScheduler) Add(r *DownloadResource) error {
+	key := r.CachePath + "/" + r.ArchiveFileName
+	if job, ok := s.byArchive[key]; ok {
+		if job.resource.Checksum != r.Checksum {
+			return fmt.Errorf("archive %s scheduled twice with different checksums", r.ArchiveFileName)
+		}
+		return nil
+	}
+	job := &downloadJob{resource: r}
+	s.byArchive[key] = job
+	s.jobs = append(s.jobs, job)
+	return nil
+}
+
+// Len returns the number of archives to download.
+func (s *DownloadScheduler) Len() int {
+	return len(s.jobs)
+}
+
+// TotalSize returns the size of all the archives to download.
+func (s *DownloadScheduler) TotalSize() int64 {
+	size := int64(0)
+	for _, job := range s.jobs {
+		size += job.resource.Size
+	}
+	return size
+}
+
+// Downloaded returns the bytes of all the archives downloaded so far,
+// including the ones already present in the download directory.
+func (s *DownloadScheduler) Downloaded() int64 {
+	size := int64(0)
+	for _, job := range s.jobs {
+		size += atomic.LoadInt64(&job.downloaded)
+	}
+	return size
+}
+
+// RunAndPoll runs the downloads and calls the poll function every interval
+// time with the bytes downloaded so far. No download is started after a
+// failure, the first error is returned when the running ones are ended.
+func (s *DownloadScheduler) RunAndPoll(poll func(downloaded int64), interval time.Duration) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	done := make(chan error)
+	go func() { done <- s.Run() }()
+	for {
+		select {
+		case <-t.C:
+			poll(s.Downloaded())
+		case err := <-done:
+			poll(s.Downloaded())
+			return err
+		}
+	}
+}
+
+// Run runs the downloads and waits until they are completed, see
+// RunAndPoll.
+func (s *DownloadScheduler) Run() error {
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	var firstErr error
+	failed := func() bool {
+		errLock.Lock()
+		defer errLock.Unlock()
+		return firstErr != nil
+	}
+
+	slots := make(chan bool, s.MaxParallel)
+	for _, job := range s.jobs {
+		slots <- true
+		if failed() {
+			<-slots
+			break
+		}
+		wg.Add(1)
+		go func(job *downloadJob) {
+			defer wg.Done()
+			defer func() { <-slots }()
+			if err := s.download(job); err != nil {
+				errLock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				errLock.Unlock()
+			}
+		}(job)
+	}
+	wg.Wait()
+	return firstErr
+}
+
+// download downloads the archive of a job retrying on failures.
+func (s *DownloadScheduler) download(job *downloadJob) error {
+	delay := s.RetryDelay
+	for retry := 0; ; retry++ {
+		err := s.tryDownload(job)
+		if err == nil {
+			return nil
+		}
+		if retry >= s.MaxRetries {
+			return fmt.Errorf("downloading %s: %s", job.resource.ArchiveFileName, err)
+		}
+		logrus.WithError(err).Warnf("Downloading %s, retrying in %s", job.resource.ArchiveFileName, delay)
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (s *DownloadScheduler) tryDownload(job *downloadJob) error {
+	r := job.resource
+	d, err := r.Download(s.DownloadDir)
+	if err != nil {
+		return err
+	}
+	if d != nil {
+		err := d.RunAndPoll(func(downloaded int64) {
+			atomic.StoreInt64(&job.downloaded, downloaded)
+		}, 100*time.Millisecond)
+		if err != nil {
+			// The partial archive is kept to resume the download.
+			return err
+		}
+		if ok, err := r.TestLocalArchiveIntegrity(s.DownloadDir); err != nil {
+			return fmt.Errorf("testing local archive integrity: %s", err)
+		} else if !ok {
+			// The server may have ignored the range request of a resumed
+			// download, start again from scratch.
+			if path, err := r.ArchivePath(s.DownloadDir); err == nil {
+				path.Remove()
+			}
+			atomic.StoreInt64(&job.downloaded, 0)
+			return fmt.Errorf("archive is corrupted")
+		}
+	}
+	atomic.StoreInt64(&job.downloaded, r.Size)
+	return nil
+}
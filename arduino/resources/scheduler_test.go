@@ -0,0 +1,119 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package resources
+
+import (
+	"bytes"
+	"crypto"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadScheduler(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	archives := map[string][]byte{
+		"/a.zip":     bytes.Repeat([]byte("a"), 10000),
+		"/b.zip":     bytes.Repeat([]byte("b"), 20000),
+		"/flaky.zip": bytes.Repeat([]byte("f"), 5000),
+	}
+	var lock sync.Mutex
+	requests := map[string]int{}
+	ranges := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		requests[r.URL.Path]++
+		count := requests[r.URL.Path]
+		if rng := r.Header.Get("Range"); rng != "" {
+			ranges[r.URL.Path] = rng
+		}
+		lock.Unlock()
+		if r.URL.Path == "/flaky.zip" && count == 1 {
+			http.Error(w, "try again", http.StatusServiceUnavailable)
+			return
+		}
+		http.ServeContent(w, r, r.URL.Path, time.Time{}, bytes.NewReader(archives[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	resource := func(name string) *DownloadResource {
+		algo := crypto.SHA256.New()
+		algo.Write(archives["/"+name])
+		return &DownloadResource{
+			URL:             server.URL + "/" + name,
+			ArchiveFileName: name,
+			CachePath:       "cache",
+			Checksum:        "SHA-256:" + hex.EncodeToString(algo.Sum(nil)),
+			Size:            int64(len(archives["/"+name])),
+		}
+	}
+
+	// Partial download of b.zip to be resumed
+	require.NoError(t, tmp.Join("cache").MkdirAll())
+	require.NoError(t, tmp.Join("cache", "b.zip").WriteFile(archives["/b.zip"][:5000]))
+
+	s := NewDownloadScheduler(tmp, 2)
+	s.RetryDelay = time.Millisecond
+	require.NoError(t, s.Add(resource("a.zip")))
+	require.NoError(t, s.Add(resource("b.zip")))
+	require.NoError(t, s.Add(resource("flaky.zip")))
+	require.NoError(t, s.Add(resource("a.zip")))
+	wrong := resource("a.zip")
+	wrong.Checksum = "SHA-256:" + strings.Repeat("0", 64)
+	require.Error(t, s.Add(wrong))
+	require.Equal(t, 3, s.Len())
+	require.Equal(t, int64(35000), s.TotalSize())
+
+	var lastPoll int64
+	require.NoError(t, s.RunAndPoll(func(downloaded int64) { lastPoll = downloaded }, time.Millisecond))
+	require.Equal(t, int64(35000), lastPoll)
+	for name, data := range archives {
+		content, err := tmp.Join("cache", name).ReadFile()
+		require.NoError(t, err)
+		require.Equal(t, data, content)
+	}
+	require.Equal(t, map[string]int{"/a.zip": 1, "/b.zip": 1, "/flaky.zip": 2}, requests)
+	require.Equal(t, "bytes=5000-", ranges["/b.zip"])
+
+	// Already downloaded archives are not requested again
+	s = NewDownloadScheduler(tmp, 2)
+	require.NoError(t, s.Add(resource("a.zip")))
+	require.NoError(t, s.Run())
+	require.Equal(t, 1, requests["/a.zip"])
+
+	// Missing archive
+	s = NewDownloadScheduler(tmp, 2)
+	s.RetryDelay = time.Millisecond
+	s.MaxRetries = 1
+	missing := resource("a.zip")
+	missing.URL = server.URL + "/missing.zip"
+	missing.ArchiveFileName = "missing.zip"
+	require.NoError(t, s.Add(missing))
+	require.Error(t, s.Run())
+	require.Equal(t, 2, requests["/missing.zip"])
+}
@@ -0,0 +1,154 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package security
+
+// arduinoPublicKey contains the keys of Arduino LLC (7BAF404C2DFAB4AE)
+// and Arduino Packages (95FA6F43E21188C4), the first one signs the
+// official package and library indexes.
+const arduinoPublicKey = `-----BEGIN PGP PUBLIC KEY BLOCK-----
+
+mQINBFL6ovoBEADQxWBJMhEXUGKPFx5OYS1Zmr6o5DCYiLm56HzL06rQFLJ0VLCv
+COfN0BnactSSts+IKtf6hXHphcU4WC2glsNx5/zZW3G8AMDpK93CaAHxsRyGgU4O
+qEnllz9jD8Qm5qXyYsIphstIm1MEAFICunKdUZcl4+YELJGZyOznNAUrc3bPQKhk
+Z5w1lMkyA+v7P4LNQs2Vb5YXkiM7THwaKCUjYPSPHW2GYvLPk/h9tAqZME9hgor4
+o+sHI56YRincCx1cZJTJr7XI+LmlPxMkwlShrqTNkhmrTfhmdlOsmm52w9s3zo9g
+tUb3jspDqQy4KiJ4opHS6Y1mdTtW8FlSgMbjOydPYxhGgG2XRH3VyUOOfshXedn6
+IXPgiM5voVbikfr9QyxPwrHrJR2v0TxyH/Zhj2lrd8Ei+3Xjy8tEb6qn//3Qccgc
+bD0jYNSVlkxiNhc1KRW7sw+nyQPqCWvwHnf8hMi1HALrEbyfA/GcfoElTx94bmSn
+qff0OIc1g8+kD0nA8EFDLq7M7H7pukZaMDIDBvDi5l6+AeUzy72LHBwEIiIT1dBf
+S2iRk9tgpopvH8iyrdnlihBOSCqtOGnMxCI27cnL6FYeEFg3q296dk3OXYy2Jgjo
+Ez8P3V+Pr747xX7lUa3HOGqt1EOzMXFuwDKs+cY5v53+YjAdTxlyIfEN7wARAQAB
+tCBBcmR1aW5vIExMQyA8c3VwcG9ydEBhcmR1aW5vLmNjPokCOAQTAQIAIgUCUvqi
++gIbAwYLCQgHAwIGFQgCCQoLBBYCAwECHgECF4AACgkQe69ATC36tK74CQ/+IMOz
+a/eG1pKWnaLs/XvKOWHnNdPLtVhderBLuKC2S2RSjtdttHUvokUjqh4HtppqZs3a
+4HSmpXKAAigZTdWRapVr8iYG2GbH/YHzKHjgb+wgDdsHA9gF4aYQBusLW9s6qJwJ
+W7JZvZPHqui9sYRoptvjD4W9ajJx9UVusivCvOmds6BU2byo9WLAG5nmv0whNrYn
+ce71TLKulfji/lQyhMN+t35RBNSZOauu8yPKXxpmykjtQj27Oiz/EnksynGs0eMD
+IYbMfQWhPg1moyWORDUnqvkht+pwxswQ4qUfyrTdEwoQ09KbGwH7QgfvZQHTqRhr
+22UuzMnzVFmaEU3T+A+e00k6xRpcTx87tZBJ7n7GFBHpDgLyd4noexioYFUd/f+o
+cOhUL2Eo4WfOGHXFxbESglk0e4UmVIcAaxc6pjHxzaHtxoxUl44dD+OzEMwPSfmu
+hPN7FHJDe2naEluv3JmuV8IkX3B0fh79UoScQEaSR88Ty2eaMa9HAEaOCe0ez+Wl
+P2fIDEigsMEzT66WUFhN/UBq2jD/vu1lklbUCSRDKwKbuyTO8iGV04k4HwseuWTG
+SUlCM150o3PYadH7DHln8w951xqwaSnOu2YFFMJWcoS9nsMkcLJjU5s6/10/upon
+XUZl5rUCtAMbY/URwd/dFrYXpvsEb86wGKegHO+5Ag0EUvqi+gEQANbeF0c5XrOD
+YQPTD6XPVV9vvJgvuLD9cjic1umaiKyhvL2LrTUhGSmrWrf2Vroa/6jJpa+DQ2/I
+/jarQDRT4+bsZ5NxrYFlf6FQaVaxFl2Ih+P7fvNm78yoLuVD4LIhcNAWSmcC71KA
+OYqQHLYmLmPArjeP2MoZV+7tnOSKo9SBvRF6LKA0HD4W/iDLalwxMKGbNk9lbNxF
+4iFt56z61CmWfXHRAcreELpk9AdYAe0unjoykxFFQ0VqJiNsykWdx3ANLpxpK63K
+m6DN5xic1ZSyDKTR8gpwsCubUPcM/G92l7HVAHAs4pSSx80oxdVVR1eI3eV0grw5
+6EZacg4lhmrJMdXXAwq2ETa/cCslvIUKUInR5vD2i4rolK38PJK7g2iI49talAQm
+2+e7xb3T3db1EjYn0c5v0YRcxmqSAJQ5G+eDBpywV0bApV2vyGn9rwoI+BCZ5PTN
+B9BccmnFOMNBzx7blBFLjNl7RCFOpY7tuT+rdyATodd6CLkggIL5YXps/jm1bwB4
+QGxiZ6v1zxB4xJsaubYOoUUTUc+InvctfWlrI7IvdTsol5rxAje1eaNQ+lWWo7Ii
+RPqRQCVirlMOgU7xmp40SPRl94wWIg3gZj97l8fw7xYp4vZKdrIbtpWj3lBbIrCb
+NFmjziGAQkvWfISC69XryBKPmGNO7ocHABEBAAGJAh8EGAECAAkFAlL6ovoCGwwA
+CgkQe69ATC36tK4FCxAAwUNOjMDW+OYOL7CRql6gTnYSsp04I+CZFPcE3hg1p7IC
+0/YZGDvToWQ5v6MaavNCZy6PWRhDM8T1bRivO3zoMm9lX3yN0dSzihlk5qTXVQ0V
+nOG17ES8IJGxCXyr5yTA6MSULCz4JnLj8gkyInDRMzE89gHgd1a3BZRqRSNdr3KU
+vNNCktmJ79/aL0avCurscvVdxrKUDHxqQJt/rTNU1coww+TuKfkhilbvjX+6Knu4
+5jBBEKId8MhHxLdhzeQIzhVtUwkVNagAwcUiyjPHEQWxFVChRf0OQbRkFGtG1G8I
+3675sD0xPVSh5Kguh0mJWreFIdqo5m7vb3sXoMpLTL/Lk3cTuYBiaVVuvYiuh5lu
++sCEasug00EvwKXpCSPCYc1EPk1sGuk9gxZpN8X2BqFP1z20kZoO1BbUsxY0IPV/
+rM6ck0e9VQG+P8gwRytkBo5f9bCedCUDBiUkZyDSFgje6Cn4ToNlUn92TJHakzcs
+cqpAVLRYEEyvwr3O1j3IDzbnvUvg06GeIOP+2Q+A+eFYSFO5cbjoR8JwJxI7mqGc
+PpC0GzpkPT1b4vwTStqDltBy035xAbZM6D4YAtDV3akVC2whVkmHlQyWAfwhR/E5
+x6mQZkCgiDmBtFLyWveg8y+qIUjs3ZsEuTr87QDxGqDmaVwvkmdrjbnpMXL9d3m5
+Ag0EUwsFpwEQAMqhqP9L+dDwrJ7byjtNJuPlad/qBDQfPmrOI65dh9YsJgDf8QsQ
+YUShtS/4tpWlkNZcaB9p3uRUgAwjUWDr4/wUNhk+EnjVbIbiu7IYe+qsHh0E1Ao5
+KxRXRx0Qora/Oc3zXRoJCpQGvLBr3vg6EqSQxeF9aIhK0mhgQmaeK0WK08wDd92p
+xY1wcM4ppT4OfIdtYbKaLeKp1z+RTQ/zsONeLtNhtgqMPD1MfnfhepOSg7/aLsVy
+Wiv6rBjGpkrL7HdnYNcIbqQr2TAx6LWfuN/v935fgNvrhK3nSzpvnk0PMUCo0PV2
+7QBUiIPIUnGqfyRQ0QYfVsuDl4YDiGHVokc7f1jrwA0rue/rGi32Eqe5CHwyb7sI
+8oeRAiUzFnhCcpZ6iGCJ1h1asP2zNzfTXyfCiGq7TU6I9UHQu60Erve9PtZqEoK3
+Yr1vju3DdgdzsVfBotTkWG5Dsoh5xU51mfmjThUk5uf5uOoTzFot9cGSCvdIM+3e
+yOuai+MxlnAt/WVtgay7/joQ2oguqjBl2clHbAp7ZsFdAGPLetGi6zFTfLRD8huB
+ZCQ2lD/mxF5q+cK1ldTfy2S4PyymtN1TZybG7Edho0DBjjKy1yEGQLmrHY4hZcDd
+OLyf2dtqMLOA3wjD8QACpmNv3HnNIxK2BvXxFqxmVhila75GxJT8fiPHABEBAAGJ
+BD4EGAECAAkFAlMLBacCGwICKQkQe69ATC36tK7BXSAEGQECAAYFAlMLBacACgkQ
+JKJrrX8pQpGHcA/+MO0bfJazhGrHs2P5YC0ohveROpxFHDHgQ611WXAk1GC1nmpg
+pu49WOZWkBI3okZfhAIWmoFrOBcK9VAoTrQgt+gnOG1mhS1oElon+mdw8Tnuf8rv
+QwAGc7fH0WhhSHdgPIda1ZPjM66SN9t3Bl+4N1zpj6G/f7FzMDSqxh8dI6Pv+GZX
+AsEJaMeZFFj4jRUbNEjH2TNAWUMaY9MKnI5japnYjajbBMuMZPEYOshz/wlC75VV
+trPxkq1fIhrJc3+HXMriHojsRcs15AwP8arwqP5Eh22TqTCgPMSEaikQLJVvOfKs
+WAjMvNf0hoqOjouaZuoYwnXO+cNxqwWSeW7VfXV6O6sx/444h/YEHmG9pDPn1oyy
+1fKOgfV4Q9UDLXO/ZxGcE3/EzovvT3BdaQ5HpTCxqFuLagmkrhailzwR1pAxuJvp
+KwdR23/nT28cIZyLk+XGjsFAOFbfKOluJ3N6f7nID27p7EhaBgncTrjfRE9hx2qX
+8yrfoti0eE3zq6TeG1eJS5z4mTShQ0UTCNc8957Mi/OCuKNPJNwzUjjYNTdns2P1
+Qy2agchPfS+rbjbnGI+pERIKkFxnNCqZYlHr7KwTvVQ6mzwsBjrilP3RXGbV3ZIk
+8+k2Ml9SVwDyEp0LMc6MzU66Xe24nwor/AxD5zL2lRYeTzPOXe0UsemGVFR7EQ//
+fLwrpRNyGpbdGJZGNQaTQ/qO701JK/pVyTD5x43x90VPG91A9LBL3p+bmpkjowPZ
+bQy/o2GSGv7xOu0JjQz3DoTA3bIMWIITUdI1mxMWcar10khHF6TK84XbDMGfvDej
+/AT084fWk0wehLnBKRIxoU9pob9nCIdcfeAOPv48eFWiRZyWJFxfDSH8AOh6DBj4
+Cjt5wOKOonSTMJxTUlRCG+fN+++1tE2upWtoWUMPzL7nZgSPiRrVy1A4ZrmOUh7W
+mzfkFlASpF4pg24qA4ByjBEI5MijLqGG4ahV942lUGts+G24iKh/q24VqQ40FkaV
+It9b2Ictcps15tgsl0zYAHbCYAgBWrIWyD+vZOSI8H0r0B9RsJY/h74KuDkrRCIn
+v3IVFIA4sMVRiQJNfBsDLbGzxWxmlT5TDFsyNjT8WEpHbK0oXvEQgBHRTZ0YCnWp
+38k2r8fvnmw/PP7diUiUzmA1jnFWs6Ze12RN6jQ6Ez9dTeTTO3QoEIagwgUVrEFR
+z+2TxW3VdOV4/e5yxBFcJcrsXq2XwUfyf06uZ/7/6g3BzfXWNqwzHLdN9HfJw7Nw
+b52vUMLhOsjejMndPHnlnsd57kidkVzyL9xT47PHcQ/oNo3xG5rN9fPK4fQ8tzEu
+Xp9X8khpKzaBy6PkkgeHj9M+0qR86c6bTkpu/Y8K0s2ZAg0EWNEzvQEQALvnfMw/
+N45B4fP6NWizBMGAIv6N5I5LNrhBo+5Ur5BvjUvdtYGPUQs15MHR6uKW0x5RSE3Q
+5OqXxLAsA07NZG7dDX92v1FXFZ7/itxkRN4ku9y4n2NmnIlhgmtcJXF0rOo7fobH
+Rh5NYRWHfy9NctB/NqA+nTsRQqUvKwcyuj+GE8opzAGVPt/SfdBQJNYxGdFow1in
+Jx3h6PvErqTTS5CtUarjKcjW+ZqIlGxHufrLqGSbkC6VuGl3GAiS4zDMgVnEsMws
+RvRVwIZUsCuVK8SltMzHbMHl6KY4E+bJSySl8h0BbOCpw0mUGPbxWyPXxMt7Mi1x
+2g0qG4bAbaZoOr51MXZRrMPSYkx2EAFt11R4gTpgseB/qxhzKWilA1PAMLnbOgo0
+qXfJ9qTeZwn/M6sIWlj8F0ak5KnI9/zZ1oUW8AV+fSprXbdYzJkxP7nDT7Tl+iY4
+JqLnPJLa9KsFEes3CK0Qcw5TVHg7+xOQQeTcZJ+oEM6PHibCgKFrKVZqfmcxlZkB
+RF4OFtJTRm+fmCGmkTKIXYTmDJJvwB+cB9AOr9PaVWVFqao98jr96ne0cWpLnOGs
+BUIYNwU66KVxdH+qr+WSQdgiV0Rb42ge1PfGsLvO5R8GDAQ9AaDJRYWmZbfpQi0n
+IwSwVsAAjaCMkRQOLmFwPdaY70QyVFjioD6JABEBAAGwDAAAZ3BnAQAAAAAAALQl
+QXJkdWlubyBQYWNrYWdlcyA8c3VwcG9ydEBhcmR1aW5vLmNjPrAMAABncGcCAAAA
+AAAAiQI4BBMBAgAiBQJY0TO9AhsDBgsJCAcDAgYVCAIJCgsEFgIDAQIeAQIXgAAK
+CRCV+m9D4hGIxH9kD/9rjgudhwzYNhy/9wOK4cVeYRAdxjPvuqzBuzehBdAbrIs+
+3N4RlyLoaaBPJIFu+FzMDh/tayKkjBIytx8pNZ2IgzEEwjEOSJJOPEqxie6MZ7TX
+i8uj5f+2l34cZBFqPkkJ5/JWUiqgk3eJ0bpTQ1MyqW/5QkQcLAJhqK0P+nWwfLsm
+aMCqGzALL+WJ48MGc5tjL7XKWLDKI2YtBm+7YXM7VGDxrvh2iCzH5bn9pbpK14PW
+oSv0PvWSz1NZYFxlTtNEXV4uxrfghs88lX5cpd69BZHQeaKTsGafxo9gm9tUh8fw
+FvP6bZyB+Rw6o3r08KSie5EmL3pnGBjfAm4V8rVVU19rM+ZAhv1god5jcMOzfM/h
+/Qdw2DXMuYcUIx5Rd61IgC0kea/g1XzTb/5kMzYUgisCCBK0lUhrLdkdteooscc7
+vICe9c0jgzozDV/hdXOuxkn/4NSKuCtlLhNKb/F0xyqJEz6KzGDLBofIBHxJ+dJS
+1Yc98RBr7M5aqeBRWTH0JT5WzI/37gECG4tR1JCN8QEtJUJCD4ZXKCB3PiSdHBlz
+vomElrck9q89SmrO8ZL2SEu/3/iE5qg5wuDHMqU2jRQfOxDlcKjcBsZ4KYrekOYZ
+fnQdj1HvRKN1ui0R0SDbyU+K5gvD5/g939esT3hYiZ0knoTEnn14C+qGgoLMSbAG
+AANncGcAuQINBFjRM70BEADUbrB1bNjrbQTPKEaF4ayQUeDRvfffw2/wi4vOA9xx
+rhAQtwLtnWIo1NZq3mj+0ynTO7sdQGxCZzupsZQFRV9gqcSU9cLjZA9tS1zVkUfo
+KSIEiYRcsQw+y+CkWUkKqFZsnyjp7xceCYjkXbgWIZZC7c2f+o0ZRf5n4t/FuavM
+lG0D8kHbLpxI8Avf7hAPWuK+34CxZNtiV0F+JxNUfxTpoo6nF5itbXyNK2MqKxr7
+1Uis+lLVAoMX+O6mBe1KzHzIFmOxOgG9P+I1hwxK2L6imjC3lT9tXyqbeUtpRwkM
+LnrgWBfubFQorYGssyAY5IpJp4Lh/eDKZWRbk/ZuA9pueHxWacnWQhg//Dl3rGsz
+PAHw83EZyGXJKDfVQq8QKleEuaJA96YCxr9BHYjVnwLhdjuYzAj0g6ec5qk8w2F4
+Qca7y9beKQQCpXYGq5bOkYIIrJrnfx419WoSZZCM8JuHpafGj4PkFnUiipFWHl3i
+bI2M+MsmrAngw3SGdz5iFW+cuPWkPHWK0OJV741wpgz+M8Ksaq9e9r1aKBwoPSjO
+u8wYjDVTaDGflXAkP0k16GGHIo+augg12rHWeYbn/MaBCNIr2CR7mFakzM8VefiK
+1zhYdmbjzWTHjOAHWFpnBWCz9yit9xy1NqKaVMi7md2hJrUcTUFoe206F99btOH4
+vwARAQABiQIfBBgBAgAJBQJY0TO9AhsMAAoJEJX6b0PiEYjEAU8P/2/XvX60Y01f
+2X90iQNrTGGCbu1l6biwdQSZRmz50vxxk1zmb71MAwn9ko224whVqVkDe0tgZ2j4
+HkuRixBB602V8nRvR06dabTAVzbH1HsOceDeM39eYvxJxhOXnTEKeghONglhKLgV
+UlwHWgQvz8TFzPkw255Oe9Yg1PFwRypSPHEazbEf9eGUpeovKmpgiaGnQYtLX5Vo
+0xZPuWgQNhSRXKTJ6y/jExmoG0NtFeziSHGx8eKJ6o07+N09aD6jl7Uv+aL/WgyZ
+lYOIoua/Oh4hg/No7yk5ts3SoUSip44FYq5LOE3g7XsSqXPF6ys3fI49luV38CPG
++KZvOGAc++ezXeri8V6Wx0EUnWuVHUn5N8rgaf8COtCUVVYwGAxHg8F2aDge1n7I
+mJsr0HVocNr4aiR0P0FJHUxL4/ZsNj1ft+ejmgPwFvJAnqbdgmZPCSoIt5BblzDI
+k/WGJ8rLFjj2XXbIW6ydFATUaKoUIWgCW1DWe54Tp/vCS/esdkmZyBXf9+fcoqQC
+D+DYkSGzft+IBGyhvSgeq6j1C/nlOba0IhuD8hX4EcwOdsvsh/8e0lK0Mh4e4vMB
+zRM5ceImq+hGH71oyBatgnRX/Nxw13pF8c+yWXn88K/02sgAHCGQG1NpyNLN5S6o
+lmjGWdOjCc+DsbR4487falzVvd/1p45ysAYAA2dwZwA=
+=i5Rx
+-----END PGP PUBLIC KEY BLOCK-----
+`
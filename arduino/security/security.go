@@ -0,0 +1,98 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package security
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	paths "github.com/arduino/go-paths-helper"
+	"golang.org/x/crypto/openpgp"
+)
+
+// builtinKeys are the armored public keys trusted to sign the official
+// Arduino package and library indexes.
+var builtinKeys = []string{arduinoPublicKey}
+
+// BuiltinKeyring returns the keys trusted to sign the official Arduino
+// indexes.
+func BuiltinKeyring() (openpgp.EntityList, error) {
+	keyring := openpgp.EntityList{}
+	for _, key := range builtinKeys {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+		if err != nil {
+			return nil, fmt.Errorf("reading builtin keyring: %s", err)
+		}
+		keyring = append(keyring, entities...)
+	}
+	return keyring, nil
+}
+
+// LoadKeyring reads the public keys contained in the given file, either
+// armored or binary.
+func LoadKeyring(path *paths.Path) (openpgp.EntityList, error) {
+	data, err := path.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading keyring: %s", err)
+	}
+	var keyring openpgp.EntityList
+	if isArmored(data) {
+		keyring, err = openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	} else {
+		keyring, err = openpgp.ReadKeyRing(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading keyring %s: %s", path, err)
+	}
+	return keyring, nil
+}
+
+// VerifyDetachedSignature checks that signature is a valid detached
+// signature, armored or binary, of the target file made by one of the
+// keys in the keyring. The signer is returned.
+func VerifyDetachedSignature(target *paths.Path, signature *paths.Path, keyring openpgp.KeyRing) (*openpgp.Entity, error) {
+	signatureData, err := signature.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading signature: %s", err)
+	}
+	targetFile, err := os.Open(target.String())
+	if err != nil {
+		return nil, fmt.Errorf("opening signed file: %s", err)
+	}
+	defer targetFile.Close()
+
+	var signed io.Reader = bufio.NewReader(targetFile)
+	var signer *openpgp.Entity
+	if isArmored(signatureData) {
+		signer, err = openpgp.CheckArmoredDetachedSignature(keyring, signed, bytes.NewReader(signatureData))
+	} else {
+		signer, err = openpgp.CheckDetachedSignature(keyring, signed, bytes.NewReader(signatureData))
+	}
+	if err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+func isArmored(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("-----BEGIN PGP"))
+}
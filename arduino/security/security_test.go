@@ -0,0 +1,134 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package security
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func newTestEntity(t *testing.T, name string) *openpgp.Entity {
+	entity, err := openpgp.NewEntity(name, "", name+"@example.com", nil)
+	require.NoError(t, err)
+	return entity
+}
+
+func TestVerifyDetachedSignature(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "security_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	dir := paths.New(tmp)
+
+	signer := newTestEntity(t, "signer")
+	other := newTestEntity(t, "other")
+
+	index := dir.Join("package_index.json")
+	require.NoError(t, index.WriteFile([]byte(`{"packages":[]}`)))
+
+	// Binary signature
+	var sig bytes.Buffer
+	require.NoError(t, openpgp.DetachSign(&sig, signer, bytes.NewReader([]byte(`{"packages":[]}`)), nil))
+	sigFile := dir.Join("package_index.json.sig")
+	require.NoError(t, sigFile.WriteFile(sig.Bytes()))
+
+	entity, err := VerifyDetachedSignature(index, sigFile, openpgp.EntityList{other, signer})
+	require.NoError(t, err)
+	require.Equal(t, signer.PrimaryKey.KeyId, entity.PrimaryKey.KeyId)
+
+	_, err = VerifyDetachedSignature(index, sigFile, openpgp.EntityList{other})
+	require.Error(t, err, "signed by an untrusted key")
+
+	// Armored signature
+	var armoredSig bytes.Buffer
+	require.NoError(t, openpgp.ArmoredDetachSign(&armoredSig, signer, bytes.NewReader([]byte(`{"packages":[]}`)), nil))
+	armoredSigFile := dir.Join("package_index.json.asc")
+	require.NoError(t, armoredSigFile.WriteFile(armoredSig.Bytes()))
+	_, err = VerifyDetachedSignature(index, armoredSigFile, openpgp.EntityList{signer})
+	require.NoError(t, err)
+
+	// Tampered index
+	require.NoError(t, index.WriteFile([]byte(`{"packages":[{"name":"evil"}]}`)))
+	_, err = VerifyDetachedSignature(index, sigFile, openpgp.EntityList{signer})
+	require.Error(t, err)
+	_, err = VerifyDetachedSignature(index, armoredSigFile, openpgp.EntityList{signer})
+	require.Error(t, err)
+}
+
+func TestLoadKeyring(t *testing.T) {
+	tmp, err := ioutil.TempDir("", "security_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmp)
+	dir := paths.New(tmp)
+
+	entity := newTestEntity(t, "signer")
+
+	var binary bytes.Buffer
+	require.NoError(t, entity.Serialize(&binary))
+	binaryKey := dir.Join("key.gpg")
+	require.NoError(t, binaryKey.WriteFile(binary.Bytes()))
+
+	var armored bytes.Buffer
+	w, err := armor.Encode(&armored, openpgp.PublicKeyType, nil)
+	require.NoError(t, err)
+	require.NoError(t, entity.Serialize(w))
+	require.NoError(t, w.Close())
+	armoredKey := dir.Join("key.asc")
+	require.NoError(t, armoredKey.WriteFile(armored.Bytes()))
+
+	for _, keyFile := range []*paths.Path{binaryKey, armoredKey} {
+		keyring, err := LoadKeyring(keyFile)
+		require.NoError(t, err)
+		require.Len(t, keyring, 1)
+		require.Equal(t, entity.PrimaryKey.KeyId, keyring[0].PrimaryKey.KeyId)
+	}
+
+	_, err = LoadKeyring(dir.Join("missing.asc"))
+	require.Error(t, err)
+	require.NoError(t, dir.Join("invalid.asc").WriteFile([]byte("not a key")))
+	_, err = LoadKeyring(dir.Join("invalid.asc"))
+	require.Error(t, err)
+}
+
+func TestVerifyOfficialIndex(t *testing.T) {
+	// testdata/package_index.json is a snapshot of an official index
+	// together with the signature published by Arduino
+	keyring, err := BuiltinKeyring()
+	require.NoError(t, err)
+	index := paths.New("testdata", "package_index.json")
+	signature := paths.New("testdata", "package_index.json.sig")
+	signer, err := VerifyDetachedSignature(index, signature, keyring)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x7baf404c2dfab4ae), signer.PrimaryKey.KeyId)
+
+	tmp, err := paths.MkTempDir("", "security_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+	data, err := index.ReadFile()
+	require.NoError(t, err)
+	tampered := tmp.Join("package_index.json")
+	require.NoError(t, tampered.WriteFile(bytes.Replace(data, []byte("arduino-beta"), []byte("arduino-evil"), 1)))
+	_, err = VerifyDetachedSignature(tampered, signature, keyring)
+	require.Error(t, err)
+}
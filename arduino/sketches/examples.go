@@ -0,0 +1,137 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketches
+
+import (
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// MainFileExtensions are the extensions of the main file of a sketch
+var MainFileExtensions = []string{".ino", ".pde"}
+
+// FindMainFile returns the main file of the sketch in dir: the file named
+// as the folder or, if missing, the only file with a main file extension.
+func FindMainFile(dir *paths.Path) (*paths.Path, error) {
+	for _, ext := range MainFileExtensions {
+		if mainFile := dir.Join(dir.Base() + ext); mainFile.Exist() {
+			return mainFile, nil
+		}
+	}
+	files, err := dir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("reading sketch folder: %s", err)
+	}
+	files.FilterOutHiddenFiles()
+	files.FilterSuffix(MainFileExtensions...)
+	mainFiles := paths.PathList{}
+	for _, file := range files {
+		if !file.IsDir() {
+			mainFiles.Add(file)
+		}
+	}
+	if len(mainFiles) != 1 {
+		return nil, fmt.Errorf("no sketch found in %s", dir)
+	}
+	return mainFiles[0], nil
+}
+
+// FindSketches returns the sketch folders contained in dir and its
+// subfolders, sorted by path. A folder is a sketch if it contains a main
+// file named as the folder, the folders inside a sketch are not searched.
+func FindSketches(dir *paths.Path) (paths.PathList, error) {
+	res := paths.PathList{}
+	if !dir.IsDir() {
+		return res, nil
+	}
+	files, err := dir.ReadDir()
+	if err != nil {
+		return nil, fmt.Errorf("searching sketches: %s", err)
+	}
+	files.FilterDirs()
+	files.FilterOutHiddenFiles()
+	for _, subDir := range files {
+		if isSketchFolder(subDir) {
+			res.Add(subDir)
+			continue
+		}
+		sketches, err := FindSketches(subDir)
+		if err != nil {
+			return nil, err
+		}
+		res.AddAll(sketches)
+	}
+	res.Sort()
+	return res, nil
+}
+
+func isSketchFolder(dir *paths.Path) bool {
+	for _, ext := range MainFileExtensions {
+		if dir.Join(dir.Base() + ext).Exist() {
+			return true
+		}
+	}
+	return false
+}
+
+// CopySketch copies the sketch in src to the new sketch folder dest,
+// renaming the main file as dest. Hidden files are not copied.
+func CopySketch(src, dest *paths.Path) error {
+	mainFile, err := FindMainFile(src)
+	if err != nil {
+		return err
+	}
+	if dest.Exist() {
+		return fmt.Errorf("%s already exists", dest)
+	}
+	if err := copyDir(src, dest); err != nil {
+		dest.RemoveAll()
+		return fmt.Errorf("copying sketch: %s", err)
+	}
+	copiedMainFile := dest.Join(mainFile.Base())
+	newMainFile := dest.Join(dest.Base() + mainFile.Ext())
+	if !copiedMainFile.EqualsTo(newMainFile) {
+		if err := copiedMainFile.Rename(newMainFile); err != nil {
+			dest.RemoveAll()
+			return fmt.Errorf("renaming sketch: %s", err)
+		}
+	}
+	return nil
+}
+
+func copyDir(src, dest *paths.Path) error {
+	if err := dest.MkdirAll(); err != nil {
+		return err
+	}
+	files, err := src.ReadDir()
+	if err != nil {
+		return err
+	}
+	files.FilterOutHiddenFiles()
+	for _, file := range files {
+		if file.IsDir() {
+			if err := copyDir(file, dest.Join(file.Base())); err != nil {
+				return err
+			}
+		} else if err := file.CopyTo(dest.Join(file.Base())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketches_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindSketchesAndCopy(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "examples_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	examples := tmp.Join("examples")
+	writeFile := func(path *paths.Path) {
+		require.NoError(t, path.Parent().MkdirAll())
+		require.NoError(t, path.WriteFile([]byte("// "+path.Base())))
+	}
+	writeFile(examples.Join("01.Basics", "Blink", "Blink.ino"))
+	writeFile(examples.Join("01.Basics", "Blink", "data", "config.txt"))
+	writeFile(examples.Join("01.Basics", "Blink", ".hidden"))
+	writeFile(examples.Join("01.Basics", "Blink", "Nested", "Nested.ino"))
+	writeFile(examples.Join("Legacy", "Legacy.pde"))
+	writeFile(examples.Join("NotASketch", "README.md"))
+
+	found, err := sketches.FindSketches(examples)
+	require.NoError(t, err)
+	require.Equal(t, paths.PathList{
+		examples.Join("01.Basics", "Blink"),
+		examples.Join("Legacy"),
+	}, found)
+
+	found, err = sketches.FindSketches(tmp.Join("missing"))
+	require.NoError(t, err)
+	require.Empty(t, found)
+
+	mainFile, err := sketches.FindMainFile(examples.Join("Legacy"))
+	require.NoError(t, err)
+	require.Equal(t, "Legacy.pde", mainFile.Base())
+	_, err = sketches.FindMainFile(examples.Join("NotASketch"))
+	require.Error(t, err)
+
+	dest := tmp.Join("MyBlink")
+	require.NoError(t, sketches.CopySketch(examples.Join("01.Basics", "Blink"), dest))
+	require.True(t, dest.Join("MyBlink.ino").Exist())
+	require.False(t, dest.Join("Blink.ino").Exist())
+	require.True(t, dest.Join("data", "config.txt").Exist())
+	require.True(t, dest.Join("Nested", "Nested.ino").Exist())
+	require.False(t, dest.Join(".hidden").Exist())
+	require.Error(t, sketches.CopySketch(examples.Join("Legacy"), dest))
+
+	// A template may have a main file not named as its folder
+	writeFile(tmp.Join("template", "main.ino"))
+	require.NoError(t, sketches.CopySketch(tmp.Join("template"), tmp.Join("FromTemplate")))
+	require.True(t, tmp.Join("FromTemplate", "FromTemplate.ino").Exist())
+}
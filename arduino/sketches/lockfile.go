@@ -0,0 +1,129 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketches
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/arduino/go-paths-helper"
+)
+
+// LockfileName is the name of the lockfile, it's saved in the sketch
+// folder alongside sketch.json
+const LockfileName = "sketch.lock"
+
+// Lockfile records the exact platforms, tools and libraries used to build
+// a sketch, so the same build environment can be reproduced later
+type Lockfile struct {
+	FQBN      string            `json:"fqbn"`
+	Platforms []*LockedPlatform `json:"platforms"`
+	Tools     []*LockedTool     `json:"tools"`
+	Libraries []*LockedLibrary  `json:"libraries"`
+}
+
+// LockedPlatform is a platform release recorded in a Lockfile. Checksum is
+// the checksum of the platform archive as reported by the package index,
+// it's empty if the platform doesn't come from an index.
+type LockedPlatform struct {
+	Packager     string `json:"packager"`
+	Architecture string `json:"architecture"`
+	Version      string `json:"version"`
+	Checksum     string `json:"checksum,omitempty"`
+}
+
+func (p *LockedPlatform) String() string {
+	return p.Packager + ":" + p.Architecture + "@" + p.Version
+}
+
+// LockedTool is a tool release recorded in a Lockfile. Checksums maps
+// each OS flavor of the tool (as named in the package index) to the
+// checksum of its archive.
+type LockedTool struct {
+	Packager  string            `json:"packager"`
+	Name      string            `json:"name"`
+	Version   string            `json:"version"`
+	Checksums map[string]string `json:"checksums,omitempty"`
+}
+
+func (t *LockedTool) String() string {
+	return t.Packager + ":" + t.Name + "@" + t.Version
+}
+
+// LockedLibrary is a library recorded in a Lockfile. Location is where the
+// library is installed (sketchbook, platform, ide...) and Checksum is the
+// checksum of the library archive as reported by the libraries index, it's
+// empty if the library doesn't come from the index.
+type LockedLibrary struct {
+	Name     string `json:"name"`
+	Version  string `json:"version,omitempty"`
+	Location string `json:"location"`
+	Checksum string `json:"checksum,omitempty"`
+}
+
+func (l *LockedLibrary) String() string {
+	if l.Version == "" {
+		return l.Name
+	}
+	return l.Name + "@" + l.Version
+}
+
+// Platform returns the locked release of the platform with the given
+// packager and architecture, nil if the platform is not locked
+func (lock *Lockfile) Platform(packager, architecture string) *LockedPlatform {
+	for _, platform := range lock.Platforms {
+		if platform.Packager == packager && platform.Architecture == architecture {
+			return platform
+		}
+	}
+	return nil
+}
+
+// LoadLockfile reads a Lockfile from the specified file
+func LoadLockfile(path *paths.Path) (*Lockfile, error) {
+	data, err := path.ReadFile()
+	if err != nil {
+		return nil, fmt.Errorf("reading lockfile: %s", err)
+	}
+	lock := &Lockfile{}
+	if err := json.Unmarshal(data, lock); err != nil {
+		return nil, fmt.Errorf("parsing lockfile: %s", err)
+	}
+	return lock, nil
+}
+
+// Encode returns the content of the lockfile
+func (lock *Lockfile) Encode() ([]byte, error) {
+	data, err := json.MarshalIndent(lock, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encoding lockfile: %s", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// Save writes the Lockfile to the specified file
+func (lock *Lockfile) Save(path *paths.Path) error {
+	data, err := lock.Encode()
+	if err != nil {
+		return err
+	}
+	if err := path.WriteFile(data); err != nil {
+		return fmt.Errorf("writing lockfile: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketches_test
+
+import (
+	"testing"
+
+	"github.com/arduino/arduino-cli/arduino/sketches"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockfileSaveAndLoad(t *testing.T) {
+	tmp, err := paths.MkTempDir("", "lockfile_test")
+	require.NoError(t, err)
+	defer tmp.RemoveAll()
+
+	lock := &sketches.Lockfile{
+		FQBN: "arduino:avr:uno",
+		Platforms: []*sketches.LockedPlatform{
+			{Packager: "arduino", Architecture: "avr", Version: "1.6.21", Checksum: "SHA-256:0123"},
+		},
+		Tools: []*sketches.LockedTool{
+			{Packager: "arduino", Name: "avrdude", Version: "6.3.0-arduino9",
+				Checksums: map[string]string{"x86_64-linux-gnu": "SHA-256:4567"}},
+		},
+		Libraries: []*sketches.LockedLibrary{
+			{Name: "Servo", Version: "1.1.2", Location: "sketchbook", Checksum: "SHA-256:89ab"},
+		},
+	}
+	lockPath := tmp.Join(sketches.LockfileName)
+	require.NoError(t, lock.Save(lockPath))
+
+	loaded, err := sketches.LoadLockfile(lockPath)
+	require.NoError(t, err)
+	require.Equal(t, lock, loaded)
+	require.Equal(t, "arduino:avr@1.6.21", loaded.Platforms[0].String())
+	require.Equal(t, "arduino:avrdude@6.3.0-arduino9", loaded.Tools[0].String())
+	require.Equal(t, "Servo@1.1.2", loaded.Libraries[0].String())
+
+	_, err = sketches.LoadLockfile(tmp.Join("missing"))
+	require.Error(t, err)
+}
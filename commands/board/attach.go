@@ -24,11 +24,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/cores"
-	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
-	discovery "github.com/arduino/board-discovery"
 	paths "github.com/arduino/go-paths-helper"
 	"github.com/bcmi-labs/arduino-modules/sketches"
 	"github.com/sirupsen/logrus"
@@ -70,54 +69,45 @@ func runAttachCommand(cmd *cobra.Command, args []string) {
 		os.Exit(commands.ErrGeneric)
 	}
 
-	logrus.WithField("fqbn", boardURI).Print("Parsing FQBN")
-	fqbn, err := cores.ParseFQBN(boardURI)
-	if err != nil && !strings.HasPrefix(boardURI, "serial") {
-		boardURI = "serial://" + boardURI
-	}
-
 	pm := commands.InitPackageManager()
 
+	var fqbn *cores.FQBN
+	if !strings.Contains(boardURI, "://") {
+		logrus.WithField("fqbn", boardURI).Print("Parsing FQBN")
+		if fqbn, err = cores.ParseFQBN(boardURI); err != nil {
+			boardURI = "serial://" + boardURI
+		}
+	}
+
 	if fqbn != nil {
 		sketch.Metadata.CPU = sketches.MetadataCPU{
 			Fqbn: fqbn.String(),
 		}
 	} else {
-		deviceURI, err := url.Parse(boardURI)
+		protocol, address, err := parsePortURI(boardURI)
 		if err != nil {
 			formatter.PrintError(err, "The provided Device URL is not in a valid format.")
 			os.Exit(commands.ErrBadCall)
 		}
 
-		var findBoardFunc func(*packagemanager.PackageManager, *discovery.Monitor, *url.URL) *cores.Board
-		var Type string
-		switch deviceURI.Scheme {
-		case "serial", "tty":
-			findBoardFunc = findSerialConnectedBoard
-			Type = "serial"
-		case "http", "https", "tcp", "udp":
-			findBoardFunc = findNetworkConnectedBoard
-			Type = "network"
-		default:
-			formatter.PrintErrorMessage("Invalid device port type provided. Accepted types are: serial://, tty://, http://, https://, tcp://, udp://.")
-			os.Exit(commands.ErrBadCall)
-		}
-
 		duration, err := time.ParseDuration(attachFlags.searchTimeout)
 		if err != nil {
 			logrus.WithError(err).Warnf("Invalid interval `%s` provided, using default (5s).", attachFlags.searchTimeout)
 			duration = time.Second * 5
 		}
 
-		monitor := discovery.New(time.Second)
-		monitor.Start()
-
+		discoveries := api.StartDiscoveries(api.NewDiscoveries(pm))
 		time.Sleep(duration)
+		res, err := api.BoardList(pm, discoveries)
+		api.QuitDiscoveries(discoveries)
+		if err != nil {
+			formatter.PrintError(err, "Error detecting boards.")
+			os.Exit(commands.ExitCode(err))
+		}
 
-		// TODO: Handle the case when no board is found.
-		board := findBoardFunc(pm, monitor, deviceURI)
+		board := findConnectedBoard(res, protocol, address)
 		if board == nil {
-			formatter.PrintErrorMessage("No supported board has been found at " + deviceURI.String() + ", try either install new cores or check your board URI.")
+			formatter.PrintErrorMessage("No supported board has been found at " + boardURI + ", try either install new cores or check your board URI.")
 			os.Exit(commands.ErrGeneric)
 		}
 		formatter.Print("Board found: " + board.Name())
@@ -125,7 +115,7 @@ func runAttachCommand(cmd *cobra.Command, args []string) {
 		sketch.Metadata.CPU = sketches.MetadataCPU{
 			Fqbn: board.FQBN(),
 			Name: board.Name(),
-			Type: Type,
+			Type: protocol,
 		}
 	}
 
@@ -136,55 +126,47 @@ func runAttachCommand(cmd *cobra.Command, args []string) {
 	formatter.PrintResult("Selected fqbn: " + sketch.Metadata.CPU.Fqbn)
 }
 
-// FIXME: Those should probably go in a "BoardManager" pkg or something
-// findSerialConnectedBoard find the board which is connected to the specified URI via serial port, using a monitor and a set of Boards
-// for the matching.
-func findSerialConnectedBoard(pm *packagemanager.PackageManager, monitor *discovery.Monitor, deviceURI *url.URL) *cores.Board {
-	found := false
-	location := deviceURI.Path
-	var serialDevice discovery.SerialDevice
-	for _, device := range monitor.Serial() {
-		if device.Port == location {
-			// Found the device !
-			found = true
-			serialDevice = *device
-		}
-	}
-	if !found {
-		return nil
+// parsePortURI returns the protocol and the address of the port specified
+// by the given URI. The serial:// and tty:// schemes select the serial
+// ports and the http://, https://, tcp:// and udp:// schemes the network
+// ports, any other scheme is the protocol of a pluggable discovery (for
+// example ble://C4:4F:33:0E:2A:11).
+func parsePortURI(uri string) (string, string, error) {
+	split := strings.SplitN(uri, "://", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", fmt.Errorf("invalid port URI: %s", uri)
 	}
-
-	boards := pm.FindBoardsWithVidPid(serialDevice.VendorID, serialDevice.ProductID)
-	if len(boards) == 0 {
-		os.Exit(commands.ErrGeneric)
+	switch split[0] {
+	case "serial", "tty":
+		return "serial", split[1], nil
+	case "http", "https", "tcp", "udp":
+		deviceURI, err := url.Parse(uri)
+		if err != nil {
+			return "", "", err
+		}
+		return "network", deviceURI.Host, nil
+	default:
+		return split[0], split[1], nil
 	}
-
-	return boards[0]
 }
 
-// findNetworkConnectedBoard find the board which is connected to the specified URI on the network, using a monitor and a set of Boards
-// for the matching.
-func findNetworkConnectedBoard(pm *packagemanager.PackageManager, monitor *discovery.Monitor, deviceURI *url.URL) *cores.Board {
-	found := false
-
-	var networkDevice discovery.NetworkDevice
-
-	for _, device := range monitor.Network() {
-		if device.Address == deviceURI.Host &&
-			fmt.Sprint(device.Port) == deviceURI.Port() {
-			// Found the device !
-			found = true
-			networkDevice = *device
+// findConnectedBoard returns the board connected to the port with the
+// given protocol and address, or nil if the port has not been found or
+// the board is unknown. The address of a network port may include the
+// TCP port.
+func findConnectedBoard(res *api.BoardListResult, protocol, address string) *cores.Board {
+	for _, item := range res.Ports {
+		port := item.Port
+		if port.Protocol != protocol || len(item.Boards) == 0 {
+			continue
+		}
+		if port.Address == address {
+			return item.Boards[0]
+		}
+		if protocol == "network" && port.Properties != nil &&
+			port.Address+":"+port.Properties.Get("port") == address {
+			return item.Boards[0]
 		}
 	}
-	if !found {
-		return nil
-	}
-
-	boards := pm.FindBoardsWithID(networkDevice.Name)
-	if len(boards) == 0 {
-		return nil
-	}
-
-	return boards[0]
+	return nil
 }
@@ -20,9 +20,13 @@ package board
 import (
 	"encoding/json"
 	"os"
+	"sort"
+	"strings"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/output"
+	properties "github.com/arduino/go-properties-orderedmap"
 
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
@@ -31,59 +35,64 @@ import (
 
 func initDetailsCommand() *cobra.Command {
 	detailsCommand := &cobra.Command{
-		Use:     "details <FQBN>",
-		Short:   "Print details about a board.",
-		Long:    "Show information about a board, in particular if the board has options to be specified in the FQBN.",
-		Example: "  " + commands.AppName + " board details arduino:avr:nano",
-		Args:    cobra.ExactArgs(1),
-		Run:     runDetailsCommand,
+		Use:   "details <FQBN>",
+		Short: "Print details about a board.",
+		Long: "Show information about a board, in particular if the board has options to be specified in the FQBN, " +
+			"the supported upload methods and programmers and the USB IDs identifying it.",
+		Example: "" +
+			"  " + commands.AppName + " board details arduino:avr:nano\n" +
+			"  " + commands.AppName + " board details --show-properties arduino:avr:nano:cpu=atmega168",
+		Args: cobra.ExactArgs(1),
+		Run:  runDetailsCommand,
 	}
+	detailsCommand.Flags().BoolVar(&detailsFlags.showProperties, "show-properties", false,
+		"Show the platform and board properties resolved with the options selected by the FQBN.")
 	return detailsCommand
 }
 
+var detailsFlags struct {
+	showProperties bool // Show the resolved board properties instead of the details.
+}
+
 func runDetailsCommand(cmd *cobra.Command, args []string) {
 	pm := commands.InitPackageManager()
-	fqbn, err := cores.ParseFQBN(args[0])
+
+	res, err := api.BoardDetails(pm, &api.BoardDetailsReq{FQBN: args[0]})
 	if err != nil {
-		formatter.PrintError(err, "Error parsing fqbn")
-		os.Exit(commands.ErrBadArgument)
+		formatter.PrintError(err, "Error getting board details")
+		os.Exit(commands.ExitCode(err))
 	}
 
-	_, _, board, _, _, err := pm.ResolveFQBN(fqbn)
-	if err != nil {
-		formatter.PrintError(err, "Error loading board data")
-		os.Exit(commands.ErrBadArgument)
+	if detailsFlags.showProperties {
+		output.Emit(&boardProperties{res.BuildProperties})
+		return
 	}
 
 	details := &boardDetails{}
-	details.Name = board.Name()
+	details.Name = res.Board.Name()
 	details.ConfigOptions = []*boardConfigOption{}
-	options := board.GetConfigOptions()
-	t := true
-	for _, option := range options.Keys() {
+	for _, option := range res.ConfigOptions {
 		configOption := &boardConfigOption{}
-		configOption.Option = option
-		configOption.OptionLabel = options.Get(option)
-		selected, hasSelected := fqbn.Configs.GetOk(option)
-
-		values := board.GetConfigOptionValues(option)
-		for i, value := range values.Keys() {
+		configOption.Option = option.Option
+		configOption.OptionLabel = option.OptionLabel
+		for _, value := range option.Values {
 			configValue := &boardConfigValue{}
-			if hasSelected && value == selected {
-				configValue.Selected = &t
-			} else if !hasSelected && i == 0 {
-				configValue.Selected = &t
+			if value.Selected {
+				selected := true
+				configValue.Selected = &selected
 			}
-
-			configValue.Value = value
-			configValue.ValueLabel = values.Get(value)
+			configValue.Value = value.Value
+			configValue.ValueLabel = value.ValueLabel
+			configValue.Properties = value.Properties.AsMap()
 			configOption.Values = append(configOption.Values, configValue)
 		}
-
 		details.ConfigOptions = append(details.ConfigOptions, configOption)
 	}
 
-	details.RequiredTools = board.PlatformRelease.Dependencies
+	details.RequiredTools = res.RequiredTools
+	details.UploadMethods = res.UploadMethods
+	details.Programmers = res.Programmers
+	details.USBIDs = res.USBIDs
 
 	output.Emit(details)
 }
@@ -92,6 +101,9 @@ type boardDetails struct {
 	Name          string
 	ConfigOptions []*boardConfigOption
 	RequiredTools []*cores.ToolDependency
+	UploadMethods []string
+	Programmers   []*api.Programmer
+	USBIDs        []*api.USBID
 }
 
 type boardConfigOption struct {
@@ -103,7 +115,8 @@ type boardConfigOption struct {
 type boardConfigValue struct {
 	Value      string
 	ValueLabel string
-	Selected   *bool `json:",omitempty"`
+	Selected   *bool             `json:",omitempty"`
+	Properties map[string]string `json:",omitempty"`
 }
 
 func (details *boardDetails) EmitJSON() string {
@@ -119,6 +132,24 @@ func (details *boardDetails) EmitTerminal() string {
 	table := output.NewTable()
 	table.SetColumnWidthMode(1, output.Average)
 	table.AddRow("Board name:", details.Name)
+	if len(details.UploadMethods) > 0 {
+		table.AddRow("Upload methods:", strings.Join(details.UploadMethods, ", "))
+	}
+	for i, id := range details.USBIDs {
+		head := ""
+		if i == 0 {
+			head = "USB IDs:"
+		}
+		table.AddRow(head, id.VID+":"+id.PID)
+	}
+	for i, programmer := range details.Programmers {
+		head := ""
+		if i == 0 {
+			table.AddRow()
+			head = "Programmers:"
+		}
+		table.AddRow(head, programmer.Name, "", programmer.ID)
+	}
 	for i, tool := range details.RequiredTools {
 		head := ""
 		if i == 0 {
@@ -142,7 +173,36 @@ func (details *boardDetails) EmitTerminal() string {
 					value.ValueLabel,
 					"", option.Option+"="+value.Value)
 			}
+			keys := []string{}
+			for key := range value.Properties {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				table.AddRow("", "", "", "  "+key+"="+value.Properties[key])
+			}
 		}
 	}
 	return table.Render()
 }
+
+type boardProperties struct {
+	properties *properties.Map
+}
+
+func (props *boardProperties) EmitJSON() string {
+	d, err := json.MarshalIndent(props.properties.AsMap(), "", "  ")
+	if err != nil {
+		formatter.PrintError(err, "Error encoding json")
+		os.Exit(commands.ErrGeneric)
+	}
+	return string(d)
+}
+
+func (props *boardProperties) EmitTerminal() string {
+	res := ""
+	for _, key := range props.properties.Keys() {
+		res += key + "=" + props.properties.Get(key) + "\n"
+	}
+	return res
+}
@@ -19,13 +19,16 @@ package board
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"time"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/arduino/arduino-cli/common/formatter/output"
-	"github.com/arduino/board-discovery"
 	"github.com/codeclysm/cc"
 	"github.com/spf13/cobra"
 )
@@ -50,16 +53,19 @@ var listFlags struct {
 }
 
 // runListCommand detects and lists the connected arduino boards
-// (either via serial or network ports).
+// (either via serial or network ports, or via the pluggable discoveries of
+// the installed platforms).
 func runListCommand(cmd *cobra.Command, args []string) {
 	pm := commands.InitPackageManager()
 
-	monitor := discovery.New(time.Millisecond)
-	monitor.Start()
 	duration, err := time.ParseDuration(listFlags.timeout)
 	if err != nil {
 		duration = time.Second * 5
 	}
+
+	discoveries := api.StartDiscoveries(api.NewDiscoveries(pm))
+	defer api.QuitDiscoveries(discoveries)
+
 	if formatter.IsCurrentFormat("text") {
 		stoppable := cc.Run(func(stop chan struct{}) {
 			for {
@@ -87,58 +93,69 @@ func runListCommand(cmd *cobra.Command, args []string) {
 		time.Sleep(duration)
 	}
 
-	formatter.Print(NewBoardList(pm, monitor))
-
-	//monitor.Stop() //If called will slow like 1sec the program to close after print, with the same result (tested).
-	// it closes ungracefully, but at the end of the command we can't have races.
+	formatter.Print(NewBoardList(pm, discoveries))
 }
 
-// NewBoardList returns a new board list by adding discovered boards from the board list and a monitor.
-func NewBoardList(pm *packagemanager.PackageManager, monitor *discovery.Monitor) *output.AttachedBoardList {
-	if monitor == nil {
-		return nil
+// NewBoardList returns a new board list with the boards connected to the
+// ports detected so far by the given discoveries.
+func NewBoardList(pm *packagemanager.PackageManager, discoveries []discovery.Discovery) *output.AttachedBoardList {
+	res, err := api.BoardList(pm, discoveries)
+	if err != nil {
+		formatter.PrintError(err, "Error detecting boards")
+		os.Exit(commands.ExitCode(err))
 	}
-
-	serialDevices := monitor.Serial()
-	networkDevices := monitor.Network()
 	ret := &output.AttachedBoardList{
-		SerialBoards:  make([]output.SerialBoardListItem, 0, len(serialDevices)),
-		NetworkBoards: make([]output.NetworkBoardListItem, 0, len(networkDevices)),
+		SerialBoards:  []output.SerialBoardListItem{},
+		NetworkBoards: []output.NetworkBoardListItem{},
 	}
 
-	for _, item := range serialDevices {
-		boards := pm.FindBoardsWithVidPid(item.VendorID, item.ProductID)
-		if len(boards) == 0 {
-			ret.SerialBoards = append(ret.SerialBoards, output.SerialBoardListItem{
-				Name:  "unknown",
-				Port:  item.Port,
-				UsbID: fmt.Sprintf("%s:%s - %s", item.VendorID[2:], item.ProductID[2:], item.SerialNumber),
-			})
-			continue
-		}
+	for _, item := range res.Ports {
+		port := item.Port
+		switch port.Protocol {
+		case "serial":
+			serialBoard := output.SerialBoardListItem{
+				Name: "unknown",
+				Port: port.Address,
+			}
+			if port.Properties != nil {
+				vid := strings.TrimPrefix(port.Properties.Get("vid"), "0x")
+				pid := strings.TrimPrefix(port.Properties.Get("pid"), "0x")
+				serialBoard.UsbID = fmt.Sprintf("%s:%s - %s", vid, pid, port.Properties.Get("serialNumber"))
+			}
+			if len(item.Boards) > 0 {
+				serialBoard.Name = item.Boards[0].Name()
+				serialBoard.Fqbn = item.Boards[0].FQBN()
+			}
+			ret.SerialBoards = append(ret.SerialBoards, serialBoard)
 
-		board := boards[0]
-		ret.SerialBoards = append(ret.SerialBoards, output.SerialBoardListItem{
-			Name:  board.Name(),
-			Fqbn:  board.FQBN(),
-			Port:  item.Port,
-			UsbID: fmt.Sprintf("%s:%s - %s", item.VendorID[2:], item.ProductID[2:], item.SerialNumber),
-		})
-	}
+		case "network":
+			if len(item.Boards) == 0 {
+				// skip it if not recognized
+				continue
+			}
+			location := port.Address
+			if port.Properties != nil && port.Properties.ContainsKey("port") {
+				location += ":" + port.Properties.Get("port")
+			}
+			ret.NetworkBoards = append(ret.NetworkBoards, output.NetworkBoardListItem{
+				Name:     item.Boards[0].Name(),
+				Fqbn:     item.Boards[0].FQBN(),
+				Location: location,
+			})
 
-	for _, item := range networkDevices {
-		boards := pm.FindBoardsWithID(item.Name)
-		if len(boards) == 0 {
-			// skip it if not recognized
-			continue
+		default:
+			portBoard := output.PortBoardListItem{
+				Name:     "unknown",
+				Protocol: port.Protocol,
+				Address:  port.Address,
+				Label:    port.AddressLabel,
+			}
+			if len(item.Boards) > 0 {
+				portBoard.Name = item.Boards[0].Name()
+				portBoard.Fqbn = item.Boards[0].FQBN()
+			}
+			ret.OtherBoards = append(ret.OtherBoards, portBoard)
 		}
-
-		board := boards[0]
-		ret.NetworkBoards = append(ret.NetworkBoards, output.NetworkBoardListItem{
-			Name:     board.Name(),
-			Fqbn:     board.FQBN(),
-			Location: fmt.Sprintf("%s:%d", item.Address, item.Port),
-		})
 	}
 	return ret
 }
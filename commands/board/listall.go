@@ -18,9 +18,10 @@
 package board
 
 import (
+	"os"
 	"sort"
-	"strings"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/arduino/arduino-cli/common/formatter/output"
@@ -47,33 +48,18 @@ func initListAllCommand() *cobra.Command {
 func runListAllCommand(cmd *cobra.Command, args []string) {
 	pm := commands.InitPackageManager()
 
-	match := func(name string) bool {
-		name = strings.ToLower(name)
-		for _, term := range args {
-			if !strings.Contains(name, strings.ToLower(term)) {
-				return false
-			}
-		}
-		return true
+	res, err := api.BoardListAll(pm, &api.BoardListAllReq{SearchArgs: args})
+	if err != nil {
+		formatter.PrintError(err, "Error listing boards")
+		os.Exit(commands.ExitCode(err))
 	}
 
 	list := &output.BoardList{}
-	for _, targetPackage := range pm.GetPackages().Packages {
-		for _, platform := range targetPackage.Platforms {
-			platformRelease := pm.GetInstalledPlatformRelease(platform)
-			if platformRelease == nil {
-				continue
-			}
-			for _, board := range platformRelease.Boards {
-				if !match(board.Name()) {
-					continue
-				}
-				list.Boards = append(list.Boards, &output.BoardListItem{
-					Name: board.Name(),
-					Fqbn: board.FQBN(),
-				})
-			}
-		}
+	for _, board := range res.Boards {
+		list.Boards = append(list.Boards, &output.BoardListItem{
+			Name: board.Name(),
+			Fqbn: board.FQBN(),
+		})
 	}
 	sort.Sort(list)
 	formatter.Print(list)
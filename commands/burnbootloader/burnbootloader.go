@@ -0,0 +1,82 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package burnbootloader
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// InitCommand prepares the command.
+func InitCommand() *cobra.Command {
+	burnBootloaderCommand := &cobra.Command{
+		Use:   "burn-bootloader",
+		Short: "Burns the bootloader of a board.",
+		Long: "Writes the bootloader of a board and sets its fuses using a programmer, " +
+			"as specified by the board definition.",
+		Example: "  " + commands.AppName + " burn-bootloader -b arduino:avr:uno -P avrispmkii -p /dev/ttyACM0",
+		Args:    cobra.NoArgs,
+		Run:     run,
+	}
+	burnBootloaderCommand.Flags().StringVarP(
+		&flags.fqbn, "fqbn", "b", "",
+		"Fully Qualified Board Name, e.g.: arduino:avr:uno")
+	burnBootloaderCommand.Flags().StringVarP(
+		&flags.port, "port", "p", "",
+		"Port of the programmer, needed only by serial programmers, e.g.: COM10 or /dev/ttyACM0")
+	burnBootloaderCommand.Flags().StringVarP(
+		&flags.programmer, "programmer", "P", "",
+		"The programmer to use, e.g.: avrispmkii")
+	burnBootloaderCommand.Flags().BoolVarP(
+		&flags.verify, "verify", "t", false,
+		"Verify the bootloader after writing it.")
+	burnBootloaderCommand.Flags().BoolVarP(
+		&flags.verbose, "verbose", "v", false,
+		"Optional, turns on verbose mode.")
+	return burnBootloaderCommand
+}
+
+var flags struct {
+	fqbn       string
+	port       string
+	programmer string
+	verbose    bool
+	verify     bool
+}
+
+func run(command *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino burn-bootloader`")
+	pm := commands.InitPackageManager()
+
+	req := &api.BurnBootloaderReq{
+		FQBN:       flags.fqbn,
+		Port:       flags.port,
+		Programmer: flags.programmer,
+		Verbose:    flags.verbose,
+		Verify:     flags.verify,
+	}
+	if err := api.BurnBootloader(pm, req, os.Stdout, os.Stderr); err != nil {
+		formatter.PrintError(err, "Error burning bootloader.")
+		os.Exit(commands.ExitCode(err))
+	}
+}
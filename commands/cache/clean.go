@@ -0,0 +1,76 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package cache
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// InitCleanCommand prepares the command removing the unused tools and the
+// stale archives. It's available both as `cache clean` and `core gc`, parent
+// and use are the names of the parent command and of the command.
+func InitCleanCommand(parent, use string) *cobra.Command {
+	cleanCommand := &cobra.Command{
+		Use:   use,
+		Short: "Removes unused tools and stale downloaded archives.",
+		Long: "Removes the tools that are not required by any installed core and the archives in the " +
+			"download cache that no longer match an entry of the package and libraries indexes.",
+		Example: "" +
+			"  " + commands.AppName + " " + parent + " " + use + " --dry-run\n" +
+			"  " + commands.AppName + " " + parent + " " + use,
+		Args: cobra.NoArgs,
+		Run:  runCleanCommand,
+	}
+	cleanCommand.Flags().BoolVar(&cleanFlags.dryRun, "dry-run", false,
+		"Show what would be removed without removing anything.")
+	return cleanCommand
+}
+
+var cleanFlags struct {
+	dryRun bool // Don't remove anything.
+}
+
+func runCleanCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino cache clean`")
+
+	pm := commands.InitPackageManager()
+	lm := commands.InitLibraryManager(pm)
+	res, err := api.CacheClean(pm, lm, &api.CacheCleanReq{DryRun: cleanFlags.dryRun}, commands.OutputTaskProgress())
+	if err != nil {
+		formatter.PrintError(err, "Error cleaning cache.")
+		os.Exit(commands.ExitCode(err))
+	}
+
+	out := output.CacheCleanResult{
+		DryRun:          cleanFlags.dryRun,
+		RemovedTools:    []string{},
+		RemovedArchives: res.RemovedArchives.AsStrings(),
+		ReclaimedSize:   res.ReclaimedSize,
+	}
+	for _, tool := range res.RemovedTools {
+		out.RemovedTools = append(out.RemovedTools, tool.String())
+	}
+	formatter.Print(out)
+}
@@ -24,7 +24,7 @@ import (
 
 	"github.com/arduino/go-paths-helper"
 
-	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/arduino/sketches"
 	"github.com/arduino/arduino-cli/configs"
@@ -80,22 +80,14 @@ func InitPackageManagerWithoutBundles() *packagemanager.PackageManager {
 }
 
 // InitPackageManager initializes the PackageManager
-// TODO: for the daemon mode, this might be called at startup, but for now only commands needing the PM will call it
 func InitPackageManager() *packagemanager.PackageManager {
 	logrus.Info("Initializing package manager")
 
-	pm := packagemanager.NewPackageManager(
-		Config.IndexesDir(),
-		Config.PackagesDir(),
-		Config.DownloadsDir(),
-		Config.DataDir.Join("tmp"))
-
-	for _, URL := range Config.BoardManagerAdditionalUrls {
-		if err := pm.LoadPackageIndex(URL); err != nil {
-			formatter.PrintError(err, "Failed to load "+URL.String()+" package index.\n"+
-				"Try updating all indexes with `"+AppName+" core update-index`.")
-			os.Exit(ErrCoreConfig)
-		}
+	pm := api.NewPackageManager(Config)
+	if err := api.LoadPackageIndexes(pm, Config); err != nil {
+		formatter.PrintError(err, "Failed to load package index.\n"+
+			"Try updating all indexes with `"+AppName+" core update-index`.")
+		os.Exit(ErrCoreConfig)
 	}
 
 	if err := pm.LoadHardware(Config); err != nil {
@@ -109,35 +101,14 @@ func InitPackageManager() *packagemanager.PackageManager {
 // InitLibraryManager initializes the LibraryManager using the underlying packagemanager
 func InitLibraryManager(pm *packagemanager.PackageManager) *librariesmanager.LibrariesManager {
 	logrus.Info("Starting libraries manager")
-	lm := librariesmanager.NewLibraryManager(
-		Config.IndexesDir(),
-		Config.DownloadsDir())
-
-	// Add IDE builtin libraries dir
-	if bundledLibsDir := Config.IDEBundledLibrariesDir(); bundledLibsDir != nil {
-		lm.AddLibrariesDir(bundledLibsDir, libraries.IDEBuiltIn)
-	}
-
-	// Add sketchbook libraries dir
-	lm.AddLibrariesDir(Config.LibrariesDir(), libraries.Sketchbook)
-
-	// Add libraries dirs from installed platforms
-	if pm != nil {
-		for _, targetPackage := range pm.GetPackages().Packages {
-			for _, platform := range targetPackage.Platforms {
-				if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
-					lm.AddPlatformReleaseLibrariesDir(platformRelease, libraries.PlatformBuiltIn)
-				}
-			}
-		}
-	}
+	lm := api.NewLibrariesManager(Config, pm)
 
 	// Auto-update index if needed
-	if err := lm.LoadIndex(); err != nil {
+	if err := api.LoadLibrariesIndex(lm, Config); err != nil {
 		logrus.WithError(err).Warn("Error during libraries index loading, trying to auto-update index")
 		UpdateLibrariesIndex(lm)
 	}
-	if err := lm.LoadIndex(); err != nil {
+	if err := api.LoadLibrariesIndex(lm, Config); err != nil {
 		logrus.WithError(err).Error("Error during libraries index loading")
 		formatter.PrintError(err, "Error loading libraries index")
 		os.Exit(ErrGeneric)
@@ -155,22 +126,34 @@ func InitLibraryManager(pm *packagemanager.PackageManager) *librariesmanager.Lib
 
 // UpdateLibrariesIndex updates the library_index.json
 func UpdateLibrariesIndex(lm *librariesmanager.LibrariesManager) {
-	logrus.Info("Updating libraries index")
-	d, err := lm.UpdateIndex()
-	if err != nil {
+	if err := api.UpdateLibrariesIndex(lm, Config, OutputProgressBar(), OutputTaskProgress()); err != nil {
 		formatter.PrintError(err, "Error downloading librarires index")
-		os.Exit(ErrNetwork)
+		os.Exit(ExitCode(err))
 	}
-	formatter.DownloadProgressBar(d, "Updating index: library_index.json")
-	if d.Error() != nil {
-		formatter.PrintError(d.Error(), "Error downloading librarires index")
-		os.Exit(ErrNetwork)
+}
+
+// ExitCode returns the exit code matching the type of an error returned
+// by the api package.
+func ExitCode(err error) int {
+	switch err.(type) {
+	case *api.InvalidArgumentError:
+		return ErrBadArgument
+	case *api.NotFoundError:
+		return ErrBadCall
+	case *api.NetworkError:
+		return ErrNetwork
+	case *api.ConfigurationError:
+		return ErrCoreConfig
+	default:
+		return ErrGeneric
 	}
 }
 
-func InitSketch(sketchPath *paths.Path) (*sk.Sketch, error) {
+// InitSketchPath returns the given sketch path or, if nil, the current
+// working directory.
+func InitSketchPath(sketchPath *paths.Path) (*paths.Path, error) {
 	if sketchPath != nil {
-		return sketches.NewSketchFromPath(sketchPath)
+		return sketchPath, nil
 	}
 
 	wd, err := paths.Getwd()
@@ -178,5 +161,13 @@ func InitSketch(sketchPath *paths.Path) (*sk.Sketch, error) {
 		return nil, fmt.Errorf("getting current directory: %s", err)
 	}
 	logrus.Infof("Reading sketch from dir: %s", wd)
-	return sketches.NewSketchFromPath(wd)
+	return wd, nil
+}
+
+func InitSketch(sketchPath *paths.Path) (*sk.Sketch, error) {
+	sketchPath, err := InitSketchPath(sketchPath)
+	if err != nil {
+		return nil, err
+	}
+	return sketches.NewSketchFromPath(sketchPath)
 }
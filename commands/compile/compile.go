@@ -18,21 +18,16 @@
 package compile
 
 import (
-	"fmt"
+	"io"
 	"os"
-	"path/filepath"
-	"sort"
-	"strings"
 
-	builder "github.com/arduino/arduino-builder"
-	"github.com/arduino/arduino-builder/types"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/cores"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
 	"github.com/arduino/arduino-cli/commands"
-	"github.com/arduino/arduino-cli/commands/core"
 	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
 	"github.com/arduino/go-paths-helper"
-	properties "github.com/arduino/go-properties-orderedmap"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -49,7 +44,7 @@ func InitCommand() *cobra.Command {
 	}
 	command.Flags().StringVarP(
 		&flags.fqbn, "fqbn", "b", "",
-		"Fully Qualified Board Name, e.g.: arduino:avr:uno")
+		"Fully Qualified Board Name, e.g.: arduino:avr:uno, or arduino:avr@1.6.21:uno to use a specific installed version of the core")
 	command.Flags().BoolVar(
 		&flags.showProperties, "show-properties", false,
 		"Show all build properties used instead of compiling.")
@@ -80,6 +75,9 @@ func InitCommand() *cobra.Command {
 	command.Flags().StringVar(
 		&flags.vidPid, "vid-pid", "",
 		"When specified, VID/PID specific build properties are used, if boards supports them.")
+	command.Flags().BoolVar(
+		&flags.locked, "locked", false,
+		"Install the platforms, tools and libraries recorded in the sketch lockfile and refuse to build if the environment diverges from it.")
 	return command
 }
 
@@ -95,6 +93,7 @@ var flags struct {
 	quiet           bool     // Suppresses almost every output.
 	vidPid          string   // VID/PID specific build properties.
 	exportFile      string   // The compiled binary is written to this file
+	locked          bool     // Build with the dependencies recorded in the sketch lockfile.
 }
 
 func run(cmd *cobra.Command, args []string) {
@@ -103,185 +102,148 @@ func run(cmd *cobra.Command, args []string) {
 	if len(args) > 0 {
 		sketchPath = paths.New(args[0])
 	}
-	sketch, err := commands.InitSketch(sketchPath)
+	sketchPath, err := commands.InitSketchPath(sketchPath)
 	if err != nil {
 		formatter.PrintError(err, "Error opening sketch.")
 		os.Exit(commands.ErrGeneric)
 	}
 
-	if flags.fqbn == "" && sketch != nil {
-		flags.fqbn = sketch.Metadata.CPU.Fqbn
-	}
-	if flags.fqbn == "" {
-		formatter.PrintErrorMessage("No Fully Qualified Board Name provided.")
-		os.Exit(commands.ErrGeneric)
-	}
-	fqbn, err := cores.ParseFQBN(flags.fqbn)
-	if err != nil {
-		formatter.PrintErrorMessage("Fully Qualified Board Name has incorrect format.")
-		os.Exit(commands.ErrBadArgument)
-	}
-
 	pm := commands.InitPackageManager()
 
-	// Check for ctags tool
-	loadBuiltinCtagsMetadata(pm)
-	ctags, _ := getBuiltinCtagsTool(pm)
-	if !ctags.IsInstalled() {
-		formatter.Print("Downloading and installing missing tool: " + ctags.String())
-		core.DownloadToolRelease(pm, ctags)
-		core.InstallToolRelease(pm, ctags)
-
-		if err := pm.LoadHardware(commands.Config); err != nil {
-			formatter.PrintError(err, "Could not load hardware packages.")
-			os.Exit(commands.ErrCoreConfig)
-		}
-		ctags, _ = getBuiltinCtagsTool(pm)
-		if !ctags.IsInstalled() {
-			formatter.PrintErrorMessage("Missing ctags tool.")
-			os.Exit(commands.ErrCoreConfig)
-		}
+	err = api.EnsureCtagsInstalled(pm, commands.Config, commands.OutputProgressBar(), commands.OutputTaskProgress())
+	if err != nil {
+		formatter.PrintError(err, "Could not install the ctags tool.")
+		os.Exit(commands.ExitCode(err))
 	}
 
-	targetPlatform := pm.FindPlatform(&packagemanager.PlatformReference{
-		Package:              fqbn.Package,
-		PlatformArchitecture: fqbn.PlatformArch,
-	})
-	if targetPlatform == nil || pm.GetInstalledPlatformRelease(targetPlatform) == nil {
-		errorMessage := fmt.Sprintf(
-			"\"%[1]s:%[2]s\" platform is not installed, please install it by running \""+
-				commands.AppName+" core install %[1]s:%[2]s\".", fqbn.Package, fqbn.PlatformArch)
-		formatter.PrintErrorMessage(errorMessage)
-		os.Exit(commands.ErrCoreConfig)
+	if flags.locked {
+		lm := commands.InitLibraryManager(pm)
+		err := api.SketchLockInstall(pm, lm, commands.Config, &api.SketchLockInstallReq{SketchPath: sketchPath},
+			commands.OutputProgressBar(), commands.OutputTaskProgress())
+		if err != nil {
+			formatter.PrintError(err, "Error installing the locked dependencies.")
+			os.Exit(commands.ExitCode(err))
+		}
 	}
 
-	ctx := &types.Context{}
-	ctx.PackageManager = pm
-	ctx.FQBN = fqbn
-	ctx.SketchLocation = paths.New(sketch.FullPath)
-
-	// FIXME: This will be redundant when arduino-builder will be part of the cli
-	if packagesDir, err := commands.Config.HardwareDirectories(); err == nil {
-		ctx.HardwareDirs = packagesDir
-	} else {
-		formatter.PrintError(err, "Cannot get hardware directories.")
-		os.Exit(commands.ErrCoreConfig)
+	req := &api.CompileReq{
+		SketchPath:      sketchPath,
+		FQBN:            flags.fqbn,
+		ShowProperties:  flags.showProperties,
+		Preprocess:      flags.preprocess,
+		BuildProperties: flags.buildProperties,
+		Warnings:        flags.warnings,
+		Verbose:         flags.verbose,
+		Debug:           commands.GlobalFlags.Debug,
+		VidPid:          flags.vidPid,
+		Locked:          flags.locked,
 	}
-
-	if toolsDir, err := commands.Config.BundleToolsDirectories(); err == nil {
-		ctx.ToolsDirs = toolsDir
-	} else {
-		formatter.PrintError(err, "Cannot get bundled tools directories.")
-		os.Exit(commands.ErrCoreConfig)
+	if flags.buildCachePath != "" {
+		req.BuildCachePath = paths.New(flags.buildCachePath)
 	}
-
-	ctx.OtherLibrariesDirs = paths.NewPathList()
-	ctx.OtherLibrariesDirs.Add(commands.Config.LibrariesDir())
-
 	if flags.buildPath != "" {
-		ctx.BuildPath = paths.New(flags.buildPath)
-		err = ctx.BuildPath.MkdirAll()
-		if err != nil {
-			formatter.PrintError(err, "Cannot create the build directory.")
-			os.Exit(commands.ErrBadCall)
-		}
+		req.BuildPath = paths.New(flags.buildPath)
 	}
-
-	ctx.Verbose = flags.verbose
-
-	ctx.CoreBuildCachePath = paths.TempDir().Join("arduino-core-cache")
-
-	ctx.USBVidPid = flags.vidPid
-	ctx.WarningsLevel = flags.warnings
-
-	if commands.GlobalFlags.Debug {
-		ctx.DebugLevel = 100
-	} else {
-		ctx.DebugLevel = 5
+	if flags.exportFile != "" {
+		req.ExportFile = paths.New(flags.exportFile)
 	}
 
-	ctx.CustomBuildProperties = append(flags.buildProperties, "build.warn_data_percentage=75")
-
-	if flags.buildCachePath != "" {
-		ctx.BuildCachePath = paths.New(flags.buildCachePath)
-		err = ctx.BuildCachePath.MkdirAll()
-		if err != nil {
-			formatter.PrintError(err, "Cannot create the build cache directory.")
-			os.Exit(commands.ErrBadCall)
-		}
+	// With a structured output format the result is the only thing printed
+	// on stdout.
+	stdout := io.Writer(os.Stdout)
+	if !formatter.IsCurrentFormat("text") {
+		stdout = os.Stderr
 	}
-
-	// Will be deprecated.
-	ctx.ArduinoAPIVersion = "10607"
-
-	// Check if Arduino IDE is installed and get it's libraries location.
-	preferencesTxt := commands.Config.DataDir.Join("preferences.txt")
-	ideProperties, err := properties.LoadFromPath(preferencesTxt)
-	if err == nil {
-		lastIdeSubProperties := ideProperties.SubTree("last").SubTree("ide")
-		// Preferences can contain records from previous IDE versions. Find the latest one.
-		var pathVariants []string
-		for k := range lastIdeSubProperties.AsMap() {
-			if strings.HasSuffix(k, ".hardwarepath") {
-				pathVariants = append(pathVariants, k)
-			}
-		}
-		sort.Strings(pathVariants)
-		ideHardwarePath := lastIdeSubProperties.Get(pathVariants[len(pathVariants)-1])
-		ideLibrariesPath := filepath.Join(filepath.Dir(ideHardwarePath), "libraries")
-		ctx.BuiltInLibrariesDirs = paths.NewPathList(ideLibrariesPath)
+	res, err := api.Compile(pm, commands.Config, req, stdout, os.Stderr)
+	if res != nil && !flags.showProperties && !flags.preprocess {
+		formatter.Print(compileResultToOutput(res))
 	}
-
-	if flags.showProperties {
-		err = builder.RunParseHardwareAndDumpBuildProperties(ctx)
-	} else if flags.preprocess {
-		err = builder.RunPreprocess(ctx)
-	} else {
-		err = builder.RunBuilder(ctx)
+	if missing, ok := err.(*api.MissingIncludeError); ok {
+		if out := missingIncludesToOutput(pm, missing); out != nil {
+			formatter.Print(out)
+		}
+		formatter.PrintError(err, "Compilation failed, install the missing libraries with `"+commands.AppName+" lib install`.")
+		os.Exit(commands.ExitCode(err))
 	}
-
 	if err != nil {
 		formatter.PrintError(err, "Compilation failed.")
-		os.Exit(commands.ErrGeneric)
+		os.Exit(commands.ExitCode(err))
 	}
+}
 
-	// FIXME: Make a function to obtain these info...
-	outputPath := ctx.BuildProperties.ExpandPropsInString("{build.path}/{recipe.output.tmp_file}")
-	ext := filepath.Ext(outputPath)
-
-	// FIXME: Make a function to produce a better name...
-	// Make the filename without the FQBN configs part
-	fqbn.Configs = properties.NewMap()
-	fqbnSuffix := strings.Replace(fqbn.String(), ":", ".", -1)
-
-	var exportPath *paths.Path
-	var exportFile string
-	if flags.exportFile == "" {
-		exportPath = paths.New(sketch.FullPath)
-		exportFile = sketch.Name + "." + fqbnSuffix
-	} else {
-		exportPath = paths.New(flags.exportFile).Parent()
-		exportFile = paths.New(flags.exportFile).Base()
-		if strings.HasSuffix(exportFile, ext) {
-			exportFile = exportFile[:len(exportFile)-len(ext)]
+// missingIncludesToOutput searches the libraries index for the libraries
+// providing the headers not found by the build. The index is not updated,
+// if it can't be loaded no suggestion is returned.
+func missingIncludesToOutput(pm *packagemanager.PackageManager, missing *api.MissingIncludeError) *output.MissingIncludes {
+	lm := api.NewLibrariesManager(commands.Config, pm)
+	if err := api.LoadLibrariesIndex(lm, commands.Config); err != nil {
+		logrus.WithError(err).Warn("Error loading libraries index, cannot suggest libraries for the missing includes")
+		return nil
+	}
+	out := &output.MissingIncludes{Includes: []*output.MissingInclude{}}
+	for _, header := range missing.Headers {
+		include := &output.MissingInclude{Header: header, Libraries: []string{}}
+		res, err := api.LibrarySearch(lm, &api.LibrarySearchReq{Header: header, Architecture: missing.Architecture})
+		if err == nil {
+			for _, lib := range res.Libraries {
+				include.Libraries = append(include.Libraries, lib.Name)
+			}
 		}
+		out.Includes = append(out.Includes, include)
 	}
+	return out
+}
 
-	// Copy .hex file to sketch directory
-	srcHex := paths.New(outputPath)
-	dstHex := exportPath.Join(exportFile + ext)
-	logrus.WithField("from", srcHex).WithField("to", dstHex).Print("copying sketch build output")
-	if err = srcHex.CopyTo(dstHex); err != nil {
-		formatter.PrintError(err, "Error copying output file.")
-		os.Exit(commands.ErrGeneric)
+func compileResultToOutput(res *api.CompileResult) *output.CompileResult {
+	out := &output.CompileResult{
+		BuildPath:      res.BuildPath.String(),
+		BuildArtifacts: res.BuildArtifacts.AsStrings(),
+		ExportedFiles:  res.ExportedFiles.AsStrings(),
+		Tools:          []*output.BuildTool{},
+		UsedLibraries:  []*output.UsedLibrary{},
+	}
+	platformToOutput := func(platform *cores.PlatformRelease) *output.BuildPlatform {
+		return &output.BuildPlatform{
+			ID:         platform.Platform.String(),
+			Version:    platform.Version.String(),
+			InstallDir: platform.InstallDir.String(),
+		}
 	}
-
-	// Copy .elf file to sketch directory
-	srcElf := paths.New(outputPath[:len(outputPath)-3] + "elf")
-	dstElf := exportPath.Join(exportFile + ".elf")
-	logrus.WithField("from", srcElf).WithField("to", dstElf).Print("copying sketch build output")
-	if err = srcElf.CopyTo(dstElf); err != nil {
-		formatter.PrintError(err, "Error copying elf file.")
-		os.Exit(commands.ErrGeneric)
+	if res.BoardPlatform != nil {
+		out.BoardPlatform = platformToOutput(res.BoardPlatform)
+	}
+	if res.CorePlatform != nil && res.CorePlatform != res.BoardPlatform {
+		out.CorePlatform = platformToOutput(res.CorePlatform)
+	}
+	for _, tool := range res.Tools {
+		out.Tools = append(out.Tools, &output.BuildTool{
+			ID:      tool.Tool.String(),
+			Version: tool.Version.String(),
+		})
+	}
+	for _, lib := range res.UsedLibraries {
+		usedLib := &output.UsedLibrary{
+			Name:       lib.Name,
+			Location:   lib.Location.String(),
+			InstallDir: lib.InstallDir.String(),
+		}
+		if lib.Version != nil {
+			usedLib.Version = lib.Version.String()
+		}
+		out.UsedLibraries = append(out.UsedLibraries, usedLib)
+	}
+	if res.Size != nil {
+		out.Size = &output.SizeReport{
+			ProgramSize:    res.Size.ProgramSize,
+			ProgramMaxSize: res.Size.ProgramMaxSize,
+			DataMaxSize:    res.Size.DataMaxSize,
+		}
+		if res.Size.DataSize >= 0 {
+			out.Size.DataSize = &res.Size.DataSize
+		}
+		if res.Size.EEPROMSize >= 0 {
+			out.Size.EEPROMSize = &res.Size.EEPROMSize
+		}
 	}
+	return out
 }
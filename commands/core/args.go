@@ -30,8 +30,8 @@ import (
 	"go.bug.st/relaxed-semver"
 )
 
-// parsePlatformReferenceArgs parses a sequence of "packager:arch@version" tokens and returns a platformReference slice.
-func parsePlatformReferenceArgs(args []string) []*packagemanager.PlatformReference {
+// ParsePlatformReferenceArgs parses a sequence of "packager:arch@version" tokens and returns a platformReference slice.
+func ParsePlatformReferenceArgs(args []string) []*packagemanager.PlatformReference {
 	ret := []*packagemanager.PlatformReference{}
 	for _, arg := range args {
 		reference, err := parsePlatformReferenceArg(arg)
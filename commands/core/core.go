@@ -19,6 +19,7 @@ package core
 
 import (
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/cache"
 	"github.com/spf13/cobra"
 )
 
@@ -31,11 +32,16 @@ func InitCommand() *cobra.Command {
 		Example: "  " + commands.AppName + " core update-index",
 	}
 	coreCommand.AddCommand(initDownloadCommand())
+	coreCommand.AddCommand(initExamplesCommand())
+	coreCommand.AddCommand(initIndexCommand())
 	coreCommand.AddCommand(initInstallCommand())
 	coreCommand.AddCommand(initListCommand())
+	coreCommand.AddCommand(initLintCommand())
 	coreCommand.AddCommand(initUpdateIndexCommand())
 	coreCommand.AddCommand(initUpgradeCommand())
 	coreCommand.AddCommand(initUninstallCommand())
+	coreCommand.AddCommand(initRollbackCommand())
 	coreCommand.AddCommand(initSearchCommand())
+	coreCommand.AddCommand(cache.InitCleanCommand("core", "gc"))
 	return coreCommand
 }
@@ -20,10 +20,7 @@ package core
 import (
 	"os"
 
-	"go.bug.st/downloader"
-
-	"github.com/arduino/arduino-cli/arduino/cores"
-	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/sirupsen/logrus"
@@ -47,60 +44,13 @@ func initDownloadCommand() *cobra.Command {
 func runDownloadCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino core download`")
 
-	platformsRefs := parsePlatformReferenceArgs(args)
+	platformsRefs := ParsePlatformReferenceArgs(args)
 	pm := commands.InitPackageManagerWithoutBundles()
 	for _, platformRef := range platformsRefs {
-		downloadPlatformByRef(pm, platformRef)
-	}
-}
-
-func downloadPlatformByRef(pm *packagemanager.PackageManager, platformsRef *packagemanager.PlatformReference) {
-	platform, tools, err := pm.FindPlatformReleaseDependencies(platformsRef)
-	if err != nil {
-		formatter.PrintError(err, "Could not determine platform dependencies")
-		os.Exit(commands.ErrBadCall)
-	}
-	downloadPlatform(pm, platform)
-	for _, tool := range tools {
-		downloadTool(pm, tool)
-	}
-}
-
-func downloadPlatform(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease) {
-	// Download platform
-	resp, err := pm.DownloadPlatformRelease(platformRelease)
-	download(resp, err, platformRelease.String())
-}
-
-func downloadTool(pm *packagemanager.PackageManager, tool *cores.ToolRelease) {
-	// Check if tool has a flavor available for the current OS
-	if tool.GetCompatibleFlavour() == nil {
-		formatter.PrintErrorMessage("The tool " + tool.String() + " is not available for the current OS")
-		os.Exit(commands.ErrGeneric)
-	}
-
-	DownloadToolRelease(pm, tool)
-}
-
-// DownloadToolRelease downloads a ToolRelease
-func DownloadToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease) {
-	resp, err := pm.DownloadToolRelease(toolRelease)
-	download(resp, err, toolRelease.String())
-}
-
-func download(d *downloader.Downloader, err error, label string) {
-	if err != nil {
-		formatter.PrintError(err, "Error downloading "+label)
-		os.Exit(commands.ErrNetwork)
-	}
-	if d == nil {
-		formatter.Print(label + " already downloaded")
-		return
-	}
-	formatter.Print("Downloading " + label + "...")
-	formatter.DownloadProgressBar(d, label)
-	if d.Error() != nil {
-		formatter.PrintError(d.Error(), "Error downloading "+label)
-		os.Exit(commands.ErrNetwork)
+		err := api.PlatformDownload(pm, &api.PlatformDownloadReq{Platform: platformRef}, commands.OutputProgressBar())
+		if err != nil {
+			formatter.PrintError(err, "Error downloading "+platformRef.String())
+			os.Exit(commands.ExitCode(err))
+		}
 	}
 }
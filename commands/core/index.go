@@ -0,0 +1,83 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package core
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initIndexCommand() *cobra.Command {
+	indexCommand := &cobra.Command{
+		Use:     "index",
+		Short:   "Package index authoring commands.",
+		Long:    "Commands to publish cores in a package index.",
+		Example: "  " + commands.AppName + " core index generate descriptor.json package_mycompany_index.json",
+	}
+	indexCommand.AddCommand(initIndexGenerateCommand())
+	return indexCommand
+}
+
+func initIndexGenerateCommand() *cobra.Command {
+	generateCommand := &cobra.Command{
+		Use:   "generate DESCRIPTOR INDEX_FILE",
+		Short: "Generates the package index entries of a core and its tools.",
+		Long: "Writes in INDEX_FILE the entries of the core and tool archives listed in the DESCRIPTOR JSON file,\n" +
+			"computing their size and checksum and listing the boards, with their USB ids, from the boards.txt\n" +
+			"in the core archive. If INDEX_FILE exists the entries are merged into it, replacing the ones with\n" +
+			"the same version.",
+		Example: "  " + commands.AppName + " core index generate descriptor.json package_mycompany_index.json",
+		Args:    cobra.ExactArgs(2),
+		Run:     runIndexGenerateCommand,
+	}
+	return generateCommand
+}
+
+func runIndexGenerateCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino core index generate`")
+	req := &api.PlatformIndexGenerateReq{
+		Descriptor: paths.New(args[0]),
+		Index:      paths.New(args[1]),
+	}
+	res, err := api.PlatformIndexGenerate(req)
+	if err != nil {
+		formatter.PrintError(err, "Error generating package index.")
+		os.Exit(commands.ExitCode(err))
+	}
+
+	out := output.CoreIndexGenerateResult{
+		Index:     req.Index.String(),
+		Merged:    res.Merged,
+		Platforms: []string{},
+		Tools:     []string{},
+	}
+	for _, platform := range res.Platforms {
+		out.Platforms = append(out.Platforms, platform.String())
+	}
+	for _, tool := range res.Tools {
+		out.Tools = append(out.Tools, tool.String())
+	}
+	formatter.Print(out)
+}
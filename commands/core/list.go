@@ -18,6 +18,9 @@
 package core
 
 import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/arduino/arduino-cli/common/formatter/output"
@@ -48,27 +51,24 @@ func runListCommand(cmd *cobra.Command, args []string) {
 
 	pm := commands.InitPackageManager()
 
+	res, err := api.PlatformList(pm, &api.PlatformListReq{UpdatableOnly: listFlags.updatableOnly})
+	if err != nil {
+		formatter.PrintError(err, "Error listing platforms")
+		os.Exit(commands.ExitCode(err))
+	}
+
 	installed := []*output.InstalledPlatform{}
-	for _, targetPackage := range pm.GetPackages().Packages {
-		for _, platform := range targetPackage.Platforms {
-			if platformRelease := pm.GetInstalledPlatformRelease(platform); platformRelease != nil {
-				if listFlags.updatableOnly {
-					if latest := platform.GetLatestRelease(); latest == nil || latest == platformRelease {
-						continue
-					}
-				}
-				var latestVersion *semver.Version
-				if latest := platformRelease.Platform.GetLatestRelease(); latest != nil {
-					latestVersion = latest.Version
-				}
-				installed = append(installed, &output.InstalledPlatform{
-					ID:        platformRelease.String(),
-					Installed: platformRelease.Version,
-					Latest:    latestVersion,
-					Name:      platformRelease.Platform.Name,
-				})
-			}
+	for _, platform := range res.Platforms {
+		var latestVersion *semver.Version
+		if platform.Latest != nil {
+			latestVersion = platform.Latest.Version
 		}
+		installed = append(installed, &output.InstalledPlatform{
+			ID:        platform.Installed.String(),
+			Installed: platform.Installed.Version,
+			Latest:    latestVersion,
+			Name:      platform.Installed.Platform.Name,
+		})
 	}
 
 	if len(installed) > 0 {
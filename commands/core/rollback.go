@@ -0,0 +1,55 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package core
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initRollbackCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "rollback PACKAGER:ARCH[@VERSION]",
+		Short: "Restores the previously installed release of a core.",
+		Long: "Restores the release of a core replaced by the last install or upgrade. " +
+			"The current release is kept, so running rollback again undoes it. " +
+			"If more releases are installed, the version selects the one to replace.",
+		Example: "  " + commands.AppName + " core rollback arduino:samd",
+		Args:    cobra.ExactArgs(1),
+		Run:     runRollbackCommand,
+	}
+}
+
+func runRollbackCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino core rollback`")
+
+	platformRef := ParsePlatformReferenceArgs(args)[0]
+
+	pm := commands.InitPackageManagerWithoutBundles()
+	err := api.PlatformRollback(pm, &api.PlatformRollbackReq{Platform: platformRef},
+		commands.OutputProgressBar(), commands.OutputTaskProgress())
+	if err != nil {
+		formatter.PrintError(err, "Error rolling back "+platformRef.String())
+		os.Exit(commands.ExitCode(err))
+	}
+}
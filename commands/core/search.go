@@ -18,14 +18,13 @@
 package core
 
 import (
-	"regexp"
+	"os"
 	"strings"
 
-	"github.com/arduino/arduino-cli/common/formatter/output"
-
-	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
 	"github.com/spf13/cobra"
 )
 
@@ -48,39 +47,17 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 	formatter.Print("Searching for platforms matching '" + search + "'")
 	formatter.Print("")
 
-	res := []*cores.PlatformRelease{}
-	if isUsb, _ := regexp.MatchString("[0-9a-f]{4}:[0-9a-f]{4}", search); isUsb {
-		vid, pid := search[:4], search[5:]
-		res = pm.FindPlatformReleaseProvidingBoardsWithVidPid(vid, pid)
-	} else {
-		match := func(line string) bool {
-			return strings.Contains(strings.ToLower(line), search)
-		}
-		for _, targetPackage := range pm.GetPackages().Packages {
-			for _, platform := range targetPackage.Platforms {
-				platformRelease := platform.GetLatestRelease()
-				if platformRelease == nil {
-					continue
-				}
-				if match(platform.Name) || match(platform.Architecture) {
-					res = append(res, platformRelease)
-					continue
-				}
-				for _, board := range platformRelease.BoardsManifest {
-					if match(board.Name) {
-						res = append(res, platformRelease)
-						break
-					}
-				}
-			}
-		}
+	res, err := api.PlatformSearch(pm, &api.PlatformSearchReq{Query: search})
+	if err != nil {
+		formatter.PrintError(err, "Error searching platforms")
+		os.Exit(commands.ExitCode(err))
 	}
 
-	if len(res) == 0 {
+	if len(res.Platforms) == 0 {
 		formatter.Print("No platforms matching your search")
 	} else {
 		out := []*output.SearchedPlatform{}
-		for _, platformRelease := range res {
+		for _, platformRelease := range res.Platforms {
 			out = append(out, &output.SearchedPlatform{
 				ID:      platformRelease.Platform.String(),
 				Name:    platformRelease.Platform.Name,
@@ -18,11 +18,9 @@
 package core
 
 import (
-	"fmt"
 	"os"
 
-	"github.com/arduino/arduino-cli/arduino/cores"
-	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/sirupsen/logrus"
@@ -41,76 +39,16 @@ func initUninstallCommand() *cobra.Command {
 }
 
 func runUninstallCommand(cmd *cobra.Command, args []string) {
-	logrus.Info("Executing `arduino core download`")
+	logrus.Info("Executing `arduino core uninstall`")
 
-	platformsRefs := parsePlatformReferenceArgs(args)
+	platformsRefs := ParsePlatformReferenceArgs(args)
 	pm := commands.InitPackageManagerWithoutBundles()
 
 	for _, platformRef := range platformsRefs {
-		uninstallPlatformByRef(pm, platformRef)
-	}
-}
-
-func uninstallPlatformByRef(pm *packagemanager.PackageManager, platformRef *packagemanager.PlatformReference) {
-	// If no version is specified consider the installed
-	if platformRef.PlatformVersion == nil {
-		platform := pm.FindPlatform(platformRef)
-		if platform == nil {
-			formatter.PrintErrorMessage("Platform not found " + platformRef.String())
-			os.Exit(commands.ErrBadCall)
+		err := api.PlatformUninstall(pm, &api.PlatformUninstallReq{Platform: platformRef}, commands.OutputTaskProgress())
+		if err != nil {
+			formatter.PrintError(err, "Error uninstalling "+platformRef.String())
+			os.Exit(commands.ExitCode(err))
 		}
-		platformRelease := pm.GetInstalledPlatformRelease(platform)
-		if platformRelease == nil {
-			formatter.PrintErrorMessage("Platform not installed " + platformRef.String())
-			os.Exit(commands.ErrBadCall)
-		}
-		platformRef.PlatformVersion = platformRelease.Version
-	}
-
-	platform, tools, err := pm.FindPlatformReleaseDependencies(platformRef)
-	if err != nil {
-		formatter.PrintError(err, "Could not determine platform dependencies")
-		os.Exit(commands.ErrBadCall)
-	}
-
-	uninstallPlatformRelease(pm, platform)
-
-	for _, tool := range tools {
-		if !pm.IsToolRequired(tool) {
-			fmt.Printf("Tool %s is no more required\n", tool)
-			uninstallToolRelease(pm, tool)
-		}
-	}
-}
-
-func uninstallPlatformRelease(pm *packagemanager.PackageManager, platformRelease *cores.PlatformRelease) {
-	log := pm.Log.WithField("platform", platformRelease)
-
-	log.Info("Uninstalling platform")
-	formatter.Print("Uninstalling " + platformRelease.String() + "...")
-
-	if err := pm.UninstallPlatform(platformRelease); err != nil {
-		log.WithError(err).Error("Error uninstalling")
-		formatter.PrintError(err, "Error uninstalling "+platformRelease.String())
-		os.Exit(commands.ErrGeneric)
 	}
-
-	log.Info("Platform uninstalled")
-	formatter.Print(platformRelease.String() + " uninstalled")
-}
-
-func uninstallToolRelease(pm *packagemanager.PackageManager, toolRelease *cores.ToolRelease) {
-	log := pm.Log.WithField("Tool", toolRelease)
-
-	log.Info("Uninstalling tool")
-	formatter.Print("Uninstalling " + toolRelease.String() + "...")
-
-	if err := pm.UninstallTool(toolRelease); err != nil {
-		log.WithError(err).Error("Error uninstalling")
-		formatter.PrintError(err, "Error uninstalling "+toolRelease.String())
-		os.Exit(commands.ErrGeneric)
-	}
-
-	log.Info("Tool uninstalled")
-	formatter.Print(toolRelease.String() + " uninstalled")
 }
@@ -18,18 +18,11 @@
 package core
 
 import (
-	"io/ioutil"
-	"net/url"
 	"os"
-	"path"
 
-	"go.bug.st/downloader"
-
-	"github.com/arduino/arduino-cli/arduino/cores/packageindex"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
-	"github.com/arduino/go-paths-helper"
-	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
 
@@ -46,56 +39,8 @@ func initUpdateIndexCommand() *cobra.Command {
 }
 
 func runUpdateIndexCommand(cmd *cobra.Command, args []string) {
-	updateIndexes()
-}
-
-func updateIndexes() {
-	for _, URL := range commands.Config.BoardManagerAdditionalUrls {
-		updateIndex(URL)
-	}
-}
-
-// TODO: This should be in packagemanager......
-func updateIndex(URL *url.URL) {
-	logrus.WithField("url", URL).Print("Updating index")
-
-	tmpFile, err := ioutil.TempFile("", "")
-	if err != nil {
-		formatter.PrintError(err, "Error creating temp file for download")
-		os.Exit(commands.ErrGeneric)
-	}
-	if err := tmpFile.Close(); err != nil {
-		formatter.PrintError(err, "Error creating temp file for download")
-		os.Exit(commands.ErrGeneric)
-	}
-	tmp := paths.New(tmpFile.Name())
-	defer tmp.Remove()
-
-	d, err := downloader.Download(tmp.String(), URL.String())
-	if err != nil {
-		formatter.PrintError(err, "Error downloading index "+URL.String())
-		os.Exit(commands.ErrNetwork)
-	}
-	indexDirPath := commands.Config.IndexesDir()
-	coreIndexPath := indexDirPath.Join(path.Base(URL.Path))
-	formatter.DownloadProgressBar(d, "Updating index: "+coreIndexPath.Base())
-	if d.Error() != nil {
-		formatter.PrintError(d.Error(), "Error downloading index "+URL.String())
-		os.Exit(commands.ErrNetwork)
-	}
-
-	if _, err := packageindex.LoadIndex(tmp); err != nil {
-		formatter.PrintError(err, "Invalid package index in "+URL.String())
-		os.Exit(commands.ErrGeneric)
-	}
-
-	if err := indexDirPath.MkdirAll(); err != nil {
-		formatter.PrintError(err, "Can't create data directory "+indexDirPath.String())
-		os.Exit(commands.ErrGeneric)
-	}
-
-	if err := tmp.CopyTo(coreIndexPath); err != nil {
-		formatter.PrintError(err, "Error saving downloaded index "+URL.String())
-		os.Exit(commands.ErrGeneric)
+	if err := api.UpdateIndex(commands.Config, commands.OutputProgressBar(), commands.OutputTaskProgress()); err != nil {
+		formatter.PrintError(err, "Error updating index")
+		os.Exit(commands.ExitCode(err))
 	}
 }
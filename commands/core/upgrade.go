@@ -20,8 +20,8 @@ package core
 import (
 	"os"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
-
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/sirupsen/logrus"
@@ -30,14 +30,16 @@ import (
 
 func initUpgradeCommand() *cobra.Command {
 	upgradeCommand := &cobra.Command{
-		Use:   "upgrade [PACKAGER:ARCH] ...",
+		Use:   "upgrade [PACKAGER:ARCH[@VERSION]] ...",
 		Short: "Upgrades one or all installed platforms to the latest version.",
 		Long:  "Upgrades one or all installed platforms to the latest version.",
 		Example: "" +
 			"  # upgrade everything to the latest version\n" +
 			"  " + commands.AppName + " core upgrade\n\n" +
 			"  # upgrade arduino:samd to the latest version\n" +
-			"  " + commands.AppName + " core upgrade arduino:samd",
+			"  " + commands.AppName + " core upgrade arduino:samd\n\n" +
+			"  # upgrade the installed arduino:avr@1.6.21, if more versions are installed\n" +
+			"  " + commands.AppName + " core upgrade arduino:avr@1.6.21",
 		Run: runUpgradeCommand,
 	}
 	return upgradeCommand
@@ -48,70 +50,34 @@ func runUpgradeCommand(cmd *cobra.Command, args []string) {
 
 	pm := commands.InitPackageManagerWithoutBundles()
 
-	platformsRefs := parsePlatformReferenceArgs(args)
+	platformsRefs := ParsePlatformReferenceArgs(args)
 	if len(platformsRefs) == 0 {
-		upgradeAllPlatforms(pm)
-	} else {
-		upgrade(pm, platformsRefs)
+		platformsRefs = updatablePlatforms(pm)
 	}
-}
-
-func upgradeAllPlatforms(pm *packagemanager.PackageManager) {
-	// Extract all PlatformReference to platforms that have updates
-	platformRefs := []*packagemanager.PlatformReference{}
-
-	for _, targetPackage := range pm.GetPackages().Packages {
-		for _, platform := range targetPackage.Platforms {
-			installed := pm.GetInstalledPlatformRelease(platform)
-			if installed == nil {
-				continue
-			}
-			latest := platform.GetLatestRelease()
-			if !latest.Version.GreaterThan(installed.Version) {
-				continue
-			}
-			platformRefs = append(platformRefs, &packagemanager.PlatformReference{
-				Package:              targetPackage.Name,
-				PlatformArchitecture: platform.Architecture,
-			})
-		}
-	}
-
-	upgrade(pm, platformRefs)
-}
-
-func upgrade(pm *packagemanager.PackageManager, platformsRefs []*packagemanager.PlatformReference) {
 	for _, platformRef := range platformsRefs {
-		if platformRef.PlatformVersion != nil {
-			formatter.PrintErrorMessage("Invalid item " + platformRef.String() + ", upgrade doesn't accept parameters with version")
-			os.Exit(commands.ErrBadArgument)
+		err := api.PlatformUpgrade(pm, &api.PlatformUpgradeReq{Platform: platformRef},
+			commands.OutputProgressBar(), commands.OutputTaskProgress())
+		if err != nil {
+			formatter.PrintError(err, "Error upgrading "+platformRef.String())
+			os.Exit(commands.ExitCode(err))
 		}
 	}
+}
 
-	// Search the latest version for all specified platforms
-	toInstallRefs := []*packagemanager.PlatformReference{}
-	for _, platformRef := range platformsRefs {
-		platform := pm.FindPlatform(platformRef)
-		if platform == nil {
-			formatter.PrintErrorMessage("Platform " + platformRef.String() + " not found")
-			os.Exit(commands.ErrBadArgument)
-		}
-		installed := pm.GetInstalledPlatformRelease(platform)
-		if installed == nil {
-			formatter.PrintErrorMessage("Platform " + platformRef.String() + " is not installed")
-			os.Exit(commands.ErrBadArgument)
-		}
-		latest := platform.GetLatestRelease()
-		if !latest.Version.GreaterThan(installed.Version) {
-			formatter.PrintResult("Platform " + platformRef.String() + " is already at the latest version.")
-		} else {
-			platformRef.PlatformVersion = latest.Version
-			toInstallRefs = append(toInstallRefs, platformRef)
-		}
+// updatablePlatforms returns the references to all the installed platforms
+// that have updates.
+func updatablePlatforms(pm *packagemanager.PackageManager) []*packagemanager.PlatformReference {
+	res, err := api.PlatformList(pm, &api.PlatformListReq{UpdatableOnly: true})
+	if err != nil {
+		formatter.PrintError(err, "Error listing platforms")
+		os.Exit(commands.ExitCode(err))
 	}
-
-	for _, platformRef := range toInstallRefs {
-		downloadPlatformByRef(pm, platformRef)
-		installPlatformByRef(pm, platformRef)
+	platformRefs := []*packagemanager.PlatformReference{}
+	for _, platform := range res.Platforms {
+		platformRefs = append(platformRefs, &packagemanager.PlatformReference{
+			Package:              platform.Installed.Platform.Package.Name,
+			PlatformArchitecture: platform.Installed.Platform.Architecture,
+		})
 	}
+	return platformRefs
 }
@@ -0,0 +1,75 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"net"
+	"os"
+
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/daemon"
+	"github.com/arduino/arduino-cli/rpc"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+)
+
+// InitCommand prepares the command.
+func InitCommand() *cobra.Command {
+	daemonCommand := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run as a daemon.",
+		Long: "Run as a daemon serving the ArduinoCore gRPC API. Hardware and libraries\n" +
+			"are loaded once at startup and kept in memory between requests.",
+		Example: "  " + commands.AppName + " daemon --listen localhost:50051",
+		Args:    cobra.NoArgs,
+		Run:     runDaemonCommand,
+	}
+	daemonCommand.Flags().StringVar(&daemonFlags.listen, "listen", "localhost:50051",
+		"The address where the gRPC server listens for connections.")
+	return daemonCommand
+}
+
+var daemonFlags struct {
+	listen string // The address where the gRPC server listens for connections.
+}
+
+func runDaemonCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino daemon`")
+
+	server, err := daemon.NewArduinoCoreServer(commands.Config, commands.Version)
+	if err != nil {
+		formatter.PrintError(err, "Error initializing daemon.")
+		os.Exit(commands.ErrCoreConfig)
+	}
+
+	lis, err := net.Listen("tcp", daemonFlags.listen)
+	if err != nil {
+		formatter.PrintError(err, "Failed to listen on "+daemonFlags.listen+".")
+		os.Exit(commands.ErrNetwork)
+	}
+
+	s := grpc.NewServer()
+	rpc.RegisterArduinoCoreServer(s, server)
+	formatter.Print("Daemon is listening on " + lis.Addr().String())
+	if err := s.Serve(lis); err != nil {
+		formatter.PrintError(err, "Failed to serve.")
+		os.Exit(commands.ErrGeneric)
+	}
+}
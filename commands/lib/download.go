@@ -20,8 +20,8 @@ package lib
 import (
 	"os"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
-	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/sirupsen/logrus"
@@ -48,45 +48,17 @@ func runDownloadCommand(cmd *cobra.Command, args []string) {
 	lm := commands.InitLibraryManager(nil)
 
 	logrus.Info("Preparing download")
-	pairs, err := librariesindex.ParseArgs(args)
+	refs, err := librariesindex.ParseArgs(args)
 	if err != nil {
 		formatter.PrintError(err, "Arguments error")
 		os.Exit(commands.ErrBadArgument)
 	}
-	downloadLibrariesFromReferences(lm, pairs)
-}
-
-func downloadLibrariesFromReferences(lm *librariesmanager.LibrariesManager, refs []*librariesindex.Reference) {
-	libReleases := []*librariesindex.Release{}
 	for _, ref := range refs {
-		if lib := lm.Index.FindRelease(ref); lib == nil {
-			formatter.PrintErrorMessage("Error: library " + ref.String() + " not found")
-			os.Exit(commands.ErrBadCall)
-		} else {
-			libReleases = append(libReleases, lib)
-		}
-	}
-	downloadLibraries(lm, libReleases)
-}
-
-func downloadLibraries(lm *librariesmanager.LibrariesManager, libReleases []*librariesindex.Release) {
-	logrus.Info("Downloading libraries")
-	for _, libRelease := range libReleases {
-		d, err := libRelease.Resource.Download(lm.DownloadsDir)
+		err := api.LibraryDownload(lm, &api.LibraryDownloadReq{Library: ref}, commands.OutputProgressBar())
 		if err != nil {
-			formatter.PrintError(err, "Error downloading "+libRelease.String())
-			os.Exit(commands.ErrNetwork)
-		}
-		if d == nil {
-			formatter.Print(libRelease.String() + " already downloaded")
-		} else {
-			formatter.DownloadProgressBar(d, libRelease.String())
-			if d.Error() != nil {
-				formatter.PrintError(d.Error(), "Error downloading "+libRelease.String())
-				os.Exit(commands.ErrNetwork)
-			}
+			formatter.PrintError(err, "Error downloading "+ref.String())
+			os.Exit(commands.ExitCode(err))
 		}
 	}
-
 	logrus.Info("Done")
 }
@@ -0,0 +1,69 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package lib
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initExamplesCommand() *cobra.Command {
+	examplesCommand := &cobra.Command{
+		Use:   "examples [LIBRARY_NAME]",
+		Short: "Shows the examples of the installed libraries.",
+		Long: "Shows the examples of the installed libraries, or of the specified library. " +
+			"Use the ID of an example with `sketch new --from` to create a copy of it.",
+		Example: "  " + commands.AppName + " lib examples Servo",
+		Args:    cobra.MaximumNArgs(1),
+		Run:     runExamplesCommand,
+	}
+	return examplesCommand
+}
+
+func runExamplesCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino lib examples`")
+	req := &api.LibraryExamplesReq{}
+	if len(args) > 0 {
+		req.Name = args[0]
+	}
+	pm := commands.InitPackageManager()
+	lm := commands.InitLibraryManager(pm)
+
+	res, err := api.LibraryExamples(lm, req)
+	if err != nil {
+		formatter.PrintError(err, "Error listing library examples.")
+		os.Exit(commands.ExitCode(err))
+	}
+
+	examples := output.ExamplesList{Examples: []*output.Example{}}
+	for _, example := range res.Examples {
+		examples.Examples = append(examples.Examples, &output.Example{
+			ID:     example.ID(),
+			Source: example.Source,
+			Name:   example.Name,
+			Path:   example.Path.String(),
+		})
+	}
+	formatter.Print(examples)
+}
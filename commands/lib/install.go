@@ -20,10 +20,12 @@ package lib
 import (
 	"os"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
-	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
+	paths "github.com/arduino/go-paths-helper"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -32,18 +34,50 @@ func initInstallCommand() *cobra.Command {
 	installCommand := &cobra.Command{
 		Use:   "install LIBRARY[@VERSION_NUMBER](S)",
 		Short: "Installs one of more specified libraries into the system.",
-		Long:  "Installs one or more specified libraries into the system.",
+		Long: "Installs one or more specified libraries into the system, together with their dependencies.\n" +
+			"Libraries not in the libraries index can be installed from a git repository or a zip file,\n" +
+			"without their dependencies.",
 		Example: "" +
 			"  " + commands.AppName + " lib install AudioZero       # for the latest version.\n" +
-			"  " + commands.AppName + " lib install AudioZero@1.0.0 # for the specific version.",
-		Args: cobra.MinimumNArgs(1),
+			"  " + commands.AppName + " lib install AudioZero@1.0.0 # for the specific version.\n" +
+			"  " + commands.AppName + " lib install --dry-run WiFi101 # to show the libraries that would be installed.\n" +
+			"  " + commands.AppName + " lib install --git-url https://github.com/arduino-libraries/WiFi101.git#0.16.0\n" +
+			"  " + commands.AppName + " lib install --zip-path /home/user/Downloads/MyLib.zip",
+		Args: cobra.ArbitraryArgs,
 		Run:  runInstallCommand,
 	}
+	installCommand.Flags().BoolVar(&installFlags.noDeps, "no-deps", false,
+		"Install only the specified libraries, without their dependencies.")
+	installCommand.Flags().BoolVar(&installFlags.dryRun, "dry-run", false,
+		"Print the libraries that would be installed, including the dependencies, without installing them.")
+	installCommand.Flags().StringVar(&installFlags.gitURL, "git-url", "",
+		"Install the library from a git repository, append #REF to install a branch, tag or commit.")
+	installCommand.Flags().StringVar(&installFlags.zipPath, "zip-path", "",
+		"Install the library from a zip file.")
 	return installCommand
 }
 
+var installFlags struct {
+	noDeps  bool   // Don't install the dependencies.
+	dryRun  bool   // Print the install plan without installing.
+	gitURL  string // Repository to install the library from.
+	zipPath string // Archive to install the library from.
+}
+
 func runInstallCommand(cmd *cobra.Command, args []string) {
 	logrus.Info("Executing `arduino lib install`")
+	if installFlags.gitURL != "" || installFlags.zipPath != "" {
+		if len(args) > 0 || installFlags.dryRun {
+			formatter.PrintErrorMessage("--git-url and --zip-path can't be used together with library names or --dry-run.")
+			os.Exit(commands.ErrBadArgument)
+		}
+		runInstallFromSourceCommand()
+		return
+	}
+	if len(args) == 0 {
+		formatter.PrintErrorMessage("Specify the libraries to install, or --git-url or --zip-path.")
+		os.Exit(commands.ErrBadArgument)
+	}
 	lm := commands.InitLibraryManager(nil)
 
 	refs, err := librariesindex.ParseArgs(args)
@@ -51,33 +85,58 @@ func runInstallCommand(cmd *cobra.Command, args []string) {
 		formatter.PrintError(err, "Arguments error")
 		os.Exit(commands.ErrBadArgument)
 	}
-	downloadLibrariesFromReferences(lm, refs)
-	installLibrariesFromReferences(lm, refs)
+	req := &api.LibraryInstallReq{Libraries: refs, NoDeps: installFlags.noDeps}
+	if installFlags.dryRun {
+		plan, err := api.LibraryInstallPlan(lm, req)
+		if err != nil {
+			formatter.PrintError(err, "Error resolving libraries to install")
+			os.Exit(commands.ExitCode(err))
+		}
+		formatter.Print(installPlanOutput(plan))
+		return
+	}
+
+	err = api.LibraryInstall(lm, req, commands.OutputProgressBar(), commands.OutputTaskProgress())
+	if err != nil {
+		formatter.PrintError(err, "Error installing libraries")
+		os.Exit(commands.ExitCode(err))
+	}
 }
 
-func installLibrariesFromReferences(lm *librariesmanager.LibrariesManager, refs []*librariesindex.Reference) {
-	libReleases := []*librariesindex.Release{}
-	for _, ref := range refs {
-		rel := lm.Index.FindRelease(ref)
-		if rel == nil {
-			formatter.PrintErrorMessage("Error: library " + ref.String() + " not found")
-			os.Exit(commands.ErrBadCall)
+// runInstallFromSourceCommand installs the libraries from the repository
+// and the archive specified with --git-url and --zip-path.
+func runInstallFromSourceCommand() {
+	lm := commands.InitLibraryManager(nil)
+	if installFlags.gitURL != "" {
+		_, err := api.LibraryInstallGit(lm, &api.LibraryInstallGitReq{URL: installFlags.gitURL}, commands.OutputTaskProgress())
+		if err != nil {
+			formatter.PrintError(err, "Error installing library from git repository")
+			os.Exit(commands.ExitCode(err))
+		}
+	}
+	if installFlags.zipPath != "" {
+		_, err := api.LibraryInstallZip(lm, &api.LibraryInstallZipReq{ZipPath: paths.New(installFlags.zipPath)}, commands.OutputTaskProgress())
+		if err != nil {
+			formatter.PrintError(err, "Error installing library from zip file")
+			os.Exit(commands.ExitCode(err))
 		}
-		libReleases = append(libReleases, rel)
 	}
-	installLibraries(lm, libReleases)
 }
 
-func installLibraries(lm *librariesmanager.LibrariesManager, libReleases []*librariesindex.Release) {
-	for _, libRelease := range libReleases {
-		logrus.WithField("library", libRelease).Info("Installing library")
-
-		if _, err := lm.Install(libRelease); err != nil {
-			logrus.WithError(err).Warn("Error installing library ", libRelease)
-			formatter.PrintError(err, "Error installing library: "+libRelease.String())
-			os.Exit(commands.ErrGeneric)
+func installPlanOutput(plan *api.LibraryInstallPlanResult) *output.LibInstallPlan {
+	res := &output.LibInstallPlan{Libraries: []*output.LibInstallPlanItem{}}
+	for _, item := range plan.Items {
+		outItem := &output.LibInstallPlanItem{
+			Name:    item.Release.Library.Name,
+			Version: item.Release.Version.String(),
 		}
-
-		formatter.Print("Installed " + libRelease.String())
+		if item.Installed != nil {
+			outItem.InstalledVersion = item.Installed.Version.String()
+		}
+		for _, requiredBy := range item.RequiredBy {
+			outItem.RequiredBy = append(outItem.RequiredBy, requiredBy.String())
+		}
+		res.Libraries = append(res.Libraries, outItem)
 	}
+	return res
 }
@@ -33,8 +33,10 @@ func InitCommand() *cobra.Command {
 			"  " + commands.AppName + " lib update-index",
 	}
 	libCommand.AddCommand(initDownloadCommand())
+	libCommand.AddCommand(initExamplesCommand())
 	libCommand.AddCommand(initInstallCommand())
 	libCommand.AddCommand(initListCommand())
+	libCommand.AddCommand(initLintCommand())
 	libCommand.AddCommand(initSearchCommand())
 	libCommand.AddCommand(initUninstallCommand())
 	libCommand.AddCommand(initUpgradeCommand())
@@ -0,0 +1,80 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package lib
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/arduino-cli/common/formatter/output"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initLintCommand() *cobra.Command {
+	lintCommand := &cobra.Command{
+		Use:   "lint [LIBRARY_PATH]",
+		Short: "Checks a library for common problems.",
+		Long: "Checks the library.properties, the layout and the examples of a library for common problems.\n" +
+			"The exit code is not zero if errors are found, or warnings with --strict.",
+		Example: "" +
+			"  " + commands.AppName + " lib lint ~/Arduino/libraries/MyLib\n" +
+			"  " + commands.AppName + " lib lint --strict --format json .",
+		Args: cobra.MaximumNArgs(1),
+		Run:  runLintCommand,
+	}
+	lintCommand.Flags().BoolVar(&lintFlags.strict, "strict", false, "Fail on warnings too.")
+	return lintCommand
+}
+
+var lintFlags struct {
+	strict bool // if true warnings make the command fail.
+}
+
+func runLintCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino lib lint`")
+	libraryPath := paths.New(".")
+	if len(args) > 0 {
+		libraryPath = paths.New(args[0])
+	}
+
+	res, err := api.LibraryLint(&api.LibraryLintReq{LibraryPath: libraryPath})
+	if err != nil {
+		formatter.PrintError(err, "Error linting library.")
+		os.Exit(commands.ExitCode(err))
+	}
+
+	report := output.LibLintReport{
+		Library: res.Library.Name,
+		Path:    res.Library.InstallDir.String(),
+	}
+	report.Results, report.Errors, report.Warnings = output.NewLintResults(res.Results)
+	formatter.Print(report)
+
+	minSeverity := lint.Error
+	if lintFlags.strict {
+		minSeverity = lint.Warning
+	}
+	if res.Failed(minSeverity) {
+		os.Exit(commands.ErrGeneric)
+	}
+}
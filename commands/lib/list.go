@@ -18,9 +18,10 @@
 package lib
 
 import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
-	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
-	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/arduino/arduino-cli/common/formatter/output"
@@ -55,29 +56,24 @@ func runListCommand(cmd *cobra.Command, args []string) {
 	}
 	lm := commands.InitLibraryManager(pm)
 
-	res := listLibraries(lm, listFlags.updatable)
-	if len(res.Libraries) > 0 {
-		formatter.Print(res)
+	libs, err := api.LibraryList(lm, &api.LibraryListReq{
+		All:       listFlags.all,
+		Updatable: listFlags.updatable,
+	})
+	if err != nil {
+		formatter.PrintError(err, "Error listing libraries")
+		os.Exit(commands.ExitCode(err))
 	}
-	logrus.Info("Done")
-}
 
-func listLibraries(lm *librariesmanager.LibrariesManager, updatable bool) *output.InstalledLibraries {
 	res := &output.InstalledLibraries{}
-	for _, libAlternatives := range lm.Libraries {
-		for _, lib := range libAlternatives.Alternatives {
-			var available *librariesindex.Release
-			if updatable {
-				available = lm.Index.FindLibraryUpdate(lib)
-				if available == nil {
-					continue
-				}
-			}
-			res.Libraries = append(res.Libraries, &output.InstalledLibary{
-				Library:   lib,
-				Available: available,
-			})
-		}
+	for _, lib := range libs.Libraries {
+		res.Libraries = append(res.Libraries, &output.InstalledLibary{
+			Library:   lib.Library,
+			Available: lib.Available,
+		})
 	}
-	return res
+	if len(res.Libraries) > 0 {
+		formatter.Print(res)
+	}
+	logrus.Info("Done")
 }
@@ -19,9 +19,10 @@ package lib
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
-	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/arduino/arduino-cli/common/formatter/output"
@@ -31,19 +32,40 @@ import (
 
 func initSearchCommand() *cobra.Command {
 	searchCommand := &cobra.Command{
-		Use:     "search [LIBRARY_NAME]",
-		Short:   "Searchs for one or more libraries data.",
-		Long:    "Search for one or more libraries data (case insensitive search).",
-		Example: "  " + commands.AppName + " lib search audio",
-		Args:    cobra.ArbitraryArgs,
-		Run:     runSearchCommand,
+		Use:   "search [QUERY]",
+		Short: "Searchs for one or more libraries data.",
+		Long: "Search for one or more libraries data (case insensitive search).\n" +
+			"Libraries are matched by name, sentence, paragraph, author, maintainer, category, types and architectures,\n" +
+			"the most relevant first. Words with typos match too.",
+		Example: "" +
+			"  " + commands.AppName + " lib search audio\n" +
+			"  " + commands.AppName + " lib search temperature --category Sensors --arch samd --type Contributed\n" +
+			"  " + commands.AppName + " lib search --header Servo.h",
+		Args: cobra.ArbitraryArgs,
+		Run:  runSearchCommand,
 	}
 	searchCommand.Flags().BoolVar(&searchFlags.names, "names", false, "Show library names only.")
+	searchCommand.Flags().StringVar(&searchFlags.header, "header", "",
+		"Search the libraries providing the header, e.g.: Servo.h, the most suitable first.")
+	searchCommand.Flags().StringVar(&searchFlags.architecture, "arch", "",
+		"Show only the libraries compatible with the architecture, e.g.: avr.")
+	searchCommand.Flags().StringVar(&searchFlags.category, "category", "",
+		"Show only the libraries of the category, e.g.: Sensors.")
+	searchCommand.Flags().StringSliceVar(&searchFlags.types, "type", []string{},
+		"Show only the libraries of the type, e.g.: Arduino, Contributed. Can be repeated.")
+	searchCommand.Flags().IntVar(&searchFlags.offset, "offset", 0, "Skip the first results.")
+	searchCommand.Flags().IntVar(&searchFlags.limit, "limit", 0, "Show at most this number of results, 0 shows all.")
 	return searchCommand
 }
 
 var searchFlags struct {
-	names bool // if true outputs lib names only.
+	names        bool     // if true outputs lib names only.
+	header       string   // if set searches the libraries providing the header.
+	architecture string   // if set shows only the libraries compatible with it.
+	category     string   // if set shows only the libraries of the category.
+	types        []string // if set shows only the libraries of one of the types.
+	offset       int      // results to skip.
+	limit        int      // maximum number of results, 0 for all.
 }
 
 func runSearchCommand(cmd *cobra.Command, args []string) {
@@ -52,13 +74,22 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 
 	lm := commands.InitLibraryManager(nil)
 
-	res := output.LibSearchResults{
-		Libraries: []*librariesindex.Library{},
+	libs, err := api.LibrarySearch(lm, &api.LibrarySearchReq{
+		Query:        query,
+		Header:       searchFlags.header,
+		Architecture: searchFlags.architecture,
+		Category:     searchFlags.category,
+		Types:        searchFlags.types,
+		Offset:       searchFlags.offset,
+		Limit:        searchFlags.limit,
+	})
+	if err != nil {
+		formatter.PrintError(err, "Error searching libraries")
+		os.Exit(commands.ExitCode(err))
 	}
-	for _, lib := range lm.Index.Libraries {
-		if strings.Contains(strings.ToLower(lib.Name), query) {
-			res.Libraries = append(res.Libraries, lib)
-		}
+	res := output.LibSearchResults{Libraries: []*output.SearchedLibrary{}, Total: libs.Total}
+	for _, lib := range libs.Libraries {
+		res.Libraries = append(res.Libraries, output.NewSearchedLibrary(lib))
 	}
 
 	if searchFlags.names {
@@ -66,7 +97,9 @@ func runSearchCommand(cmd *cobra.Command, args []string) {
 			formatter.Print(lib.Name)
 		}
 	} else {
-		if len(res.Libraries) == 0 {
+		if res.Total == 0 && searchFlags.header != "" {
+			formatter.Print(fmt.Sprintf("No library found providing `%s`", searchFlags.header))
+		} else if res.Total == 0 {
 			formatter.Print(fmt.Sprintf("No library found matching `%s` search query", query))
 		} else {
 			formatter.Print(res)
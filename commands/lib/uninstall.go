@@ -20,6 +20,7 @@ package lib
 import (
 	"os"
 
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
@@ -49,13 +50,10 @@ func runUninstallCommand(cmd *cobra.Command, args []string) {
 		os.Exit(commands.ErrBadArgument)
 	}
 	for _, libRef := range libRefs {
-		lib := lm.FindByReference(libRef)
-		if lib == nil {
-			formatter.PrintErrorMessage("Library not installed: " + libRef.String())
-			os.Exit(commands.ErrGeneric)
-		} else {
-			formatter.Print("Uninstalling " + lib.String())
-			lm.Uninstall(lib)
+		err := api.LibraryUninstall(lm, &api.LibraryUninstallReq{Library: libRef}, commands.OutputTaskProgress())
+		if err != nil {
+			formatter.PrintError(err, "Error uninstalling "+libRef.String())
+			os.Exit(commands.ExitCode(err))
 		}
 	}
 
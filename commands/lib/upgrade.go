@@ -18,8 +18,11 @@
 package lib
 
 import (
-	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
 	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -39,13 +42,9 @@ func initUpgradeCommand() *cobra.Command {
 
 func runUpgradeCommand(cmd *cobra.Command, args []string) {
 	lm := commands.InitLibraryManager(nil)
-	list := listLibraries(lm, true)
-	libReleases := []*librariesindex.Release{}
-	for _, upgradeDesc := range list.Libraries {
-		libReleases = append(libReleases, upgradeDesc.Available)
+	if err := api.LibraryUpgradeAll(lm, commands.OutputProgressBar(), commands.OutputTaskProgress()); err != nil {
+		formatter.PrintError(err, "Error upgrading libraries")
+		os.Exit(commands.ExitCode(err))
 	}
-
-	downloadLibraries(lm, libReleases)
-	installLibraries(lm, libReleases)
 	logrus.Info("Done")
 }
@@ -0,0 +1,108 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package mirror
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/common/formatter"
+	paths "github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initCreateCommand() *cobra.Command {
+	createCommand := &cobra.Command{
+		Use:   "create DIR",
+		Short: "Creates a mirror of cores, tools and libraries.",
+		Long: "Copies the specified cores with their tools, and the specified libraries with their " +
+			"dependencies, into DIR together with a package index and a libraries index pointing to them. " +
+			"Other machines can use the mirror by setting board_manager.index_url and " +
+			"library_manager.index_url in their configuration.",
+		Example: "" +
+			"  " + commands.AppName + " mirror create --core arduino:avr --core arduino:samd@1.6.19 /srv/arduino-mirror\n" +
+			"  " + commands.AppName + " mirror create --lib WiFi101 --base-url http://mirror.local/arduino /srv/arduino-mirror",
+		Args: cobra.ExactArgs(1),
+		Run:  runCreateCommand,
+	}
+	createCommand.Flags().StringSliceVar(&createFlags.cores, "core", nil,
+		"The cores to mirror, as PACKAGER:ARCH[@VERSION]. Can be repeated.")
+	createCommand.Flags().StringSliceVar(&createFlags.libraries, "lib", nil,
+		"The libraries to mirror, as LIBRARY[@VERSION]. Can be repeated.")
+	createCommand.Flags().StringVar(&createFlags.baseURL, "base-url", "",
+		"The URL where the mirror directory will be published, defaults to its file:// URL.")
+	createCommand.Flags().BoolVar(&createFlags.noDeps, "no-deps", false,
+		"Mirror only the specified libraries, without their dependencies.")
+	createCommand.Flags().BoolVar(&createFlags.allHosts, "all-hosts", false,
+		"Mirror the tools for all the operating systems, not only the current one.")
+	return createCommand
+}
+
+var createFlags struct {
+	cores     []string // The cores to mirror.
+	libraries []string // The libraries to mirror.
+	baseURL   string   // The URL where the mirror will be published.
+	noDeps    bool     // Don't mirror the library dependencies.
+	allHosts  bool     // Mirror the tools for all the OSes.
+}
+
+func runCreateCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino mirror create`")
+	if len(createFlags.cores) == 0 && len(createFlags.libraries) == 0 {
+		formatter.PrintErrorMessage("Specify the cores and libraries to mirror with --core and --lib.")
+		os.Exit(commands.ErrBadArgument)
+	}
+
+	req := &api.MirrorCreateReq{
+		Dir:       paths.New(args[0]),
+		Platforms: core.ParsePlatformReferenceArgs(createFlags.cores),
+		NoDeps:    createFlags.noDeps,
+		AllHosts:  createFlags.allHosts,
+	}
+	refs, err := librariesindex.ParseArgs(createFlags.libraries)
+	if err != nil {
+		formatter.PrintError(err, "Arguments error")
+		os.Exit(commands.ErrBadArgument)
+	}
+	req.Libraries = refs
+	if createFlags.baseURL != "" {
+		baseURL, err := url.Parse(createFlags.baseURL)
+		if err != nil {
+			formatter.PrintError(err, "Invalid base URL")
+			os.Exit(commands.ErrBadArgument)
+		}
+		req.BaseURL = baseURL
+	}
+
+	pm := commands.InitPackageManager()
+	lm := commands.InitLibraryManager(nil)
+	res, err := api.MirrorCreate(pm, lm, req, commands.OutputProgressBar(), commands.OutputTaskProgress())
+	if err != nil {
+		formatter.PrintError(err, "Error creating mirror")
+		os.Exit(commands.ExitCode(err))
+	}
+	formatter.Print(fmt.Sprintf("To use the mirror add to the configuration:\n\n"+
+		"board_manager:\n  index_url: %s\nlibrary_manager:\n  index_url: %s",
+		res.PackageIndexURL, res.LibrariesIndexURL))
+}
@@ -0,0 +1,327 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package monitor
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sync"
+	"time"
+
+	"github.com/arduino/arduino-cli/arduino/monitor"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// InitCommand prepares the command.
+func InitCommand() *cobra.Command {
+	command := &cobra.Command{
+		Use:   "monitor",
+		Short: "Open a serial monitor.",
+		Long: "Open a serial monitor on the given port. In line mode (the default) each line typed is sent " +
+			"when Enter is pressed, press Ctrl-C to exit. In raw mode each key is sent immediately, " +
+			"press Ctrl-] to exit.",
+		Example: "" +
+			"  " + commands.AppName + " monitor -p /dev/ttyACM0\n" +
+			"  " + commands.AppName + " monitor -p /dev/ttyACM0 --baudrate 115200 --timestamp --log-file serial.log",
+		Args: cobra.NoArgs,
+		Run:  run,
+	}
+	command.Flags().StringVarP(&flags.port, "port", "p", "", "Serial port, e.g.: /dev/ttyACM0 or COM3")
+	command.Flags().IntVar(&flags.baudRate, "baudrate", 9600, "Baud rate of the serial port.")
+	command.Flags().StringVar(&flags.lineEnding, "line-ending", "nl",
+		`Appended to each line sent in line mode, can be "none", "nl", "cr" or "crlf".`)
+	command.Flags().BoolVar(&flags.dtr, "dtr", true, "State of the DTR line after opening the port.")
+	command.Flags().BoolVar(&flags.rts, "rts", true, "State of the RTS line after opening the port.")
+	command.Flags().BoolVar(&flags.raw, "raw", false, "Raw mode, each key is sent immediately and data is shown as received.")
+	command.Flags().BoolVar(&flags.timestamp, "timestamp", false, "Prefix each received line with the time it was received.")
+	command.Flags().StringVar(&flags.logFile, "log-file", "", "Append the received data to the given file.")
+	return command
+}
+
+var flags struct {
+	port       string // The serial port to open.
+	baudRate   int    // The baud rate of the serial port.
+	lineEnding string // Appended to each line sent in line mode.
+	dtr        bool   // State of the DTR line.
+	rts        bool   // State of the RTS line.
+	raw        bool   // Raw mode.
+	timestamp  bool   // Prefix each received line with a timestamp.
+	logFile    string // Append the received data to this file.
+}
+
+// exitKey is the key used to exit in raw mode: Ctrl-]
+const exitKey = 0x1d
+
+const timestampLayout = "2006-01-02 15:04:05.000"
+
+// event is emitted for each action of the monitor when the output format
+// is JSON.
+type event struct {
+	Type     string    `json:"type"` // one of "open", "data", "sent", "close" or "error"
+	Time     time.Time `json:"time"`
+	Port     string    `json:"port,omitempty"`
+	BaudRate int       `json:"baudrate,omitempty"`
+	Data     string    `json:"data,omitempty"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// outputMux serializes the output of the received data and of the events.
+var outputMux sync.Mutex
+
+func printEvent(e *event) {
+	e.Time = time.Now()
+	formatter.Print(e)
+}
+
+func run(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino monitor`")
+	if flags.port == "" {
+		formatter.PrintErrorMessage("No port specified, use the --port flag.")
+		os.Exit(commands.ErrBadArgument)
+	}
+	lineEnding, err := monitor.ParseLineEnding(flags.lineEnding)
+	if err != nil {
+		formatter.PrintError(err, "Invalid line ending.")
+		os.Exit(commands.ErrBadArgument)
+	}
+	jsonOutput := !formatter.IsCurrentFormat("text")
+	stdinFd := int(os.Stdin.Fd())
+	rawTerminal := flags.raw && terminal.IsTerminal(stdinFd)
+
+	// The received data is written to stdout and to the log file
+	writers := []io.Writer{}
+	if rawTerminal && !jsonOutput {
+		// In raw mode the terminal doesn't translate the line feeds
+		writers = append(writers, monitor.NewCRLFWriter(os.Stdout))
+	} else if !jsonOutput {
+		writers = append(writers, os.Stdout)
+	}
+	var logFile *os.File
+	if flags.logFile != "" {
+		logFile, err = os.OpenFile(flags.logFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			formatter.PrintError(err, "Error opening log file.")
+			os.Exit(commands.ErrGeneric)
+		}
+		writers = append(writers, logFile)
+	}
+	out := io.MultiWriter(writers...)
+	if flags.timestamp {
+		out = monitor.NewTimestampWriter(out, timestampLayout)
+	}
+
+	m, err := monitor.Open(&monitor.Config{
+		Port:     flags.port,
+		BaudRate: flags.baudRate,
+		DTR:      flags.dtr,
+		RTS:      flags.rts,
+	})
+	if err != nil {
+		if logFile != nil {
+			logFile.Close()
+		}
+		formatter.PrintError(err, "Error opening serial monitor.")
+		os.Exit(commands.ErrGeneric)
+	}
+
+	if jsonOutput {
+		printEvent(&event{Type: "open", Port: flags.port, BaudRate: flags.baudRate})
+	} else if flags.raw {
+		formatter.Print(fmt.Sprintf("Connected to %s at %d baud, press Ctrl-] to exit.", flags.port, flags.baudRate))
+	} else {
+		formatter.Print(fmt.Sprintf("Connected to %s at %d baud, press Ctrl-C to exit.", flags.port, flags.baudRate))
+	}
+
+	// os.Exit skips the deferred calls: the terminal must be restored and
+	// the log file closed before exiting.
+	var oldState *terminal.State
+	if rawTerminal {
+		if oldState, err = terminal.MakeRaw(stdinFd); err != nil {
+			logrus.WithError(err).Warn("Error setting terminal in raw mode")
+		}
+	}
+	err = monitorPort(m, out, lineEnding, jsonOutput)
+	if oldState != nil {
+		terminal.Restore(stdinFd, oldState)
+	}
+	if logFile != nil {
+		if closeErr := logFile.Close(); closeErr != nil && err == nil {
+			err = fmt.Errorf("closing log file: %s", closeErr)
+		}
+	}
+
+	if jsonOutput {
+		if err != nil {
+			printEvent(&event{Type: "error", Error: err.Error()})
+		}
+		printEvent(&event{Type: "close", Port: flags.port})
+	}
+	if err != nil {
+		if !jsonOutput {
+			formatter.PrintError(err, "Serial monitor error.")
+		}
+		os.Exit(commands.ErrGeneric)
+	}
+}
+
+// monitorPort writes the data received from the serial port to out and
+// sends the data read from stdin, until the user asks to exit (nil is
+// returned) or an error interrupts the monitor. The port is closed before
+// returning.
+func monitorPort(m *monitor.Monitor, out io.Writer, lineEnding monitor.LineEnding, jsonOutput bool) error {
+	// done receives nil when the user asks to exit, or the error that
+	// interrupted the monitor.
+	done := make(chan error, 3)
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+	go func() {
+		<-interrupt
+		done <- nil
+	}()
+
+	lines := &lineSplitter{}
+	go func() {
+		buff := make([]byte, 1024)
+		for {
+			n, err := m.Read(buff)
+			if err != nil {
+				done <- fmt.Errorf("reading from %s: %s", flags.port, err)
+				return
+			}
+			outputMux.Lock()
+			data := buff[:n]
+			out.Write(data)
+			if jsonOutput && flags.raw {
+				printEvent(&event{Type: "data", Data: string(data)})
+			} else if jsonOutput {
+				for _, line := range lines.Split(data) {
+					printEvent(&event{Type: "data", Data: line})
+				}
+			}
+			outputMux.Unlock()
+		}
+	}()
+
+	go func() {
+		var err error
+		if flags.raw {
+			err = sendRaw(m)
+		} else {
+			err = sendLines(m, lineEnding, jsonOutput)
+		}
+		// when stdin is closed keep monitoring until interrupted
+		if err != io.EOF {
+			done <- err
+		}
+	}()
+
+	err := <-done
+	m.Close()
+	// The reading goroutine may still be running: the output stays locked
+	// so that no data is written after the close event.
+	outputMux.Lock()
+	if jsonOutput {
+		if line := lines.Rest(); line != "" {
+			printEvent(&event{Type: "data", Data: line})
+		}
+	}
+	return err
+}
+
+// sendRaw sends each byte read from stdin to the serial port, until the
+// exit key is pressed (nil is returned) or stdin is closed (io.EOF is
+// returned).
+func sendRaw(m *monitor.Monitor) error {
+	buff := make([]byte, 1024)
+	for {
+		n, err := os.Stdin.Read(buff)
+		if err == io.EOF {
+			return err
+		}
+		if err != nil {
+			return fmt.Errorf("reading from stdin: %s", err)
+		}
+		data := buff[:n]
+		exit := false
+		if i := bytes.IndexByte(data, exitKey); i != -1 {
+			data = data[:i]
+			exit = true
+		}
+		if _, err := m.Write(data); err != nil {
+			return fmt.Errorf("writing to %s: %s", flags.port, err)
+		}
+		if exit {
+			return nil
+		}
+	}
+}
+
+// sendLines sends each line read from stdin to the serial port, followed
+// by the line ending, until stdin is closed (io.EOF is returned).
+func sendLines(m *monitor.Monitor, lineEnding monitor.LineEnding, jsonOutput bool) error {
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if _, err := m.Write([]byte(line + string(lineEnding))); err != nil {
+			return fmt.Errorf("writing to %s: %s", flags.port, err)
+		}
+		if jsonOutput {
+			outputMux.Lock()
+			printEvent(&event{Type: "sent", Data: line})
+			outputMux.Unlock()
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading from stdin: %s", err)
+	}
+	return io.EOF
+}
+
+// lineSplitter splits the received data in lines, the line terminators
+// are removed.
+type lineSplitter struct {
+	partial []byte
+}
+
+// Split returns the lines completed by data.
+func (s *lineSplitter) Split(data []byte) []string {
+	s.partial = append(s.partial, data...)
+	lines := []string{}
+	for {
+		i := bytes.IndexByte(s.partial, '\n')
+		if i == -1 {
+			return lines
+		}
+		lines = append(lines, string(bytes.TrimRight(s.partial[:i], "\r")))
+		s.partial = s.partial[i+1:]
+	}
+}
+
+// Rest returns the data received after the last complete line.
+func (s *lineSplitter) Rest() string {
+	return string(bytes.TrimRight(s.partial, "\r"))
+}
@@ -0,0 +1,70 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package commands
+
+import (
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/common/formatter"
+	pb "gopkg.in/cheggaaa/pb.v1"
+)
+
+// OutputProgressBar returns a DownloadProgressCB that prints a progress bar
+// for each download. Nothing is printed if the output format is not text.
+func OutputProgressBar() api.DownloadProgressCB {
+	var bar *pb.ProgressBar
+	var label string
+	return func(progress *api.DownloadProgress) {
+		if !formatter.IsCurrentFormat("text") {
+			return
+		}
+		switch {
+		case progress.File != "" && progress.Completed:
+			formatter.Print(progress.File + " already downloaded")
+		case progress.File != "":
+			label = progress.File
+			formatter.Print("Downloading " + label + "...")
+			bar = pb.StartNew(int(progress.TotalSize))
+			bar.SetUnits(pb.U_BYTES)
+			bar.Prefix(label)
+		case bar == nil:
+			return
+		case progress.Completed:
+			bar.FinishPrintOver(label + " downloaded")
+			bar = nil
+		default:
+			bar.Set(int(progress.Downloaded))
+		}
+	}
+}
+
+// OutputTaskProgress returns a TaskProgressCB that prints the progress of
+// the running tasks.
+func OutputTaskProgress() api.TaskProgressCB {
+	return func(progress *api.TaskProgress) {
+		if progress.Name != "" {
+			if progress.Completed {
+				formatter.Print(progress.Name)
+			} else {
+				formatter.Print(progress.Name + "...")
+			}
+		}
+		if progress.Message != "" {
+			formatter.Print(progress.Message)
+		}
+	}
+}
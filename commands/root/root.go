@@ -31,11 +31,16 @@ import (
 
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/commands/board"
+	"github.com/arduino/arduino-cli/commands/burnbootloader"
+	"github.com/arduino/arduino-cli/commands/cache"
 	"github.com/arduino/arduino-cli/commands/compile"
 	"github.com/arduino/arduino-cli/commands/config"
 	"github.com/arduino/arduino-cli/commands/core"
+	"github.com/arduino/arduino-cli/commands/daemon"
 	"github.com/arduino/arduino-cli/commands/generatedocs"
 	"github.com/arduino/arduino-cli/commands/lib"
+	"github.com/arduino/arduino-cli/commands/mirror"
+	"github.com/arduino/arduino-cli/commands/monitor"
 	"github.com/arduino/arduino-cli/commands/sketch"
 	"github.com/arduino/arduino-cli/commands/upload"
 	"github.com/arduino/arduino-cli/commands/version"
@@ -58,13 +63,18 @@ func Init() *cobra.Command {
 	command.PersistentFlags().StringVar(&commands.GlobalFlags.Format, "format", "text", "The output format, can be [text|json].")
 	command.PersistentFlags().StringVar(&yamlConfigFile, "config-file", "", "The custom config file (if not specified ./.cli-config.yml will be used).")
 	command.AddCommand(board.InitCommand())
+	command.AddCommand(burnbootloader.InitCommand())
+	command.AddCommand(cache.InitCommand())
 	command.AddCommand(compile.InitCommand())
 	command.AddCommand(config.InitCommand())
 	command.AddCommand(core.InitCommand())
+	command.AddCommand(daemon.InitCommand())
 	command.AddCommand(generatedocs.InitCommand())
 	command.AddCommand(lib.InitCommand())
 	// command.AddCommand(login.InitCommand())
 	// command.AddCommand(logout.InitCommand())
+	command.AddCommand(mirror.InitCommand())
+	command.AddCommand(monitor.InitCommand())
 	command.AddCommand(sketch.InitCommand())
 	command.AddCommand(upload.InitCommand())
 	// command.AddCommand(validate.InitCommand())
@@ -0,0 +1,91 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketch
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initArchiveCommand() *cobra.Command {
+	archiveCommand := &cobra.Command{
+		Use:   "archive [sketchPath] [archivePath]",
+		Short: "Creates a zip archive of a sketch.",
+		Long: "Creates a zip archive of a sketch to share it, by default <sketch name>.zip next to the sketch folder. " +
+			"The archive can be unpacked in the sketchbook with `sketch import`.",
+		Example: "  " + commands.AppName + " sketch archive --include-metadata --include-libraries /home/user/Arduino/MySketch\n" +
+			"  " + commands.AppName + " sketch archive /home/user/Arduino/MySketch /home/user/MySketch.zip",
+		Args: cobra.MaximumNArgs(2),
+		Run:  runArchiveCommand,
+	}
+	archiveCommand.Flags().StringVarP(&archiveFlags.fqbn, "fqbn", "b", "",
+		"Fully Qualified Board Name used to detect the libraries used by the sketch, e.g.: arduino:avr:uno")
+	archiveCommand.Flags().BoolVar(&archiveFlags.includeBuild, "include-build", false,
+		"Include the compiled binaries exported in the sketch folder.")
+	archiveCommand.Flags().BoolVar(&archiveFlags.includeMetadata, "include-metadata", false,
+		"Include sketch.json and a sketch.lock recording the cores and libraries used.")
+	archiveCommand.Flags().BoolVar(&archiveFlags.includeLibraries, "include-libraries", false,
+		"Include a copy of the installed libraries used by the sketch.")
+	return archiveCommand
+}
+
+var archiveFlags struct {
+	fqbn             string // Fully Qualified Board Name, e.g.: arduino:avr:uno.
+	includeBuild     bool   // Include the compiled binaries.
+	includeMetadata  bool   // Include sketch.json and sketch.lock.
+	includeLibraries bool   // Include the libraries used by the sketch.
+}
+
+func runArchiveCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino sketch archive`")
+	var sketchPath, archivePath *paths.Path
+	if len(args) > 0 {
+		sketchPath = paths.New(args[0])
+	}
+	if len(args) > 1 {
+		archivePath = paths.New(args[1])
+	}
+	sketchPath, err := commands.InitSketchPath(sketchPath)
+	if err != nil {
+		formatter.PrintError(err, "Error opening sketch.")
+		os.Exit(commands.ErrGeneric)
+	}
+
+	pm := commands.InitPackageManager()
+	lm := commands.InitLibraryManager(pm)
+
+	res, err := api.SketchArchive(pm, lm, commands.Config, &api.SketchArchiveReq{
+		SketchPath:       sketchPath,
+		ArchivePath:      archivePath,
+		FQBN:             archiveFlags.fqbn,
+		IncludeBuild:     archiveFlags.includeBuild,
+		IncludeMetadata:  archiveFlags.includeMetadata,
+		IncludeLibraries: archiveFlags.includeLibraries,
+	}, os.Stdout, os.Stderr)
+	if err != nil {
+		formatter.PrintError(err, "Error archiving sketch.")
+		os.Exit(commands.ExitCode(err))
+	}
+	formatter.Print("Sketch archived in: " + res.ArchivePath.String())
+}
@@ -0,0 +1,56 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketch
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initImportCommand() *cobra.Command {
+	importCommand := &cobra.Command{
+		Use:   "import <archivePath>",
+		Short: "Unpacks a sketch archive in the sketchbook.",
+		Long: "Unpacks a sketch archive created with `sketch archive` in the sketchbook, installing the " +
+			"libraries it contains and the cores and libraries recorded in its metadata that are missing.",
+		Example: "  " + commands.AppName + " sketch import /home/user/MySketch.zip",
+		Args:    cobra.ExactArgs(1),
+		Run:     runImportCommand,
+	}
+	return importCommand
+}
+
+func runImportCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino sketch import`")
+	pm := commands.InitPackageManager()
+	lm := commands.InitLibraryManager(pm)
+
+	res, err := api.SketchImport(pm, lm, commands.Config, &api.SketchImportReq{ArchivePath: paths.New(args[0])},
+		commands.OutputProgressBar(), commands.OutputTaskProgress())
+	if err != nil {
+		formatter.PrintError(err, "Error importing sketch.")
+		os.Exit(commands.ExitCode(err))
+	}
+	formatter.Print("Sketch imported in: " + res.SketchPath.String())
+}
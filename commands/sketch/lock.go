@@ -0,0 +1,72 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package sketch
+
+import (
+	"os"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/commands"
+	"github.com/arduino/arduino-cli/common/formatter"
+	"github.com/arduino/go-paths-helper"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+func initLockCommand() *cobra.Command {
+	lockCommand := &cobra.Command{
+		Use:   "lock [sketchPath]",
+		Short: "Records the platforms, tools and libraries used by a sketch.",
+		Long: "Records the exact platforms, tools and libraries used to build a sketch in the " +
+			"sketch.lock file in the sketch folder. Use `compile --locked` to build with them.",
+		Example: "  " + commands.AppName + " sketch lock -b arduino:avr:uno /home/user/Arduino/MySketch",
+		Args:    cobra.MaximumNArgs(1),
+		Run:     runLockCommand,
+	}
+	lockCommand.Flags().StringVarP(&lockFlags.fqbn, "fqbn", "b", "",
+		"Fully Qualified Board Name, e.g.: arduino:avr:uno")
+	return lockCommand
+}
+
+var lockFlags struct {
+	fqbn string // Fully Qualified Board Name, e.g.: arduino:avr:uno.
+}
+
+func runLockCommand(cmd *cobra.Command, args []string) {
+	logrus.Info("Executing `arduino sketch lock`")
+	var sketchPath *paths.Path
+	if len(args) > 0 {
+		sketchPath = paths.New(args[0])
+	}
+	sketchPath, err := commands.InitSketchPath(sketchPath)
+	if err != nil {
+		formatter.PrintError(err, "Error opening sketch.")
+		os.Exit(commands.ErrGeneric)
+	}
+
+	pm := commands.InitPackageManager()
+	lm := commands.InitLibraryManager(pm)
+
+	res, err := api.SketchLock(pm, lm, commands.Config,
+		&api.SketchLockReq{SketchPath: sketchPath, FQBN: lockFlags.fqbn}, os.Stdout, os.Stderr)
+	if err != nil {
+		formatter.PrintError(err, "Error locking sketch dependencies.")
+		os.Exit(commands.ExitCode(err))
+	}
+	formatter.Print("Sketch dependencies locked in: " + res.Path.String())
+}
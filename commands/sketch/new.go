@@ -20,6 +20,9 @@ package sketch
 import (
 	"os"
 
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
 	"github.com/arduino/arduino-cli/commands"
 	"github.com/arduino/arduino-cli/common/formatter"
 	"github.com/spf13/cobra"
@@ -27,36 +30,40 @@ import (
 
 func initNewCommand() *cobra.Command {
 	newCommand := &cobra.Command{
-		Use:     "new",
-		Short:   "Create a new Sketch",
-		Long:    "Create a new Sketch",
-		Example: "  " + commands.AppName + " sketch new MultiBlinker",
-		Args:    cobra.ExactArgs(1),
-		Run:     runNewCommand,
+		Use:   "new",
+		Short: "Create a new Sketch",
+		Long: "Create a new Sketch in the sketchbook, empty or as a copy of a template: a sketch folder, " +
+			"a template in the templates folder of the sketchbook (configurable with sketch_templates_path) " +
+			"or an example listed by `lib examples` and `core examples`.",
+		Example: "" +
+			"  " + commands.AppName + " sketch new MultiBlinker\n" +
+			"  " + commands.AppName + " sketch new --from arduino:avr/01.Basics/Blink MyBlink\n" +
+			"  " + commands.AppName + " sketch new --from Servo/Sweep MySweep",
+		Args: cobra.ExactArgs(1),
+		Run:  runNewCommand,
 	}
+	newCommand.Flags().StringVar(&newFlags.from, "from", "",
+		"Create the sketch copying an example, a template or a sketch folder.")
 	return newCommand
 }
 
-var emptySketch = []byte(`
-void setup() {
+var newFlags struct {
+	from string // The template of the new sketch.
 }
 
-void loop() {
-}
-`)
-
 func runNewCommand(cmd *cobra.Command, args []string) {
-	sketchDir := commands.Config.SketchbookDir.Join(args[0])
-	if err := sketchDir.MkdirAll(); err != nil {
-		formatter.PrintError(err, "Could not create sketch directory.")
-		os.Exit(commands.ErrGeneric)
+	var pm *packagemanager.PackageManager
+	var lm *librariesmanager.LibrariesManager
+	if newFlags.from != "" {
+		pm = commands.InitPackageManager()
+		lm = commands.InitLibraryManager(pm)
 	}
 
-	sketchFile := sketchDir.Join(args[0] + ".ino")
-	if err := sketchFile.WriteFile(emptySketch); err != nil {
+	res, err := api.SketchNew(pm, lm, commands.Config, &api.SketchNewReq{Name: args[0], From: newFlags.from})
+	if err != nil {
 		formatter.PrintError(err, "Error creating sketch.")
-		os.Exit(commands.ErrGeneric)
+		os.Exit(commands.ExitCode(err))
 	}
 
-	formatter.Print("Sketch created in: " + sketchDir.String())
+	formatter.Print("Sketch created in: " + res.SketchPath.String())
 }
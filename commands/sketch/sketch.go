@@ -31,6 +31,9 @@ func InitCommand() *cobra.Command {
 		Example: "  " + commands.AppName + " sketch new MySketch",
 	}
 	sketchCommand.AddCommand(initNewCommand())
+	sketchCommand.AddCommand(initLockCommand())
+	sketchCommand.AddCommand(initArchiveCommand())
+	sketchCommand.AddCommand(initImportCommand())
 	//sketchCommand.AddCommand(initSyncCommand())
 	return sketchCommand
 }
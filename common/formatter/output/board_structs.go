@@ -38,10 +38,21 @@ type NetworkBoardListItem struct {
 	Location string `json:"location,required"`
 }
 
+// PortBoardListItem represents a board connected to a port found by a
+// pluggable discovery.
+type PortBoardListItem struct {
+	Name     string `json:"name,required"`
+	Fqbn     string `json:"fqbn,required"`
+	Protocol string `json:"protocol,required"`
+	Address  string `json:"address,required"`
+	Label    string `json:"label,omitempty"`
+}
+
 // AttachedBoardList is a list of attached boards.
 type AttachedBoardList struct {
 	SerialBoards  []SerialBoardListItem  `json:"serialBoards,required"`
 	NetworkBoards []NetworkBoardListItem `json:"networkBoards,required"`
+	OtherBoards   []PortBoardListItem    `json:"otherBoards,omitempty"`
 }
 
 func (bl *AttachedBoardList) String() string {
@@ -51,11 +62,18 @@ func (bl *AttachedBoardList) String() string {
 
 	table.AddRow("FQBN", "Port", "ID", "Board Name")
 	for _, item := range bl.SerialBoards {
-		table.AddRow(item.Fqbn, item.Port, item.UsbID[:9], item.Name)
+		usbID := item.UsbID
+		if len(usbID) > 9 {
+			usbID = usbID[:9]
+		}
+		table.AddRow(item.Fqbn, item.Port, usbID, item.Name)
 	}
 	for _, item := range bl.NetworkBoards {
 		table.AddRow(item.Fqbn, "network://"+item.Location, "", item.Name)
 	}
+	for _, item := range bl.OtherBoards {
+		table.AddRow(item.Fqbn, item.Protocol+"://"+item.Address, "", item.Name)
+	}
 	return fmt.Sprintln(table)
 }
 
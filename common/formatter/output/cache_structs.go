@@ -0,0 +1,69 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package output
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CacheCleanResult represents the outcome of a cache clean command.
+type CacheCleanResult struct {
+	DryRun          bool     `json:"dryRun,required"`
+	RemovedTools    []string `json:"removedTools,required"`
+	RemovedArchives []string `json:"removedArchives,required"`
+	ReclaimedSize   int64    `json:"reclaimedSize,required"`
+}
+
+// String returns a string representation of the object.
+func (cr CacheCleanResult) String() string {
+	verb := "Removed"
+	if cr.DryRun {
+		verb = "Would remove"
+	}
+	lines := []string{}
+	for _, tool := range cr.RemovedTools {
+		lines = append(lines, verb+" tool "+tool)
+	}
+	for _, archive := range cr.RemovedArchives {
+		lines = append(lines, verb+" archive "+archive)
+	}
+	if len(lines) == 0 {
+		return "Nothing to clean."
+	}
+	reclaimed := "reclaimed"
+	if cr.DryRun {
+		reclaimed = "would be reclaimed"
+	}
+	lines = append(lines, fmt.Sprintf("%s %s.", humanSize(cr.ReclaimedSize), reclaimed))
+	return strings.Join(lines, "\n")
+}
+
+// humanSize formats a size in bytes using binary units.
+func humanSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gosuri/uitable"
+)
+
+// CompileResult represents the outcome of a compile command.
+type CompileResult struct {
+	BuildPath      string         `json:"buildPath,required"`
+	BuildArtifacts []string       `json:"buildArtifacts,required"`
+	ExportedFiles  []string       `json:"exportedFiles,required"`
+	BoardPlatform  *BuildPlatform `json:"boardPlatform,omitempty"`
+	CorePlatform   *BuildPlatform `json:"corePlatform,omitempty"`
+	Tools          []*BuildTool   `json:"tools,required"`
+	UsedLibraries  []*UsedLibrary `json:"usedLibraries,required"`
+	Size           *SizeReport    `json:"size,omitempty"`
+}
+
+// BuildPlatform represents a platform used to compile a sketch.
+type BuildPlatform struct {
+	ID         string `json:"id,required"`
+	Version    string `json:"version,required"`
+	InstallDir string `json:"installDir,required"`
+}
+
+// BuildTool represents a tool used to compile a sketch.
+type BuildTool struct {
+	ID      string `json:"id,required"`
+	Version string `json:"version,required"`
+}
+
+// UsedLibrary represents a library compiled with a sketch.
+type UsedLibrary struct {
+	Name       string `json:"name,required"`
+	Version    string `json:"version,omitempty"`
+	Location   string `json:"location,required"`
+	InstallDir string `json:"installDir,required"`
+}
+
+// SizeReport represents the memory used by a compiled sketch. The maximum
+// sizes are omitted when the board doesn't declare them.
+type SizeReport struct {
+	ProgramSize    int  `json:"programSize,required"`
+	ProgramMaxSize int  `json:"programMaxSize,omitempty"`
+	DataSize       *int `json:"dataSize,omitempty"`
+	DataMaxSize    int  `json:"dataMaxSize,omitempty"`
+	EEPROMSize     *int `json:"eepromSize,omitempty"`
+}
+
+// String returns a string representation of the object.
+func (cr CompileResult) String() string {
+	newTable := func() *uitable.Table {
+		table := uitable.New()
+		table.MaxColWidth = 100
+		table.Wrap = true
+		return table
+	}
+	sections := []string{}
+
+	platforms := newTable()
+	platforms.AddRow("Platform", "Version", "Path")
+	for _, platform := range []*BuildPlatform{cr.BoardPlatform, cr.CorePlatform} {
+		if platform != nil {
+			platforms.AddRow(platform.ID, platform.Version, platform.InstallDir)
+		}
+	}
+	for _, tool := range cr.Tools {
+		platforms.AddRow(tool.ID, tool.Version, "")
+	}
+	sections = append(sections, platforms.String())
+
+	if len(cr.UsedLibraries) > 0 {
+		libs := newTable()
+		libs.AddRow("Library", "Version", "Location", "Path")
+		for _, lib := range cr.UsedLibraries {
+			libs.AddRow(lib.Name, lib.Version, lib.Location, lib.InstallDir)
+		}
+		sections = append(sections, libs.String())
+	}
+
+	if cr.Size != nil {
+		size := newTable()
+		size.AddRow("Memory", "Used", "Maximum", "Usage")
+		size.AddRow(sizeRow("Program", &cr.Size.ProgramSize, cr.Size.ProgramMaxSize)...)
+		if cr.Size.DataSize != nil {
+			size.AddRow(sizeRow("Data", cr.Size.DataSize, cr.Size.DataMaxSize)...)
+		}
+		if cr.Size.EEPROMSize != nil {
+			size.AddRow(sizeRow("EEPROM", cr.Size.EEPROMSize, 0)...)
+		}
+		sections = append(sections, size.String())
+	}
+
+	files := "Output files:\n"
+	for _, file := range append(cr.ExportedFiles, cr.BuildArtifacts...) {
+		files += fmt.Sprintln(" -", file)
+	}
+	sections = append(sections, strings.TrimSpace(files))
+
+	return strings.Join(sections, "\n\n")
+}
+
+func sizeRow(name string, used *int, max int) []interface{} {
+	if max <= 0 {
+		return []interface{}{name, *used, "", ""}
+	}
+	return []interface{}{name, *used, max, fmt.Sprintf("%d%%", *used*100/max)}
+}
+
+// MissingIncludes represents the headers not found while compiling a
+// sketch together with the libraries that provide them.
+type MissingIncludes struct {
+	Includes []*MissingInclude `json:"missingIncludes,required"`
+}
+
+// MissingInclude represents a header not found while compiling a sketch.
+// Libraries are sorted from the most suitable.
+type MissingInclude struct {
+	Header    string   `json:"header,required"`
+	Libraries []string `json:"libraries,required"`
+}
+
+// String returns a string representation of the object.
+func (mi MissingIncludes) String() string {
+	lines := []string{}
+	for _, include := range mi.Includes {
+		if len(include.Libraries) == 0 {
+			lines = append(lines, fmt.Sprintf("%s not found, no library in the index provides it.", include.Header))
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%s not found, it's provided by the libraries:", include.Header))
+		for _, lib := range include.Libraries {
+			lines = append(lines, "  "+lib)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -83,3 +83,38 @@ func (is SearchedPlatforms) String() string {
 	}
 	return fmt.Sprintln(table)
 }
+
+// CoreLintReport represents the output of the `core lint` command.
+type CoreLintReport struct {
+	Platform string        `json:"platform,required"`
+	Path     string        `json:"path,required"`
+	Results  []*LintResult `json:"results,required"`
+	Errors   int           `json:"errors"`
+	Warnings int           `json:"warnings"`
+}
+
+// String returns a string representation of the object.
+func (report CoreLintReport) String() string {
+	return lintReportString(report.Platform, report.Results, report.Errors, report.Warnings)
+}
+
+// CoreIndexGenerateResult represents the output of the `core index
+// generate` command.
+type CoreIndexGenerateResult struct {
+	Index     string   `json:"index,required"`
+	Merged    bool     `json:"merged"`
+	Platforms []string `json:"platforms,required"`
+	Tools     []string `json:"tools,required"`
+}
+
+// String returns a string representation of the object.
+func (res CoreIndexGenerateResult) String() string {
+	ret := "Created " + res.Index + " with:"
+	if res.Merged {
+		ret = "Updated " + res.Index + " with:"
+	}
+	for _, release := range append(res.Platforms, res.Tools...) {
+		ret += "\n  " + release
+	}
+	return ret
+}
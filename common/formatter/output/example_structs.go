@@ -0,0 +1,53 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package output
+
+import (
+	"fmt"
+
+	"github.com/gosuri/uitable"
+)
+
+// ExamplesList represents the output of the `lib examples` and
+// `core examples` commands.
+type ExamplesList struct {
+	Examples []*Example `json:"examples,required"`
+}
+
+// Example is an example sketch of a library or a platform.
+type Example struct {
+	ID     string `json:"id,required"`
+	Source string `json:"source,required"`
+	Name   string `json:"name,required"`
+	Path   string `json:"path,required"`
+}
+
+func (el ExamplesList) String() string {
+	if len(el.Examples) == 0 {
+		return "No examples found."
+	}
+	table := uitable.New()
+	table.MaxColWidth = 100
+	table.Wrap = true
+
+	table.AddRow("ID", "Path")
+	for _, example := range el.Examples {
+		table.AddRow(example.ID, example.Path)
+	}
+	return fmt.Sprintln(table)
+}
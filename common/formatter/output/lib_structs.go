@@ -22,6 +22,8 @@ import (
 	"strings"
 
 	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/lint"
+	"github.com/gosuri/uitable"
 )
 
 // VersionResult represents the output of the version commands.
@@ -58,8 +60,48 @@ func (cpr CoreProcessResults) String() string {
 }
 
 // LibSearchResults represents a set of results of a search of libraries.
+// Total counts also the libraries outside of the page shown.
 type LibSearchResults struct {
-	Libraries []*librariesindex.Library `json:"libraries,required"`
+	Libraries []*SearchedLibrary `json:"libraries,required"`
+	Total     int                `json:"total"`
+}
+
+// SearchedLibrary represents a library found in the libraries index, the
+// fields are the ones of the latest release.
+type SearchedLibrary struct {
+	Name          string   `json:"name,required"`
+	Author        string   `json:"author"`
+	Maintainer    string   `json:"maintainer"`
+	Sentence      string   `json:"sentence"`
+	Paragraph     string   `json:"paragraph"`
+	Website       string   `json:"website"`
+	Category      string   `json:"category"`
+	Architectures []string `json:"architectures"`
+	Types         []string `json:"types"`
+	Latest        string   `json:"latest,required"`
+	Versions      []string `json:"versions,required"`
+}
+
+// NewSearchedLibrary creates a SearchedLibrary from a library of the
+// index, the versions are sorted from the oldest.
+func NewSearchedLibrary(lib *librariesindex.Library) *SearchedLibrary {
+	versions := []string{}
+	for _, version := range lib.Versions() {
+		versions = append(versions, version.String())
+	}
+	return &SearchedLibrary{
+		Name:          lib.Name,
+		Author:        lib.Latest.Author,
+		Maintainer:    lib.Latest.Maintainer,
+		Sentence:      lib.Latest.Sentence,
+		Paragraph:     lib.Latest.Paragraph,
+		Website:       lib.Latest.Website,
+		Category:      lib.Latest.Category,
+		Architectures: lib.Latest.Architectures,
+		Types:         lib.Latest.Types,
+		Latest:        lib.Latest.Version.String(),
+		Versions:      versions,
+	}
 }
 
 // String returns a string representation of the object.
@@ -76,15 +118,18 @@ func (lsr LibSearchResults) String() string {
 	ret := ""
 	for _, l := range lsr.Libraries {
 		ret += fmt.Sprintf("Name: \"%s\"\n", l.Name) +
-			fmt.Sprintln("  Author: ", l.Latest.Author) +
-			fmt.Sprintln("  Maintainer: ", l.Latest.Maintainer) +
-			fmt.Sprintln("  Sentence: ", l.Latest.Sentence) +
-			fmt.Sprintln("  Paragraph: ", l.Latest.Paragraph) +
-			fmt.Sprintln("  Website: ", l.Latest.Website) +
-			fmt.Sprintln("  Category: ", l.Latest.Category) +
-			fmt.Sprintln("  Architecture: ", strings.Join(l.Latest.Architectures, ", ")) +
-			fmt.Sprintln("  Types: ", strings.Join(l.Latest.Types, ", ")) +
-			fmt.Sprintln("  Versions: ", strings.Replace(fmt.Sprint(l.Versions()), " ", ", ", -1))
+			fmt.Sprintln("  Author: ", l.Author) +
+			fmt.Sprintln("  Maintainer: ", l.Maintainer) +
+			fmt.Sprintln("  Sentence: ", l.Sentence) +
+			fmt.Sprintln("  Paragraph: ", l.Paragraph) +
+			fmt.Sprintln("  Website: ", l.Website) +
+			fmt.Sprintln("  Category: ", l.Category) +
+			fmt.Sprintln("  Architecture: ", strings.Join(l.Architectures, ", ")) +
+			fmt.Sprintln("  Types: ", strings.Join(l.Types, ", ")) +
+			fmt.Sprintln("  Versions: ", "["+strings.Join(l.Versions, ", ")+"]")
+	}
+	if len(lsr.Libraries) < lsr.Total {
+		ret += fmt.Sprintf("Showing %d of %d libraries found.", len(lsr.Libraries), lsr.Total)
 	}
 	return strings.TrimSpace(ret)
 }
@@ -95,3 +140,93 @@ func (lsr LibSearchResults) String() string {
 func (lpr LibProcessResults) Results() map[string]ProcessResult {
 	return lpr.Libraries
 }
+
+// LibInstallPlan represents the libraries selected for installation by
+// the lib install command.
+type LibInstallPlan struct {
+	Libraries []*LibInstallPlanItem `json:"libraries"`
+}
+
+// LibInstallPlanItem represents a library selected for installation.
+type LibInstallPlanItem struct {
+	Name             string   `json:"name,required"`
+	Version          string   `json:"version,required"`
+	InstalledVersion string   `json:"installed_version,omitempty"`
+	RequiredBy       []string `json:"required_by,omitempty"`
+}
+
+// String returns a string representation of the object.
+func (plan LibInstallPlan) String() string {
+	ret := ""
+	for _, item := range plan.Libraries {
+		lib := item.Name + "@" + item.Version
+		switch item.InstalledVersion {
+		case "":
+			ret += lib + " will be installed"
+		case item.Version:
+			ret += lib + " is already installed"
+		default:
+			ret += lib + " will replace " + item.Name + "@" + item.InstalledVersion
+		}
+		if len(item.RequiredBy) > 0 {
+			ret += " (required by " + strings.Join(item.RequiredBy, ", ") + ")"
+		}
+		ret += "\n"
+	}
+	return strings.TrimSpace(ret)
+}
+
+// LibLintReport represents the output of the `lib lint` command.
+type LibLintReport struct {
+	Library  string        `json:"library,required"`
+	Path     string        `json:"path,required"`
+	Results  []*LintResult `json:"results,required"`
+	Errors   int           `json:"errors"`
+	Warnings int           `json:"warnings"`
+}
+
+// LintResult represents a problem found by the linter.
+type LintResult struct {
+	Severity string `json:"severity,required"`
+	Check    string `json:"check,required"`
+	Message  string `json:"message,required"`
+}
+
+// NewLintResults converts the results of a linter, returning the number of
+// errors and warnings found.
+func NewLintResults(results []*lint.Result) (res []*LintResult, errors int, warnings int) {
+	res = []*LintResult{}
+	for _, r := range results {
+		res = append(res, &LintResult{
+			Severity: r.Severity.String(),
+			Check:    r.Check,
+			Message:  r.Message,
+		})
+		switch r.Severity {
+		case lint.Error:
+			errors++
+		case lint.Warning:
+			warnings++
+		}
+	}
+	return res, errors, warnings
+}
+
+// String returns a string representation of the object.
+func (report LibLintReport) String() string {
+	return lintReportString(report.Library, report.Results, report.Errors, report.Warnings)
+}
+
+func lintReportString(name string, results []*LintResult, errors, warnings int) string {
+	if len(results) == 0 {
+		return "No problems found in " + name + "."
+	}
+	table := uitable.New()
+	table.MaxColWidth = 100
+	table.Wrap = true
+	table.AddRow("Severity", "Check", "Message")
+	for _, r := range results {
+		table.AddRow(r.Severity, r.Check, r.Message)
+	}
+	return fmt.Sprintf("%s\n\n%s: %d errors, %d warnings.", table, name, errors, warnings)
+}
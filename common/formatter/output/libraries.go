@@ -52,17 +52,25 @@ func (il InstalledLibraries) String() string {
 	table.Wrap = true
 
 	hasUpdates := false
+	hasSources := false
 	for _, libMeta := range il.Libraries {
 		if libMeta.Available != nil {
 			hasUpdates = true
 		}
+		if libMeta.Library.InstalledFrom != nil {
+			hasSources = true
+		}
 	}
 
+	header := []interface{}{"Name", "Installed"}
 	if hasUpdates {
-		table.AddRow("Name", "Installed", "Available", "Location")
-	} else {
-		table.AddRow("Name", "Installed", "Location")
+		header = append(header, "Available")
+	}
+	header = append(header, "Location")
+	if hasSources {
+		header = append(header, "Source")
 	}
+	table.AddRow(header...)
 	sort.Sort(il)
 	lastName := ""
 	for _, libMeta := range il.Libraries {
@@ -78,15 +86,23 @@ func (il InstalledLibraries) String() string {
 		if lib.ContainerPlatform != nil {
 			location = lib.ContainerPlatform.String()
 		}
+		row := []interface{}{name, lib.Version}
 		if hasUpdates {
 			var available *semver.Version
 			if libMeta.Available != nil {
 				available = libMeta.Available.Version
 			}
-			table.AddRow(name, lib.Version, available, location)
-		} else {
-			table.AddRow(name, lib.Version, location)
+			row = append(row, available)
+		}
+		row = append(row, location)
+		if hasSources {
+			source := ""
+			if lib.InstalledFrom != nil {
+				source = lib.InstalledFrom.String()
+			}
+			row = append(row, source)
 		}
+		table.AddRow(row...)
 	}
 	return fmt.Sprintln(table)
 }
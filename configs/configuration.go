@@ -36,6 +36,10 @@ type Configuration struct {
 	// SketchbookDir represents the current root of the sketchbooks tree (defaulted to `$HOME/Arduino`).
 	SketchbookDir *paths.Path
 
+	// SketchTemplatesDir contains the user defined templates for new
+	// sketches, if nil the templates folder in the sketchbook is used.
+	SketchTemplatesDir *paths.Path
+
 	// ArduinoIDEDirectory is the directory of the Arduino IDE if the CLI runs together with it.
 	ArduinoIDEDirectory *paths.Path
 
@@ -44,9 +48,26 @@ type Configuration struct {
 	// standalone or nil if the detection has not been performed.
 	IDEBundledCheckResult *bool
 
-	// BoardManagerAdditionalUrls contains the additional URL for 3rd party packages
+	// BoardManagerAdditionalUrls contains the additional URL for 3rd party packages,
+	// the first one is the URL of the Arduino package index.
 	BoardManagerAdditionalUrls []*url.URL
 
+	// LibrariesIndexURL is the URL of the libraries index.
+	LibrariesIndexURL *url.URL
+
+	// TrustedKeys maps the URLs of the 3rd party package indexes to the
+	// files containing the public keys trusted to sign them.
+	TrustedKeys map[string]*paths.Path
+
+	// IndexSignaturePolicy is the action taken when an index is unsigned
+	// or its signature can't be verified: IndexSignatureWarn or
+	// IndexSignatureStrict.
+	IndexSignaturePolicy string
+
+	// MaxParallelDownloads is the number of archives downloaded in
+	// parallel, a default is used if not positive.
+	MaxParallelDownloads int
+
 	// ProxyType is the type of proxy configured
 	ProxyType string
 
@@ -60,7 +81,17 @@ type Configuration struct {
 	ProxyPassword string
 }
 
+// The policies for the indexes that are unsigned or whose signature can't
+// be verified.
+const (
+	// IndexSignatureWarn prints a warning and uses the index anyway.
+	IndexSignatureWarn = "warn"
+	// IndexSignatureStrict refuses the index.
+	IndexSignatureStrict = "strict"
+)
+
 var defaultPackageIndexURL, _ = url.Parse("https://downloads.arduino.cc/packages/package_index.json")
+var defaultLibrariesIndexURL, _ = url.Parse("https://downloads.arduino.cc/libraries/library_index.json")
 
 // NewConfiguration returns a new Configuration with the default values
 func NewConfiguration() (*Configuration, error) {
@@ -78,6 +109,9 @@ func NewConfiguration() (*Configuration, error) {
 		DataDir:                    dataDir,
 		SketchbookDir:              sketchbookDir,
 		BoardManagerAdditionalUrls: []*url.URL{defaultPackageIndexURL},
+		LibrariesIndexURL:          defaultLibrariesIndexURL,
+		TrustedKeys:                map[string]*paths.Path{},
+		IndexSignaturePolicy:       IndexSignatureWarn,
 		ProxyType:                  "auto",
 	}, nil
 }
@@ -87,6 +121,14 @@ func (config *Configuration) LibrariesDir() *paths.Path {
 	return config.SketchbookDir.Join("libraries")
 }
 
+// TemplatesDir returns the directory for the user defined sketch templates.
+func (config *Configuration) TemplatesDir() *paths.Path {
+	if config.SketchTemplatesDir != nil {
+		return config.SketchTemplatesDir
+	}
+	return config.SketchbookDir.Join("templates")
+}
+
 // PackagesDir return the directory for installed packages.
 func (config *Configuration) PackagesDir() *paths.Path {
 	return config.DataDir.Join("packages")
@@ -97,6 +139,18 @@ func (config *Configuration) DownloadsDir() *paths.Path {
 	return config.DataDir.Join("staging")
 }
 
+// TrustedKeyFor returns the file of the public keys trusted to sign the
+// index at the given URL, or nil if not configured.
+func (config *Configuration) TrustedKeyFor(URL *url.URL) *paths.Path {
+	return config.TrustedKeys[URL.String()]
+}
+
+// IndexSignatureRequired returns true if the indexes that are unsigned or
+// whose signature can't be verified must be refused.
+func (config *Configuration) IndexSignatureRequired() bool {
+	return config.IndexSignaturePolicy == IndexSignatureStrict
+}
+
 // IndexesDir returns the directory for the indexes
 func (config *Configuration) IndexesDir() *paths.Path {
 	return config.DataDir
@@ -31,12 +31,22 @@ type yamlConfig struct {
 	ProxyType         string                   `yaml:"proxy_type"`
 	ProxyManualConfig *yamlProxyConfig         `yaml:"manual_configs,omitempty"`
 	SketchbookPath    string                   `yaml:"sketchbook_path,omitempty"`
+	TemplatesPath     string                   `yaml:"sketch_templates_path,omitempty"`
 	ArduinoDataDir    string                   `yaml:"arduino_data,omitempty"`
 	BoardsManager     *yamlBoardsManagerConfig `yaml:"board_manager"`
+	LibrariesManager  *yamlLibManagerConfig    `yaml:"library_manager,omitempty"`
+	SignaturePolicy   string                   `yaml:"index_signature_policy,omitempty"`
+	ParallelDownloads int                      `yaml:"max_parallel_downloads,omitempty"`
 }
 
 type yamlBoardsManagerConfig struct {
-	AdditionalURLS []string `yaml:"additional_urls,omitempty"`
+	IndexURL       string            `yaml:"index_url,omitempty"`
+	AdditionalURLS []string          `yaml:"additional_urls,omitempty"`
+	TrustedKeys    map[string]string `yaml:"trusted_keys,omitempty"`
+}
+
+type yamlLibManagerConfig struct {
+	IndexURL string `yaml:"index_url,omitempty"`
 }
 
 type yamlProxyConfig struct {
@@ -66,6 +76,9 @@ func (config *Configuration) LoadFromYAML(path *paths.Path) error {
 	if ret.SketchbookPath != "" {
 		config.SketchbookDir = paths.New(ret.SketchbookPath)
 	}
+	if ret.TemplatesPath != "" {
+		config.SketchTemplatesDir = paths.New(ret.TemplatesPath)
+	}
 	if ret.ProxyType != "" {
 		config.ProxyType = ret.ProxyType
 		if ret.ProxyManualConfig != nil {
@@ -75,6 +88,13 @@ func (config *Configuration) LoadFromYAML(path *paths.Path) error {
 		}
 	}
 	if ret.BoardsManager != nil {
+		if ret.BoardsManager.IndexURL != "" {
+			if url, err := url.Parse(ret.BoardsManager.IndexURL); err != nil {
+				logrus.WithError(err).Warn("Error parsing config")
+			} else {
+				config.BoardManagerAdditionalUrls[0] = url
+			}
+		}
 		for _, rawurl := range ret.BoardsManager.AdditionalURLS {
 			url, err := url.Parse(rawurl)
 			if err != nil {
@@ -83,6 +103,34 @@ func (config *Configuration) LoadFromYAML(path *paths.Path) error {
 			}
 			config.BoardManagerAdditionalUrls = append(config.BoardManagerAdditionalUrls, url)
 		}
+		if config.TrustedKeys == nil {
+			config.TrustedKeys = map[string]*paths.Path{}
+		}
+		for rawurl, keyPath := range ret.BoardsManager.TrustedKeys {
+			url, err := url.Parse(rawurl)
+			if err != nil {
+				logrus.WithError(err).Warn("Error parsing config")
+				continue
+			}
+			config.TrustedKeys[url.String()] = paths.New(keyPath)
+		}
+	}
+	if ret.LibrariesManager != nil && ret.LibrariesManager.IndexURL != "" {
+		if url, err := url.Parse(ret.LibrariesManager.IndexURL); err != nil {
+			logrus.WithError(err).Warn("Error parsing config")
+		} else {
+			config.LibrariesIndexURL = url
+		}
+	}
+	switch ret.SignaturePolicy {
+	case "":
+	case IndexSignatureWarn, IndexSignatureStrict:
+		config.IndexSignaturePolicy = ret.SignaturePolicy
+	default:
+		logrus.Warnf("Invalid index signature policy %s, using %s", ret.SignaturePolicy, config.IndexSignaturePolicy)
+	}
+	if ret.ParallelDownloads > 0 {
+		config.MaxParallelDownloads = ret.ParallelDownloads
 	}
 	return nil
 }
@@ -93,6 +141,9 @@ func (config *Configuration) SerializeToYAML() ([]byte, error) {
 	if config.SketchbookDir != nil {
 		c.SketchbookPath = config.SketchbookDir.String()
 	}
+	if config.SketchTemplatesDir != nil {
+		c.TemplatesPath = config.SketchTemplatesDir.String()
+	}
 	if config.DataDir != nil {
 		c.ArduinoDataDir = config.DataDir.String()
 	}
@@ -104,12 +155,29 @@ func (config *Configuration) SerializeToYAML() ([]byte, error) {
 			Password: config.ProxyPassword,
 		}
 	}
-	if len(config.BoardManagerAdditionalUrls) > 1 {
+	customIndex := config.BoardManagerAdditionalUrls[0].String() != defaultPackageIndexURL.String()
+	if len(config.BoardManagerAdditionalUrls) > 1 || len(config.TrustedKeys) > 0 || customIndex {
 		c.BoardsManager = &yamlBoardsManagerConfig{AdditionalURLS: []string{}}
+		if customIndex {
+			c.BoardsManager.IndexURL = config.BoardManagerAdditionalUrls[0].String()
+		}
 		for _, URL := range config.BoardManagerAdditionalUrls[1:] {
 			c.BoardsManager.AdditionalURLS = append(c.BoardsManager.AdditionalURLS, URL.String())
 		}
+		if len(config.TrustedKeys) > 0 {
+			c.BoardsManager.TrustedKeys = map[string]string{}
+			for URL, keyPath := range config.TrustedKeys {
+				c.BoardsManager.TrustedKeys[URL] = keyPath.String()
+			}
+		}
+	}
+	if config.LibrariesIndexURL.String() != defaultLibrariesIndexURL.String() {
+		c.LibrariesManager = &yamlLibManagerConfig{IndexURL: config.LibrariesIndexURL.String()}
+	}
+	if config.IndexSignatureRequired() {
+		c.SignaturePolicy = config.IndexSignaturePolicy
 	}
+	c.ParallelDownloads = config.MaxParallelDownloads
 	return yaml.Marshal(c)
 }
 
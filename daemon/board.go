@@ -0,0 +1,170 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/rpc"
+)
+
+// BoardDetails returns the name, the config options, the required tools,
+// the programmers, the USB IDs, the upload methods and the build properties
+// of the board identified by the given FQBN.
+func (s *ArduinoCoreServerImpl) BoardDetails(ctx context.Context, req *rpc.BoardDetailsReq) (*rpc.BoardDetailsResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.BoardDetails(s.pm, &api.BoardDetailsReq{FQBN: req.Fqbn})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	details := &rpc.BoardDetailsResp{}
+	details.Name = res.Board.Name()
+	for _, option := range res.ConfigOptions {
+		configOption := &rpc.ConfigOption{
+			Option:      option.Option,
+			OptionLabel: option.OptionLabel,
+		}
+		for _, value := range option.Values {
+			configOption.Values = append(configOption.Values, &rpc.ConfigValue{
+				Value:      value.Value,
+				ValueLabel: value.ValueLabel,
+				Selected:   value.Selected,
+				Properties: value.Properties.AsMap(),
+			})
+		}
+		details.ConfigOptions = append(details.ConfigOptions, configOption)
+	}
+
+	for _, tool := range res.RequiredTools {
+		details.RequiredTools = append(details.RequiredTools, &rpc.RequiredTool{
+			Name:     tool.ToolName,
+			Packager: tool.ToolPackager,
+			Version:  tool.ToolVersion.String(),
+		})
+	}
+	for _, programmer := range res.Programmers {
+		details.Programmers = append(details.Programmers, &rpc.Programmer{
+			Id:   programmer.ID,
+			Name: programmer.Name,
+		})
+	}
+	for _, id := range res.USBIDs {
+		details.UsbIds = append(details.UsbIds, &rpc.USBID{Vid: id.VID, Pid: id.PID})
+	}
+	details.UploadMethods = res.UploadMethods
+	details.BuildProperties = res.BuildProperties.AsMap()
+	return details, nil
+}
+
+// BoardList returns the boards detected so far by the discovery running in
+// background.
+func (s *ArduinoCoreServerImpl) BoardList(ctx context.Context, req *rpc.BoardListReq) (*rpc.BoardListResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.BoardList(s.pm, s.discoveries)
+	if err != nil {
+		return nil, rpcError(err)
+	}
+
+	resp := &rpc.BoardListResp{}
+	for _, item := range res.Ports {
+		port := item.Port
+		detectedPort := &rpc.DetectedPort{
+			Address:       port.Address,
+			Label:         port.AddressLabel,
+			Protocol:      port.Protocol,
+			ProtocolLabel: port.ProtocolLabel,
+			Discovery:     item.DiscoveryID,
+		}
+		if port.Properties != nil {
+			detectedPort.Properties = port.Properties.AsMap()
+		}
+		for _, board := range item.Boards {
+			detectedPort.Boards = append(detectedPort.Boards, &rpc.BoardListItem{
+				Name: board.Name(),
+				Fqbn: board.FQBN(),
+			})
+		}
+		resp.Ports = append(resp.Ports, detectedPort)
+
+		// The serial and network boards are reported also in the
+		// original format for compatibility
+		switch port.Protocol {
+		case "serial":
+			serialBoard := &rpc.AttachedSerialBoard{
+				Name:         "unknown",
+				Port:         port.Address,
+				SerialNumber: detectedPort.Properties["serialNumber"],
+				ProductId:    detectedPort.Properties["pid"],
+				VendorId:     detectedPort.Properties["vid"],
+			}
+			if len(item.Boards) > 0 {
+				serialBoard.Name = item.Boards[0].Name()
+				serialBoard.Fqbn = item.Boards[0].FQBN()
+			}
+			resp.Serial = append(resp.Serial, serialBoard)
+		case "network":
+			if len(item.Boards) == 0 {
+				continue
+			}
+			// Info was made of the mDNS TXT records
+			info := []string{}
+			for _, key := range port.Properties.Keys() {
+				if key != "name" && key != "hostname" && key != "port" {
+					info = append(info, key+"="+port.Properties.Get(key))
+				}
+			}
+			tcpPort, _ := strconv.ParseUint(port.Properties.Get("port"), 10, 64)
+			resp.Network = append(resp.Network, &rpc.AttachedNetworkBoard{
+				Name:    item.Boards[0].Name(),
+				Fqbn:    item.Boards[0].FQBN(),
+				Info:    strings.Join(info, " "),
+				Address: port.Address,
+				Port:    tcpPort,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// BoardListAll returns all the boards provided by the installed platforms
+// whose name contains all the search args.
+func (s *ArduinoCoreServerImpl) BoardListAll(ctx context.Context, req *rpc.BoardListAllReq) (*rpc.BoardListAllResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.BoardListAll(s.pm, &api.BoardListAllReq{SearchArgs: req.SearchArgs})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	list := &rpc.BoardListAllResp{}
+	for _, board := range res.Boards {
+		list.Boards = append(list.Boards, &rpc.BoardListItem{
+			Name: board.Name(),
+			Fqbn: board.FQBN(),
+		})
+	}
+	return list, nil
+}
@@ -0,0 +1,141 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/cores"
+	"github.com/arduino/arduino-cli/rpc"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// Compile compiles a sketch, the output of the build is streamed back to
+// the client.
+func (s *ArduinoCoreServerImpl) Compile(req *rpc.CompileReq, stream rpc.ArduinoCore_CompileServer) error {
+	stdout, stderr := outputStreams(func(out, err []byte) {
+		stream.Send(&rpc.CompileResp{OutStream: out, ErrStream: err})
+	})
+
+	// Installing ctags changes the PackageManager so it needs the write
+	// lock, the build itself only needs to read it.
+	s.mux.Lock()
+	err := api.EnsureCtagsInstalled(s.pm, s.Config,
+		func(*api.DownloadProgress) {},
+		func(p *api.TaskProgress) {
+			if p.Message != "" {
+				fmt.Fprintln(stdout, p.Message)
+			}
+		})
+	s.mux.Unlock()
+	if err != nil {
+		return rpcError(err)
+	}
+
+	compileReq := &api.CompileReq{
+		FQBN:            req.Fqbn,
+		ShowProperties:  req.ShowProperties,
+		Preprocess:      req.Preprocess,
+		BuildProperties: req.BuildProperties,
+		Warnings:        req.Warnings,
+		Verbose:         req.Verbose,
+		VidPid:          req.VidPid,
+	}
+	if req.SketchPath != "" {
+		compileReq.SketchPath = paths.New(req.SketchPath)
+	}
+	if req.BuildCachePath != "" {
+		compileReq.BuildCachePath = paths.New(req.BuildCachePath)
+	}
+	if req.BuildPath != "" {
+		compileReq.BuildPath = paths.New(req.BuildPath)
+	}
+	if req.ExportFile != "" {
+		compileReq.ExportFile = paths.New(req.ExportFile)
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	res, err := api.Compile(s.pm, s.Config, compileReq, stdout, stderr)
+	if res != nil && !req.ShowProperties && !req.Preprocess {
+		stream.Send(&rpc.CompileResp{Result: compileResultToRPC(res)})
+	}
+	if missing, ok := err.(*api.MissingIncludeError); ok {
+		s.printMissingIncludes(stderr, missing)
+	}
+	return rpcError(err)
+}
+
+// printMissingIncludes writes the libraries providing the headers not
+// found by the build, the clients can use LibrarySearch to get them.
+func (s *ArduinoCoreServerImpl) printMissingIncludes(w io.Writer, missing *api.MissingIncludeError) {
+	for _, header := range missing.Headers {
+		res, err := api.LibrarySearch(s.lm, &api.LibrarySearchReq{Header: header, Architecture: missing.Architecture})
+		if err != nil || len(res.Libraries) == 0 {
+			fmt.Fprintf(w, "%s not found, no library in the index provides it.\n", header)
+			continue
+		}
+		fmt.Fprintf(w, "%s not found, it's provided by the libraries:\n", header)
+		for _, lib := range res.Libraries {
+			fmt.Fprintf(w, "  %s\n", lib.Name)
+		}
+	}
+}
+
+func compileResultToRPC(res *api.CompileResult) *rpc.CompileResult {
+	rpcRes := &rpc.CompileResult{
+		BuildPath:      res.BuildPath.String(),
+		BuildArtifacts: res.BuildArtifacts.AsStrings(),
+		ExportedFiles:  res.ExportedFiles.AsStrings(),
+	}
+	if res.BoardPlatform != nil {
+		rpcRes.BoardPlatform = buildPlatformToRPC(res.BoardPlatform)
+	}
+	if res.CorePlatform != nil && res.CorePlatform != res.BoardPlatform {
+		rpcRes.CorePlatform = buildPlatformToRPC(res.CorePlatform)
+	}
+	for _, tool := range res.Tools {
+		rpcRes.Tools = append(rpcRes.Tools, &rpc.BuildTool{
+			Id:      tool.Tool.String(),
+			Version: tool.Version.String(),
+		})
+	}
+	for _, lib := range res.UsedLibraries {
+		rpcRes.UsedLibraries = append(rpcRes.UsedLibraries, libraryToRPC(lib))
+	}
+	if res.Size != nil {
+		rpcRes.Size = &rpc.SizeReport{
+			ProgramSize:    int64(res.Size.ProgramSize),
+			ProgramMaxSize: int64(res.Size.ProgramMaxSize),
+			DataSize:       int64(res.Size.DataSize),
+			DataMaxSize:    int64(res.Size.DataMaxSize),
+			EepromSize:     int64(res.Size.EEPROMSize),
+		}
+	}
+	return rpcRes
+}
+
+func buildPlatformToRPC(platform *cores.PlatformRelease) *rpc.BuildPlatform {
+	return &rpc.BuildPlatform{
+		Id:         platform.Platform.String(),
+		Version:    platform.Version.String(),
+		InstallDir: platform.InstallDir.String(),
+	}
+}
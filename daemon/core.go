@@ -0,0 +1,214 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/rpc"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// UpdateIndex downloads the latest version of all the platform indexes.
+func (s *ArduinoCoreServerImpl) UpdateIndex(req *rpc.UpdateIndexReq, stream rpc.ArduinoCore_UpdateIndexServer) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err := api.UpdateIndex(s.Config,
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.UpdateIndexResp{DownloadProgress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.UpdateIndexResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// parsePlatformReference builds a PlatformReference from the fields of a
+// request, the version may be empty.
+func parsePlatformReference(packager, arch, version string) (*packagemanager.PlatformReference, error) {
+	if packager == "" || arch == "" {
+		return nil, &api.InvalidArgumentError{Message: fmt.Sprintf("invalid platform reference %s:%s", packager, arch)}
+	}
+	ref := &packagemanager.PlatformReference{
+		Package:              packager,
+		PlatformArchitecture: arch,
+	}
+	if version != "" {
+		v, err := semver.Parse(version)
+		if err != nil {
+			return nil, &api.InvalidArgumentError{Message: "invalid version " + version, Cause: err}
+		}
+		ref.PlatformVersion = v
+	}
+	return ref, nil
+}
+
+// PlatformDownload downloads a platform and its tool dependencies.
+func (s *ArduinoCoreServerImpl) PlatformDownload(req *rpc.PlatformDownloadReq, stream rpc.ArduinoCore_PlatformDownloadServer) error {
+	ref, err := parsePlatformReference(req.PlatformPackage, req.Architecture, req.Version)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	err = api.PlatformDownload(s.pm, &api.PlatformDownloadReq{Platform: ref},
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.PlatformDownloadResp{Progress: downloadProgressToRPC(p)})
+		})
+	return rpcError(err)
+}
+
+// PlatformInstall downloads and installs a platform and its tool
+// dependencies.
+func (s *ArduinoCoreServerImpl) PlatformInstall(req *rpc.PlatformInstallReq, stream rpc.ArduinoCore_PlatformInstallServer) error {
+	ref, err := parsePlatformReference(req.PlatformPackage, req.Architecture, req.Version)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err = api.PlatformInstall(s.pm, &api.PlatformInstallReq{Platform: ref},
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.PlatformInstallResp{Progress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.PlatformInstallResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// PlatformUninstall removes a platform and the tools that are no more
+// required by other platforms.
+func (s *ArduinoCoreServerImpl) PlatformUninstall(req *rpc.PlatformUninstallReq, stream rpc.ArduinoCore_PlatformUninstallServer) error {
+	ref, err := parsePlatformReference(req.PlatformPackage, req.Architecture, req.Version)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err = api.PlatformUninstall(s.pm, &api.PlatformUninstallReq{Platform: ref},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.PlatformUninstallResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// PlatformUpgrade upgrades an installed platform to the latest version.
+func (s *ArduinoCoreServerImpl) PlatformUpgrade(req *rpc.PlatformUpgradeReq, stream rpc.ArduinoCore_PlatformUpgradeServer) error {
+	ref, err := parsePlatformReference(req.PlatformPackage, req.Architecture, "")
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err = api.PlatformUpgrade(s.pm, &api.PlatformUpgradeReq{Platform: ref},
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.PlatformUpgradeResp{Progress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.PlatformUpgradeResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// PlatformRollback restores the release of a platform replaced by the
+// last install or upgrade.
+func (s *ArduinoCoreServerImpl) PlatformRollback(req *rpc.PlatformRollbackReq, stream rpc.ArduinoCore_PlatformRollbackServer) error {
+	ref, err := parsePlatformReference(req.PlatformPackage, req.Architecture, "")
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err = api.PlatformRollback(s.pm, &api.PlatformRollbackReq{Platform: ref},
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.PlatformRollbackResp{Progress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.PlatformRollbackResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// PlatformSearch searches the platform indexes for the given keywords or
+// USB VID:PID.
+func (s *ArduinoCoreServerImpl) PlatformSearch(ctx context.Context, req *rpc.PlatformSearchReq) (*rpc.PlatformSearchResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.PlatformSearch(s.pm, &api.PlatformSearchReq{Query: req.SearchArgs})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	out := []*rpc.Platform{}
+	for _, platformRelease := range res.Platforms {
+		out = append(out, &rpc.Platform{
+			Id:     platformRelease.Platform.String(),
+			Name:   platformRelease.Platform.Name,
+			Latest: platformRelease.Version.String(),
+		})
+	}
+	return &rpc.PlatformSearchResp{SearchOutput: out}, nil
+}
+
+// PlatformList returns the installed platforms.
+func (s *ArduinoCoreServerImpl) PlatformList(ctx context.Context, req *rpc.PlatformListReq) (*rpc.PlatformListResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.PlatformList(s.pm, &api.PlatformListReq{UpdatableOnly: req.UpdatableOnly})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	installed := []*rpc.Platform{}
+	for _, platform := range res.Platforms {
+		p := &rpc.Platform{
+			Id:        platform.Installed.Platform.String(),
+			Installed: platform.Installed.Version.String(),
+			Name:      platform.Installed.Platform.Name,
+		}
+		if platform.Latest != nil {
+			p.Latest = platform.Latest.Version.String()
+		}
+		installed = append(installed, p)
+	}
+	return &rpc.PlatformListResp{InstalledPlatform: installed}, nil
+}
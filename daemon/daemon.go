@@ -0,0 +1,208 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+// Package daemon implements the ArduinoCore gRPC service served by
+// `arduino-cli daemon`.
+package daemon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/cores/packagemanager"
+	"github.com/arduino/arduino-cli/arduino/discovery"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesmanager"
+	"github.com/arduino/arduino-cli/configs"
+	"github.com/arduino/arduino-cli/rpc"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ArduinoCoreServerImpl implements the ArduinoCore gRPC service. A single
+// PackageManager and LibrariesManager are kept loaded for the whole life
+// of the server and are reloaded only after an operation that changes the
+// installed cores or libraries.
+type ArduinoCoreServerImpl struct {
+	rpc.UnimplementedArduinoCoreServer
+
+	Config        *configs.Configuration
+	VersionString string
+
+	// mux protects pm, lm and discoveries: read-only calls hold a read lock while
+	// install/uninstall/update calls hold the write lock.
+	mux sync.RWMutex
+	pm  *packagemanager.PackageManager
+	lm  *librariesmanager.LibrariesManager
+
+	// discoveries are restarted by rescan since the pluggable discoveries
+	// depend on the installed platforms.
+	discoveries []discovery.Discovery
+}
+
+// NewArduinoCoreServer creates a new ArduinoCoreServerImpl using the given
+// configuration and loads hardware and libraries.
+func NewArduinoCoreServer(config *configs.Configuration, version string) (*ArduinoCoreServerImpl, error) {
+	s := &ArduinoCoreServerImpl{
+		Config:        config,
+		VersionString: version,
+	}
+	if err := s.rescan(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// rescan loads a new PackageManager and LibrariesManager from disk and
+// replaces the current ones. The caller must hold the write lock (or be
+// the constructor).
+func (s *ArduinoCoreServerImpl) rescan() error {
+	pm, err := loadPackageManager(s.Config)
+	if err != nil {
+		return err
+	}
+	lm, err := loadLibrariesManager(s.Config, pm)
+	if err != nil {
+		return err
+	}
+	s.pm = pm
+	s.lm = lm
+
+	// The discoveries are kept running in background so BoardList can
+	// answer immediately with the boards detected so far.
+	api.QuitDiscoveries(s.discoveries)
+	s.discoveries = api.StartDiscoveries(api.NewDiscoveries(pm))
+	return nil
+}
+
+// loadPackageManager loads hardware like api.LoadPackageManager but
+// tolerates broken package indexes: the client may call UpdateIndex and
+// then Rescan to fix them.
+func loadPackageManager(config *configs.Configuration) (*packagemanager.PackageManager, error) {
+	logrus.Info("Initializing package manager")
+
+	pm := api.NewPackageManager(config)
+	if err := api.LoadPackageIndexes(pm, config); err != nil {
+		logrus.WithError(err).Warn("Error loading package indexes")
+	}
+	if err := pm.LoadHardware(config); err != nil {
+		return nil, fmt.Errorf("loading hardware packages: %s", err)
+	}
+	return pm, nil
+}
+
+// loadLibrariesManager loads libraries like api.LoadLibrariesManager but
+// tolerates a missing libraries index, that may be fixed by the client
+// with UpdateLibrariesIndex.
+func loadLibrariesManager(config *configs.Configuration, pm *packagemanager.PackageManager) (*librariesmanager.LibrariesManager, error) {
+	logrus.Info("Starting libraries manager")
+
+	lm := api.NewLibrariesManager(config, pm)
+	if err := api.LoadLibrariesIndex(lm, config); err != nil {
+		logrus.WithError(err).Warn("Error during libraries index loading")
+		lm.Index = &librariesindex.Index{Libraries: map[string]*librariesindex.Library{}}
+	}
+	if err := lm.RescanLibraries(); err != nil {
+		return nil, fmt.Errorf("rescanning libraries: %s", err)
+	}
+	return lm, nil
+}
+
+// Version returns the version of the running daemon.
+func (s *ArduinoCoreServerImpl) Version(ctx context.Context, req *rpc.VersionReq) (*rpc.VersionResp, error) {
+	return &rpc.VersionResp{Version: s.VersionString}, nil
+}
+
+// Rescan reloads hardware and libraries from disk.
+func (s *ArduinoCoreServerImpl) Rescan(ctx context.Context, req *rpc.RescanReq) (*rpc.RescanResp, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := s.rescan(); err != nil {
+		return nil, err
+	}
+	return &rpc.RescanResp{}, nil
+}
+
+// rpcError converts an error returned by the api package to a gRPC error
+// with the matching status code.
+func rpcError(err error) error {
+	if err == nil {
+		return nil
+	}
+	code := codes.Unknown
+	switch err.(type) {
+	case *api.InvalidArgumentError:
+		code = codes.InvalidArgument
+	case *api.NotFoundError:
+		code = codes.NotFound
+	case *api.FailedPreconditionError, *api.MissingIncludeError:
+		code = codes.FailedPrecondition
+	case *api.NetworkError:
+		code = codes.Unavailable
+	case *api.ConfigurationError:
+		code = codes.Internal
+	}
+	return status.Error(code, err.Error())
+}
+
+func downloadProgressToRPC(p *api.DownloadProgress) *rpc.DownloadProgress {
+	return &rpc.DownloadProgress{
+		Url:        p.URL,
+		File:       p.File,
+		TotalSize:  p.TotalSize,
+		Downloaded: p.Downloaded,
+		Completed:  p.Completed,
+	}
+}
+
+func taskProgressToRPC(p *api.TaskProgress) *rpc.TaskProgress {
+	return &rpc.TaskProgress{
+		Name:      p.Name,
+		Message:   p.Message,
+		Completed: p.Completed,
+	}
+}
+
+// writerFunc is an io.Writer that forwards all the data written to a function.
+type writerFunc func(data []byte)
+
+func (w writerFunc) Write(data []byte) (int, error) {
+	w(data)
+	return len(data), nil
+}
+
+// outputStreams returns two io.Writer, to be used as stdout and stderr,
+// that forward the written data to send. The calls to send are serialized
+// since a gRPC stream can't be used concurrently.
+func outputStreams(send func(out, err []byte)) (stdout io.Writer, stderr io.Writer) {
+	var mux sync.Mutex
+	stdout = writerFunc(func(data []byte) {
+		mux.Lock()
+		defer mux.Unlock()
+		send(data, nil)
+	})
+	stderr = writerFunc(func(data []byte) {
+		mux.Lock()
+		defer mux.Unlock()
+		send(nil, data)
+	})
+	return
+}
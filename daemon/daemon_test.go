@@ -0,0 +1,111 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/arduino/arduino-cli/configs"
+	"github.com/arduino/arduino-cli/rpc"
+	"github.com/arduino/go-paths-helper"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) *ArduinoCoreServerImpl {
+	noIDE := false
+	librariesIndexURL, err := url.Parse("https://downloads.arduino.cc/libraries/library_index.json")
+	require.NoError(t, err)
+	s := &ArduinoCoreServerImpl{
+		Config: &configs.Configuration{
+			DataDir:               paths.New("testdata", "data_dir"),
+			SketchbookDir:         paths.New("testdata", "sketchbook"),
+			LibrariesIndexURL:     librariesIndexURL,
+			IDEBundledCheckResult: &noIDE,
+		},
+		VersionString: "test",
+	}
+	require.NoError(t, s.rescan())
+	return s
+}
+
+func TestVersion(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.Version(context.Background(), &rpc.VersionReq{})
+	require.NoError(t, err)
+	require.Equal(t, "test", resp.Version)
+}
+
+func TestBoardListAll(t *testing.T) {
+	s := newTestServer(t)
+
+	resp, err := s.BoardListAll(context.Background(), &rpc.BoardListAllReq{})
+	require.NoError(t, err)
+	require.Len(t, resp.Boards, 2)
+	require.Equal(t, "Test Mega", resp.Boards[0].Name)
+	require.Equal(t, "test:avr:mega", resp.Boards[0].Fqbn)
+	require.Equal(t, "Test Uno", resp.Boards[1].Name)
+
+	resp, err = s.BoardListAll(context.Background(), &rpc.BoardListAllReq{SearchArgs: []string{"uno"}})
+	require.NoError(t, err)
+	require.Len(t, resp.Boards, 1)
+	require.Equal(t, "test:avr:uno", resp.Boards[0].Fqbn)
+}
+
+func TestBoardDetails(t *testing.T) {
+	s := newTestServer(t)
+
+	resp, err := s.BoardDetails(context.Background(), &rpc.BoardDetailsReq{Fqbn: "test:avr:mega:cpu=atmega1280"})
+	require.NoError(t, err)
+	require.Equal(t, "Test Mega", resp.Name)
+	require.Len(t, resp.ConfigOptions, 1)
+	option := resp.ConfigOptions[0]
+	require.Equal(t, "cpu", option.Option)
+	require.Equal(t, "Processor", option.OptionLabel)
+	require.Len(t, option.Values, 2)
+	require.False(t, option.Values[0].Selected)
+	require.True(t, option.Values[1].Selected)
+	require.Equal(t, "atmega1280", option.Values[1].Properties["build.mcu"])
+	require.Equal(t, "atmega1280", resp.BuildProperties["build.mcu"])
+
+	_, err = s.BoardDetails(context.Background(), &rpc.BoardDetailsReq{Fqbn: "test:avr:notexistent"})
+	require.Error(t, err)
+}
+
+func TestPlatformList(t *testing.T) {
+	s := newTestServer(t)
+
+	resp, err := s.PlatformList(context.Background(), &rpc.PlatformListReq{})
+	require.NoError(t, err)
+	require.Len(t, resp.InstalledPlatform, 1)
+	require.Equal(t, "test:avr", resp.InstalledPlatform[0].Id)
+	require.Equal(t, "1.0.0", resp.InstalledPlatform[0].Installed)
+}
+
+func TestLibraryList(t *testing.T) {
+	s := newTestServer(t)
+
+	resp, err := s.LibraryList(context.Background(), &rpc.LibraryListReq{})
+	require.NoError(t, err)
+	require.Len(t, resp.InstalledLibrary, 1)
+	lib := resp.InstalledLibrary[0].Library
+	require.Equal(t, "TestLib", lib.Name)
+	require.Equal(t, "1.2.3", lib.Version)
+	require.Equal(t, "sketchbook", lib.Location)
+}
@@ -0,0 +1,261 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"context"
+
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/arduino/libraries"
+	"github.com/arduino/arduino-cli/arduino/libraries/librariesindex"
+	"github.com/arduino/arduino-cli/rpc"
+	paths "github.com/arduino/go-paths-helper"
+	semver "go.bug.st/relaxed-semver"
+)
+
+// UpdateLibrariesIndex downloads the latest version of the libraries index.
+func (s *ArduinoCoreServerImpl) UpdateLibrariesIndex(req *rpc.UpdateLibrariesIndexReq, stream rpc.ArduinoCore_UpdateLibrariesIndexServer) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	err := api.UpdateLibrariesIndex(s.lm, s.Config,
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.UpdateLibrariesIndexResp{DownloadProgress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.UpdateLibrariesIndexResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// parseLibraryReference builds a library Reference from the fields of a
+// request, the version may be empty.
+func parseLibraryReference(name, version string) (*librariesindex.Reference, error) {
+	if name == "" {
+		return nil, &api.InvalidArgumentError{Message: "missing library name"}
+	}
+	ref := &librariesindex.Reference{Name: name}
+	if version != "" {
+		v, err := semver.Parse(version)
+		if err != nil {
+			return nil, &api.InvalidArgumentError{Message: "invalid version " + version, Cause: err}
+		}
+		ref.Version = v
+	}
+	return ref, nil
+}
+
+// LibraryDownload downloads a library without installing it.
+func (s *ArduinoCoreServerImpl) LibraryDownload(req *rpc.LibraryDownloadReq, stream rpc.ArduinoCore_LibraryDownloadServer) error {
+	ref, err := parseLibraryReference(req.Name, req.Version)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	err = api.LibraryDownload(s.lm, &api.LibraryDownloadReq{Library: ref},
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.LibraryDownloadResp{Progress: downloadProgressToRPC(p)})
+		})
+	return rpcError(err)
+}
+
+// LibraryInstall downloads and installs a library together with its
+// dependencies.
+func (s *ArduinoCoreServerImpl) LibraryInstall(req *rpc.LibraryInstallReq, stream rpc.ArduinoCore_LibraryInstallServer) error {
+	if req.GitUrl != "" || req.ZipPath != "" {
+		return s.libraryInstallFromSource(req, stream)
+	}
+	ref, err := parseLibraryReference(req.Name, req.Version)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err = api.LibraryInstall(s.lm, &api.LibraryInstallReq{Libraries: []*librariesindex.Reference{ref}, NoDeps: req.NoDeps},
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.LibraryInstallResp{Progress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.LibraryInstallResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// libraryInstallFromSource installs a library from the git repository or
+// the zip file in the request.
+func (s *ArduinoCoreServerImpl) libraryInstallFromSource(req *rpc.LibraryInstallReq, stream rpc.ArduinoCore_LibraryInstallServer) error {
+	taskCB := func(p *api.TaskProgress) {
+		stream.Send(&rpc.LibraryInstallResp{TaskProgress: taskProgressToRPC(p)})
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	var err error
+	if req.GitUrl != "" {
+		_, err = api.LibraryInstallGit(s.lm, &api.LibraryInstallGitReq{URL: req.GitUrl}, taskCB)
+	} else {
+		_, err = api.LibraryInstallZip(s.lm, &api.LibraryInstallZipReq{ZipPath: paths.New(req.ZipPath)}, taskCB)
+	}
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// LibraryUninstall removes an installed library.
+func (s *ArduinoCoreServerImpl) LibraryUninstall(req *rpc.LibraryUninstallReq, stream rpc.ArduinoCore_LibraryUninstallServer) error {
+	ref, err := parseLibraryReference(req.Name, req.Version)
+	if err != nil {
+		return rpcError(err)
+	}
+
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	err = api.LibraryUninstall(s.lm, &api.LibraryUninstallReq{Library: ref},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.LibraryUninstallResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// LibraryUpgradeAll upgrades all the installed libraries to the latest
+// available version.
+func (s *ArduinoCoreServerImpl) LibraryUpgradeAll(req *rpc.LibraryUpgradeAllReq, stream rpc.ArduinoCore_LibraryUpgradeAllServer) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	err := api.LibraryUpgradeAll(s.lm,
+		func(p *api.DownloadProgress) {
+			stream.Send(&rpc.LibraryUpgradeAllResp{Progress: downloadProgressToRPC(p)})
+		},
+		func(p *api.TaskProgress) {
+			stream.Send(&rpc.LibraryUpgradeAllResp{TaskProgress: taskProgressToRPC(p)})
+		})
+	if err != nil {
+		return rpcError(err)
+	}
+	return s.rescan()
+}
+
+// LibrarySearch searches the libraries index for libraries matching the
+// query, the most relevant first, or, if req.Header is set, for the
+// libraries providing the header.
+func (s *ArduinoCoreServerImpl) LibrarySearch(ctx context.Context, req *rpc.LibrarySearchReq) (*rpc.LibrarySearchResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.LibrarySearch(s.lm, &api.LibrarySearchReq{
+		Query:        req.Query,
+		Header:       req.Header,
+		Architecture: req.Architecture,
+		Category:     req.Category,
+		Types:        req.Types,
+		Offset:       int(req.Offset),
+		Limit:        int(req.Limit),
+	})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	out := []*rpc.SearchedLibrary{}
+	for _, lib := range res.Libraries {
+		versions := []string{}
+		for _, v := range lib.Versions() {
+			versions = append(versions, v.String())
+		}
+		out = append(out, &rpc.SearchedLibrary{
+			Name:              lib.Name,
+			Latest:            libraryReleaseToRPC(lib.Latest),
+			AvailableVersions: versions,
+		})
+	}
+	return &rpc.LibrarySearchResp{Libraries: out, Total: int32(res.Total)}, nil
+}
+
+// LibraryList returns the installed libraries. Libraries bundled with the
+// installed platforms are included only if req.All is set.
+func (s *ArduinoCoreServerImpl) LibraryList(ctx context.Context, req *rpc.LibraryListReq) (*rpc.LibraryListResp, error) {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	res, err := api.LibraryList(s.lm, &api.LibraryListReq{All: req.All, Updatable: req.Updatable})
+	if err != nil {
+		return nil, rpcError(err)
+	}
+	out := []*rpc.InstalledLibrary{}
+	for _, lib := range res.Libraries {
+		out = append(out, &rpc.InstalledLibrary{
+			Library: libraryToRPC(lib.Library),
+			Release: libraryReleaseToRPC(lib.Available),
+		})
+	}
+	return &rpc.LibraryListResp{InstalledLibrary: out}, nil
+}
+
+func libraryReleaseToRPC(release *librariesindex.Release) *rpc.LibraryRelease {
+	if release == nil {
+		return nil
+	}
+	return &rpc.LibraryRelease{
+		Author:        release.Author,
+		Version:       release.Version.String(),
+		Maintainer:    release.Maintainer,
+		Sentence:      release.Sentence,
+		Paragraph:     release.Paragraph,
+		Website:       release.Website,
+		Category:      release.Category,
+		Architectures: release.Architectures,
+		Types:         release.Types,
+	}
+}
+
+func libraryToRPC(lib *libraries.Library) *rpc.Library {
+	res := &rpc.Library{
+		Name:          lib.Name,
+		Author:        lib.Author,
+		Maintainer:    lib.Maintainer,
+		Sentence:      lib.Sentence,
+		Paragraph:     lib.Paragraph,
+		Website:       lib.Website,
+		Category:      lib.Category,
+		Architectures: lib.Architectures,
+		Types:         lib.Types,
+		Location:      lib.Location.String(),
+	}
+	if lib.InstallDir != nil {
+		res.InstallDir = lib.InstallDir.String()
+	}
+	if lib.Version != nil {
+		res.Version = lib.Version.String()
+	}
+	if lib.InstalledFrom != nil {
+		res.InstalledFrom = lib.InstalledFrom.String()
+	}
+	return res
+}
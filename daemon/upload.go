@@ -0,0 +1,70 @@
+/*
+ * This file is part of arduino-cli.
+ *
+ * Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+ *
+ * This software is released under the GNU General Public License version 3,
+ * which covers the main part of arduino-cli.
+ * The terms of this license can be found at:
+ * https://www.gnu.org/licenses/gpl-3.0.en.html
+ *
+ * You can be released from the requirements of the above licenses by purchasing
+ * a commercial license. Buying such a license is mandatory if you want to modify or
+ * otherwise use the software for commercial activities involving the Arduino
+ * software without disclosing the source code of your own applications. To purchase
+ * a commercial license, send an email to license@arduino.cc.
+ */
+
+package daemon
+
+import (
+	"github.com/arduino/arduino-cli/api"
+	"github.com/arduino/arduino-cli/rpc"
+	paths "github.com/arduino/go-paths-helper"
+)
+
+// Upload uploads a compiled sketch to a board, the output of the upload
+// tool is streamed back to the client.
+func (s *ArduinoCoreServerImpl) Upload(req *rpc.UploadReq, stream rpc.ArduinoCore_UploadServer) error {
+	stdout, stderr := outputStreams(func(out, err []byte) {
+		stream.Send(&rpc.UploadResp{OutStream: out, ErrStream: err})
+	})
+
+	uploadReq := &api.UploadReq{
+		FQBN:       req.Fqbn,
+		Port:       req.Port,
+		Verbose:    req.Verbose,
+		Verify:     req.Verify,
+		Programmer: req.Programmer,
+		Password:   req.Password,
+	}
+	if req.SketchPath != "" {
+		uploadReq.SketchPath = paths.New(req.SketchPath)
+	}
+	if req.ImportFile != "" {
+		uploadReq.ImportFile = paths.New(req.ImportFile)
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return rpcError(api.Upload(s.pm, uploadReq, stdout, stderr))
+}
+
+// BurnBootloader writes the bootloader of a board using a programmer.
+func (s *ArduinoCoreServerImpl) BurnBootloader(req *rpc.BurnBootloaderReq, stream rpc.ArduinoCore_BurnBootloaderServer) error {
+	stdout, stderr := outputStreams(func(out, err []byte) {
+		stream.Send(&rpc.BurnBootloaderResp{OutStream: out, ErrStream: err})
+	})
+
+	burnReq := &api.BurnBootloaderReq{
+		FQBN:       req.Fqbn,
+		Port:       req.Port,
+		Programmer: req.Programmer,
+		Verbose:    req.Verbose,
+		Verify:     req.Verify,
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return rpcError(api.BurnBootloader(s.pm, burnReq, stdout, stderr))
+}
@@ -0,0 +1,1327 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: commands.proto
+
+package rpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ArduinoCore_Version_FullMethodName              = "/cc.arduino.cli.rpc.v1.ArduinoCore/Version"
+	ArduinoCore_Rescan_FullMethodName               = "/cc.arduino.cli.rpc.v1.ArduinoCore/Rescan"
+	ArduinoCore_UpdateIndex_FullMethodName          = "/cc.arduino.cli.rpc.v1.ArduinoCore/UpdateIndex"
+	ArduinoCore_UpdateLibrariesIndex_FullMethodName = "/cc.arduino.cli.rpc.v1.ArduinoCore/UpdateLibrariesIndex"
+	ArduinoCore_BoardDetails_FullMethodName         = "/cc.arduino.cli.rpc.v1.ArduinoCore/BoardDetails"
+	ArduinoCore_BoardList_FullMethodName            = "/cc.arduino.cli.rpc.v1.ArduinoCore/BoardList"
+	ArduinoCore_BoardListAll_FullMethodName         = "/cc.arduino.cli.rpc.v1.ArduinoCore/BoardListAll"
+	ArduinoCore_Compile_FullMethodName              = "/cc.arduino.cli.rpc.v1.ArduinoCore/Compile"
+	ArduinoCore_Upload_FullMethodName               = "/cc.arduino.cli.rpc.v1.ArduinoCore/Upload"
+	ArduinoCore_BurnBootloader_FullMethodName       = "/cc.arduino.cli.rpc.v1.ArduinoCore/BurnBootloader"
+	ArduinoCore_PlatformInstall_FullMethodName      = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformInstall"
+	ArduinoCore_PlatformDownload_FullMethodName     = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformDownload"
+	ArduinoCore_PlatformUninstall_FullMethodName    = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformUninstall"
+	ArduinoCore_PlatformUpgrade_FullMethodName      = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformUpgrade"
+	ArduinoCore_PlatformRollback_FullMethodName     = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformRollback"
+	ArduinoCore_PlatformSearch_FullMethodName       = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformSearch"
+	ArduinoCore_PlatformList_FullMethodName         = "/cc.arduino.cli.rpc.v1.ArduinoCore/PlatformList"
+	ArduinoCore_LibraryDownload_FullMethodName      = "/cc.arduino.cli.rpc.v1.ArduinoCore/LibraryDownload"
+	ArduinoCore_LibraryInstall_FullMethodName       = "/cc.arduino.cli.rpc.v1.ArduinoCore/LibraryInstall"
+	ArduinoCore_LibraryUninstall_FullMethodName     = "/cc.arduino.cli.rpc.v1.ArduinoCore/LibraryUninstall"
+	ArduinoCore_LibraryUpgradeAll_FullMethodName    = "/cc.arduino.cli.rpc.v1.ArduinoCore/LibraryUpgradeAll"
+	ArduinoCore_LibrarySearch_FullMethodName        = "/cc.arduino.cli.rpc.v1.ArduinoCore/LibrarySearch"
+	ArduinoCore_LibraryList_FullMethodName          = "/cc.arduino.cli.rpc.v1.ArduinoCore/LibraryList"
+)
+
+// ArduinoCoreClient is the client API for ArduinoCore service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ArduinoCoreClient interface {
+	// Returns the version of the running daemon.
+	Version(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionResp, error)
+	// Reload hardware and libraries from disk, useful when the data directory
+	// or the sketchbook have been modified by another program.
+	Rescan(ctx context.Context, in *RescanReq, opts ...grpc.CallOption) (*RescanResp, error)
+	// Download the latest version of all the platform indexes.
+	UpdateIndex(ctx context.Context, in *UpdateIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateIndexClient, error)
+	// Download the latest version of the libraries index.
+	UpdateLibrariesIndex(ctx context.Context, in *UpdateLibrariesIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateLibrariesIndexClient, error)
+	BoardDetails(ctx context.Context, in *BoardDetailsReq, opts ...grpc.CallOption) (*BoardDetailsResp, error)
+	BoardList(ctx context.Context, in *BoardListReq, opts ...grpc.CallOption) (*BoardListResp, error)
+	BoardListAll(ctx context.Context, in *BoardListAllReq, opts ...grpc.CallOption) (*BoardListAllResp, error)
+	Compile(ctx context.Context, in *CompileReq, opts ...grpc.CallOption) (ArduinoCore_CompileClient, error)
+	Upload(ctx context.Context, in *UploadReq, opts ...grpc.CallOption) (ArduinoCore_UploadClient, error)
+	BurnBootloader(ctx context.Context, in *BurnBootloaderReq, opts ...grpc.CallOption) (ArduinoCore_BurnBootloaderClient, error)
+	PlatformInstall(ctx context.Context, in *PlatformInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformInstallClient, error)
+	PlatformDownload(ctx context.Context, in *PlatformDownloadReq, opts ...grpc.CallOption) (ArduinoCore_PlatformDownloadClient, error)
+	PlatformUninstall(ctx context.Context, in *PlatformUninstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUninstallClient, error)
+	PlatformUpgrade(ctx context.Context, in *PlatformUpgradeReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUpgradeClient, error)
+	PlatformRollback(ctx context.Context, in *PlatformRollbackReq, opts ...grpc.CallOption) (ArduinoCore_PlatformRollbackClient, error)
+	PlatformSearch(ctx context.Context, in *PlatformSearchReq, opts ...grpc.CallOption) (*PlatformSearchResp, error)
+	PlatformList(ctx context.Context, in *PlatformListReq, opts ...grpc.CallOption) (*PlatformListResp, error)
+	LibraryDownload(ctx context.Context, in *LibraryDownloadReq, opts ...grpc.CallOption) (ArduinoCore_LibraryDownloadClient, error)
+	LibraryInstall(ctx context.Context, in *LibraryInstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryInstallClient, error)
+	LibraryUninstall(ctx context.Context, in *LibraryUninstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUninstallClient, error)
+	LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUpgradeAllClient, error)
+	LibrarySearch(ctx context.Context, in *LibrarySearchReq, opts ...grpc.CallOption) (*LibrarySearchResp, error)
+	LibraryList(ctx context.Context, in *LibraryListReq, opts ...grpc.CallOption) (*LibraryListResp, error)
+}
+
+type arduinoCoreClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewArduinoCoreClient(cc grpc.ClientConnInterface) ArduinoCoreClient {
+	return &arduinoCoreClient{cc}
+}
+
+func (c *arduinoCoreClient) Version(ctx context.Context, in *VersionReq, opts ...grpc.CallOption) (*VersionResp, error) {
+	out := new(VersionResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_Version_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) Rescan(ctx context.Context, in *RescanReq, opts ...grpc.CallOption) (*RescanResp, error) {
+	out := new(RescanResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_Rescan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) UpdateIndex(ctx context.Context, in *UpdateIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateIndexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[0], ArduinoCore_UpdateIndex_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreUpdateIndexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_UpdateIndexClient interface {
+	Recv() (*UpdateIndexResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreUpdateIndexClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreUpdateIndexClient) Recv() (*UpdateIndexResp, error) {
+	m := new(UpdateIndexResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) UpdateLibrariesIndex(ctx context.Context, in *UpdateLibrariesIndexReq, opts ...grpc.CallOption) (ArduinoCore_UpdateLibrariesIndexClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[1], ArduinoCore_UpdateLibrariesIndex_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreUpdateLibrariesIndexClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_UpdateLibrariesIndexClient interface {
+	Recv() (*UpdateLibrariesIndexResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreUpdateLibrariesIndexClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreUpdateLibrariesIndexClient) Recv() (*UpdateLibrariesIndexResp, error) {
+	m := new(UpdateLibrariesIndexResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) BoardDetails(ctx context.Context, in *BoardDetailsReq, opts ...grpc.CallOption) (*BoardDetailsResp, error) {
+	out := new(BoardDetailsResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_BoardDetails_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) BoardList(ctx context.Context, in *BoardListReq, opts ...grpc.CallOption) (*BoardListResp, error) {
+	out := new(BoardListResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_BoardList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) BoardListAll(ctx context.Context, in *BoardListAllReq, opts ...grpc.CallOption) (*BoardListAllResp, error) {
+	out := new(BoardListAllResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_BoardListAll_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) Compile(ctx context.Context, in *CompileReq, opts ...grpc.CallOption) (ArduinoCore_CompileClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[2], ArduinoCore_Compile_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreCompileClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_CompileClient interface {
+	Recv() (*CompileResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreCompileClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreCompileClient) Recv() (*CompileResp, error) {
+	m := new(CompileResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) Upload(ctx context.Context, in *UploadReq, opts ...grpc.CallOption) (ArduinoCore_UploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[3], ArduinoCore_Upload_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreUploadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_UploadClient interface {
+	Recv() (*UploadResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreUploadClient) Recv() (*UploadResp, error) {
+	m := new(UploadResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) BurnBootloader(ctx context.Context, in *BurnBootloaderReq, opts ...grpc.CallOption) (ArduinoCore_BurnBootloaderClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[4], ArduinoCore_BurnBootloader_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreBurnBootloaderClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_BurnBootloaderClient interface {
+	Recv() (*BurnBootloaderResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreBurnBootloaderClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreBurnBootloaderClient) Recv() (*BurnBootloaderResp, error) {
+	m := new(BurnBootloaderResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformInstall(ctx context.Context, in *PlatformInstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformInstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[5], ArduinoCore_PlatformInstall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformInstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformInstallClient interface {
+	Recv() (*PlatformInstallResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformInstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformInstallClient) Recv() (*PlatformInstallResp, error) {
+	m := new(PlatformInstallResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformDownload(ctx context.Context, in *PlatformDownloadReq, opts ...grpc.CallOption) (ArduinoCore_PlatformDownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[6], ArduinoCore_PlatformDownload_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformDownloadClient interface {
+	Recv() (*PlatformDownloadResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformDownloadClient) Recv() (*PlatformDownloadResp, error) {
+	m := new(PlatformDownloadResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformUninstall(ctx context.Context, in *PlatformUninstallReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUninstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[7], ArduinoCore_PlatformUninstall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformUninstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformUninstallClient interface {
+	Recv() (*PlatformUninstallResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformUninstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformUninstallClient) Recv() (*PlatformUninstallResp, error) {
+	m := new(PlatformUninstallResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformUpgrade(ctx context.Context, in *PlatformUpgradeReq, opts ...grpc.CallOption) (ArduinoCore_PlatformUpgradeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[8], ArduinoCore_PlatformUpgrade_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformUpgradeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformUpgradeClient interface {
+	Recv() (*PlatformUpgradeResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformUpgradeClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformUpgradeClient) Recv() (*PlatformUpgradeResp, error) {
+	m := new(PlatformUpgradeResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformRollback(ctx context.Context, in *PlatformRollbackReq, opts ...grpc.CallOption) (ArduinoCore_PlatformRollbackClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[9], ArduinoCore_PlatformRollback_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCorePlatformRollbackClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_PlatformRollbackClient interface {
+	Recv() (*PlatformRollbackResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCorePlatformRollbackClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCorePlatformRollbackClient) Recv() (*PlatformRollbackResp, error) {
+	m := new(PlatformRollbackResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) PlatformSearch(ctx context.Context, in *PlatformSearchReq, opts ...grpc.CallOption) (*PlatformSearchResp, error) {
+	out := new(PlatformSearchResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_PlatformSearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) PlatformList(ctx context.Context, in *PlatformListReq, opts ...grpc.CallOption) (*PlatformListResp, error) {
+	out := new(PlatformListResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_PlatformList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) LibraryDownload(ctx context.Context, in *LibraryDownloadReq, opts ...grpc.CallOption) (ArduinoCore_LibraryDownloadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[10], ArduinoCore_LibraryDownload_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreLibraryDownloadClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_LibraryDownloadClient interface {
+	Recv() (*LibraryDownloadResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreLibraryDownloadClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreLibraryDownloadClient) Recv() (*LibraryDownloadResp, error) {
+	m := new(LibraryDownloadResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) LibraryInstall(ctx context.Context, in *LibraryInstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryInstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[11], ArduinoCore_LibraryInstall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreLibraryInstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_LibraryInstallClient interface {
+	Recv() (*LibraryInstallResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreLibraryInstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreLibraryInstallClient) Recv() (*LibraryInstallResp, error) {
+	m := new(LibraryInstallResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) LibraryUninstall(ctx context.Context, in *LibraryUninstallReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUninstallClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[12], ArduinoCore_LibraryUninstall_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreLibraryUninstallClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_LibraryUninstallClient interface {
+	Recv() (*LibraryUninstallResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreLibraryUninstallClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreLibraryUninstallClient) Recv() (*LibraryUninstallResp, error) {
+	m := new(LibraryUninstallResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) LibraryUpgradeAll(ctx context.Context, in *LibraryUpgradeAllReq, opts ...grpc.CallOption) (ArduinoCore_LibraryUpgradeAllClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ArduinoCore_ServiceDesc.Streams[13], ArduinoCore_LibraryUpgradeAll_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &arduinoCoreLibraryUpgradeAllClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ArduinoCore_LibraryUpgradeAllClient interface {
+	Recv() (*LibraryUpgradeAllResp, error)
+	grpc.ClientStream
+}
+
+type arduinoCoreLibraryUpgradeAllClient struct {
+	grpc.ClientStream
+}
+
+func (x *arduinoCoreLibraryUpgradeAllClient) Recv() (*LibraryUpgradeAllResp, error) {
+	m := new(LibraryUpgradeAllResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *arduinoCoreClient) LibrarySearch(ctx context.Context, in *LibrarySearchReq, opts ...grpc.CallOption) (*LibrarySearchResp, error) {
+	out := new(LibrarySearchResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_LibrarySearch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *arduinoCoreClient) LibraryList(ctx context.Context, in *LibraryListReq, opts ...grpc.CallOption) (*LibraryListResp, error) {
+	out := new(LibraryListResp)
+	err := c.cc.Invoke(ctx, ArduinoCore_LibraryList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ArduinoCoreServer is the server API for ArduinoCore service.
+// All implementations must embed UnimplementedArduinoCoreServer
+// for forward compatibility
+type ArduinoCoreServer interface {
+	// Returns the version of the running daemon.
+	Version(context.Context, *VersionReq) (*VersionResp, error)
+	// Reload hardware and libraries from disk, useful when the data directory
+	// or the sketchbook have been modified by another program.
+	Rescan(context.Context, *RescanReq) (*RescanResp, error)
+	// Download the latest version of all the platform indexes.
+	UpdateIndex(*UpdateIndexReq, ArduinoCore_UpdateIndexServer) error
+	// Download the latest version of the libraries index.
+	UpdateLibrariesIndex(*UpdateLibrariesIndexReq, ArduinoCore_UpdateLibrariesIndexServer) error
+	BoardDetails(context.Context, *BoardDetailsReq) (*BoardDetailsResp, error)
+	BoardList(context.Context, *BoardListReq) (*BoardListResp, error)
+	BoardListAll(context.Context, *BoardListAllReq) (*BoardListAllResp, error)
+	Compile(*CompileReq, ArduinoCore_CompileServer) error
+	Upload(*UploadReq, ArduinoCore_UploadServer) error
+	BurnBootloader(*BurnBootloaderReq, ArduinoCore_BurnBootloaderServer) error
+	PlatformInstall(*PlatformInstallReq, ArduinoCore_PlatformInstallServer) error
+	PlatformDownload(*PlatformDownloadReq, ArduinoCore_PlatformDownloadServer) error
+	PlatformUninstall(*PlatformUninstallReq, ArduinoCore_PlatformUninstallServer) error
+	PlatformUpgrade(*PlatformUpgradeReq, ArduinoCore_PlatformUpgradeServer) error
+	PlatformRollback(*PlatformRollbackReq, ArduinoCore_PlatformRollbackServer) error
+	PlatformSearch(context.Context, *PlatformSearchReq) (*PlatformSearchResp, error)
+	PlatformList(context.Context, *PlatformListReq) (*PlatformListResp, error)
+	LibraryDownload(*LibraryDownloadReq, ArduinoCore_LibraryDownloadServer) error
+	LibraryInstall(*LibraryInstallReq, ArduinoCore_LibraryInstallServer) error
+	LibraryUninstall(*LibraryUninstallReq, ArduinoCore_LibraryUninstallServer) error
+	LibraryUpgradeAll(*LibraryUpgradeAllReq, ArduinoCore_LibraryUpgradeAllServer) error
+	LibrarySearch(context.Context, *LibrarySearchReq) (*LibrarySearchResp, error)
+	LibraryList(context.Context, *LibraryListReq) (*LibraryListResp, error)
+	mustEmbedUnimplementedArduinoCoreServer()
+}
+
+// UnimplementedArduinoCoreServer must be embedded to have forward compatible implementations.
+type UnimplementedArduinoCoreServer struct {
+}
+
+func (UnimplementedArduinoCoreServer) Version(context.Context, *VersionReq) (*VersionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedArduinoCoreServer) Rescan(context.Context, *RescanReq) (*RescanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rescan not implemented")
+}
+func (UnimplementedArduinoCoreServer) UpdateIndex(*UpdateIndexReq, ArduinoCore_UpdateIndexServer) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateIndex not implemented")
+}
+func (UnimplementedArduinoCoreServer) UpdateLibrariesIndex(*UpdateLibrariesIndexReq, ArduinoCore_UpdateLibrariesIndexServer) error {
+	return status.Errorf(codes.Unimplemented, "method UpdateLibrariesIndex not implemented")
+}
+func (UnimplementedArduinoCoreServer) BoardDetails(context.Context, *BoardDetailsReq) (*BoardDetailsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardDetails not implemented")
+}
+func (UnimplementedArduinoCoreServer) BoardList(context.Context, *BoardListReq) (*BoardListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardList not implemented")
+}
+func (UnimplementedArduinoCoreServer) BoardListAll(context.Context, *BoardListAllReq) (*BoardListAllResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BoardListAll not implemented")
+}
+func (UnimplementedArduinoCoreServer) Compile(*CompileReq, ArduinoCore_CompileServer) error {
+	return status.Errorf(codes.Unimplemented, "method Compile not implemented")
+}
+func (UnimplementedArduinoCoreServer) Upload(*UploadReq, ArduinoCore_UploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedArduinoCoreServer) BurnBootloader(*BurnBootloaderReq, ArduinoCore_BurnBootloaderServer) error {
+	return status.Errorf(codes.Unimplemented, "method BurnBootloader not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformInstall(*PlatformInstallReq, ArduinoCore_PlatformInstallServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformInstall not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformDownload(*PlatformDownloadReq, ArduinoCore_PlatformDownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformDownload not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformUninstall(*PlatformUninstallReq, ArduinoCore_PlatformUninstallServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformUninstall not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformUpgrade(*PlatformUpgradeReq, ArduinoCore_PlatformUpgradeServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformUpgrade not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformRollback(*PlatformRollbackReq, ArduinoCore_PlatformRollbackServer) error {
+	return status.Errorf(codes.Unimplemented, "method PlatformRollback not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformSearch(context.Context, *PlatformSearchReq) (*PlatformSearchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformSearch not implemented")
+}
+func (UnimplementedArduinoCoreServer) PlatformList(context.Context, *PlatformListReq) (*PlatformListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlatformList not implemented")
+}
+func (UnimplementedArduinoCoreServer) LibraryDownload(*LibraryDownloadReq, ArduinoCore_LibraryDownloadServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryDownload not implemented")
+}
+func (UnimplementedArduinoCoreServer) LibraryInstall(*LibraryInstallReq, ArduinoCore_LibraryInstallServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryInstall not implemented")
+}
+func (UnimplementedArduinoCoreServer) LibraryUninstall(*LibraryUninstallReq, ArduinoCore_LibraryUninstallServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryUninstall not implemented")
+}
+func (UnimplementedArduinoCoreServer) LibraryUpgradeAll(*LibraryUpgradeAllReq, ArduinoCore_LibraryUpgradeAllServer) error {
+	return status.Errorf(codes.Unimplemented, "method LibraryUpgradeAll not implemented")
+}
+func (UnimplementedArduinoCoreServer) LibrarySearch(context.Context, *LibrarySearchReq) (*LibrarySearchResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibrarySearch not implemented")
+}
+func (UnimplementedArduinoCoreServer) LibraryList(context.Context, *LibraryListReq) (*LibraryListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LibraryList not implemented")
+}
+func (UnimplementedArduinoCoreServer) mustEmbedUnimplementedArduinoCoreServer() {}
+
+// UnsafeArduinoCoreServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ArduinoCoreServer will
+// result in compilation errors.
+type UnsafeArduinoCoreServer interface {
+	mustEmbedUnimplementedArduinoCoreServer()
+}
+
+func RegisterArduinoCoreServer(s grpc.ServiceRegistrar, srv ArduinoCoreServer) {
+	s.RegisterService(&ArduinoCore_ServiceDesc, srv)
+}
+
+func _ArduinoCore_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VersionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).Version(ctx, req.(*VersionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_Rescan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).Rescan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_Rescan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).Rescan(ctx, req.(*RescanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_UpdateIndex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UpdateIndexReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).UpdateIndex(m, &arduinoCoreUpdateIndexServer{stream})
+}
+
+type ArduinoCore_UpdateIndexServer interface {
+	Send(*UpdateIndexResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreUpdateIndexServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreUpdateIndexServer) Send(m *UpdateIndexResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_UpdateLibrariesIndex_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UpdateLibrariesIndexReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).UpdateLibrariesIndex(m, &arduinoCoreUpdateLibrariesIndexServer{stream})
+}
+
+type ArduinoCore_UpdateLibrariesIndexServer interface {
+	Send(*UpdateLibrariesIndexResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreUpdateLibrariesIndexServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreUpdateLibrariesIndexServer) Send(m *UpdateLibrariesIndexResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_BoardDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardDetailsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).BoardDetails(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_BoardDetails_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).BoardDetails(ctx, req.(*BoardDetailsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_BoardList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).BoardList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_BoardList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).BoardList(ctx, req.(*BoardListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_BoardListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoardListAllReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).BoardListAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_BoardListAll_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).BoardListAll(ctx, req.(*BoardListAllReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_Compile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CompileReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).Compile(m, &arduinoCoreCompileServer{stream})
+}
+
+type ArduinoCore_CompileServer interface {
+	Send(*CompileResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreCompileServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreCompileServer) Send(m *CompileResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(UploadReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).Upload(m, &arduinoCoreUploadServer{stream})
+}
+
+type ArduinoCore_UploadServer interface {
+	Send(*UploadResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreUploadServer) Send(m *UploadResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_BurnBootloader_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BurnBootloaderReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).BurnBootloader(m, &arduinoCoreBurnBootloaderServer{stream})
+}
+
+type ArduinoCore_BurnBootloaderServer interface {
+	Send(*BurnBootloaderResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreBurnBootloaderServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreBurnBootloaderServer) Send(m *BurnBootloaderResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformInstallReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformInstall(m, &arduinoCorePlatformInstallServer{stream})
+}
+
+type ArduinoCore_PlatformInstallServer interface {
+	Send(*PlatformInstallResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformInstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformInstallServer) Send(m *PlatformInstallResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformDownloadReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformDownload(m, &arduinoCorePlatformDownloadServer{stream})
+}
+
+type ArduinoCore_PlatformDownloadServer interface {
+	Send(*PlatformDownloadResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformDownloadServer) Send(m *PlatformDownloadResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformUninstall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformUninstallReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformUninstall(m, &arduinoCorePlatformUninstallServer{stream})
+}
+
+type ArduinoCore_PlatformUninstallServer interface {
+	Send(*PlatformUninstallResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformUninstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformUninstallServer) Send(m *PlatformUninstallResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformUpgrade_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformUpgradeReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformUpgrade(m, &arduinoCorePlatformUpgradeServer{stream})
+}
+
+type ArduinoCore_PlatformUpgradeServer interface {
+	Send(*PlatformUpgradeResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformUpgradeServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformUpgradeServer) Send(m *PlatformUpgradeResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformRollback_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlatformRollbackReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).PlatformRollback(m, &arduinoCorePlatformRollbackServer{stream})
+}
+
+type ArduinoCore_PlatformRollbackServer interface {
+	Send(*PlatformRollbackResp) error
+	grpc.ServerStream
+}
+
+type arduinoCorePlatformRollbackServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCorePlatformRollbackServer) Send(m *PlatformRollbackResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_PlatformSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformSearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).PlatformSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_PlatformSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).PlatformSearch(ctx, req.(*PlatformSearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_PlatformList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlatformListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).PlatformList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_PlatformList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).PlatformList(ctx, req.(*PlatformListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_LibraryDownload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryDownloadReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).LibraryDownload(m, &arduinoCoreLibraryDownloadServer{stream})
+}
+
+type ArduinoCore_LibraryDownloadServer interface {
+	Send(*LibraryDownloadResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreLibraryDownloadServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreLibraryDownloadServer) Send(m *LibraryDownloadResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_LibraryInstall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryInstallReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).LibraryInstall(m, &arduinoCoreLibraryInstallServer{stream})
+}
+
+type ArduinoCore_LibraryInstallServer interface {
+	Send(*LibraryInstallResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreLibraryInstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreLibraryInstallServer) Send(m *LibraryInstallResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_LibraryUninstall_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryUninstallReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).LibraryUninstall(m, &arduinoCoreLibraryUninstallServer{stream})
+}
+
+type ArduinoCore_LibraryUninstallServer interface {
+	Send(*LibraryUninstallResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreLibraryUninstallServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreLibraryUninstallServer) Send(m *LibraryUninstallResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_LibraryUpgradeAll_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LibraryUpgradeAllReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArduinoCoreServer).LibraryUpgradeAll(m, &arduinoCoreLibraryUpgradeAllServer{stream})
+}
+
+type ArduinoCore_LibraryUpgradeAllServer interface {
+	Send(*LibraryUpgradeAllResp) error
+	grpc.ServerStream
+}
+
+type arduinoCoreLibraryUpgradeAllServer struct {
+	grpc.ServerStream
+}
+
+func (x *arduinoCoreLibraryUpgradeAllServer) Send(m *LibraryUpgradeAllResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ArduinoCore_LibrarySearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibrarySearchReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).LibrarySearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_LibrarySearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).LibrarySearch(ctx, req.(*LibrarySearchReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArduinoCore_LibraryList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LibraryListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArduinoCoreServer).LibraryList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArduinoCore_LibraryList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArduinoCoreServer).LibraryList(ctx, req.(*LibraryListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ArduinoCore_ServiceDesc is the grpc.ServiceDesc for ArduinoCore service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ArduinoCore_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cc.arduino.cli.rpc.v1.ArduinoCore",
+	HandlerType: (*ArduinoCoreServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Version",
+			Handler:    _ArduinoCore_Version_Handler,
+		},
+		{
+			MethodName: "Rescan",
+			Handler:    _ArduinoCore_Rescan_Handler,
+		},
+		{
+			MethodName: "BoardDetails",
+			Handler:    _ArduinoCore_BoardDetails_Handler,
+		},
+		{
+			MethodName: "BoardList",
+			Handler:    _ArduinoCore_BoardList_Handler,
+		},
+		{
+			MethodName: "BoardListAll",
+			Handler:    _ArduinoCore_BoardListAll_Handler,
+		},
+		{
+			MethodName: "PlatformSearch",
+			Handler:    _ArduinoCore_PlatformSearch_Handler,
+		},
+		{
+			MethodName: "PlatformList",
+			Handler:    _ArduinoCore_PlatformList_Handler,
+		},
+		{
+			MethodName: "LibrarySearch",
+			Handler:    _ArduinoCore_LibrarySearch_Handler,
+		},
+		{
+			MethodName: "LibraryList",
+			Handler:    _ArduinoCore_LibraryList_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "UpdateIndex",
+			Handler:       _ArduinoCore_UpdateIndex_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UpdateLibrariesIndex",
+			Handler:       _ArduinoCore_UpdateLibrariesIndex_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Compile",
+			Handler:       _ArduinoCore_Compile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Upload",
+			Handler:       _ArduinoCore_Upload_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BurnBootloader",
+			Handler:       _ArduinoCore_BurnBootloader_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlatformInstall",
+			Handler:       _ArduinoCore_PlatformInstall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlatformDownload",
+			Handler:       _ArduinoCore_PlatformDownload_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlatformUninstall",
+			Handler:       _ArduinoCore_PlatformUninstall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlatformUpgrade",
+			Handler:       _ArduinoCore_PlatformUpgrade_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PlatformRollback",
+			Handler:       _ArduinoCore_PlatformRollback_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LibraryDownload",
+			Handler:       _ArduinoCore_LibraryDownload_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LibraryInstall",
+			Handler:       _ArduinoCore_LibraryInstall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LibraryUninstall",
+			Handler:       _ArduinoCore_LibraryUninstall_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LibraryUpgradeAll",
+			Handler:       _ArduinoCore_LibraryUpgradeAll_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "commands.proto",
+}
@@ -0,0 +1,767 @@
+// This file is part of arduino-cli.
+//
+// Copyright 2018 ARDUINO SA (http://www.arduino.cc/)
+//
+// This software is released under the GNU General Public License version 3,
+// which covers the main part of arduino-cli.
+// The terms of this license can be found at:
+// https://www.gnu.org/licenses/gpl-3.0.en.html
+//
+// You can be released from the requirements of the above licenses by purchasing
+// a commercial license. Buying such a license is mandatory if you want to modify or
+// otherwise use the software for commercial activities involving the Arduino
+// software without disclosing the source code of your own applications. To purchase
+// a commercial license, send an email to license@arduino.cc.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.12
+// source: compile.proto
+
+package rpc
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CompileReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fqbn            string   `protobuf:"bytes,1,opt,name=fqbn,proto3" json:"fqbn,omitempty"` // Fully Qualified Board Name, e.g.: arduino:avr:uno.
+	SketchPath      string   `protobuf:"bytes,2,opt,name=sketch_path,json=sketchPath,proto3" json:"sketch_path,omitempty"`
+	ShowProperties  bool     `protobuf:"varint,3,opt,name=show_properties,json=showProperties,proto3" json:"show_properties,omitempty"`   // Show all build preferences used instead of compiling.
+	Preprocess      bool     `protobuf:"varint,4,opt,name=preprocess,proto3" json:"preprocess,omitempty"`                                 // Print preprocessed code to stdout.
+	BuildCachePath  string   `protobuf:"bytes,5,opt,name=build_cache_path,json=buildCachePath,proto3" json:"build_cache_path,omitempty"`  // Builds of 'core.a' are saved into this path to be cached and reused.
+	BuildPath       string   `protobuf:"bytes,6,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`                   // Path where to save compiled files.
+	BuildProperties []string `protobuf:"bytes,7,rep,name=build_properties,json=buildProperties,proto3" json:"build_properties,omitempty"` // List of custom build properties separated by commas. Or can be used multiple times for multiple properties.
+	Warnings        string   `protobuf:"bytes,8,opt,name=warnings,proto3" json:"warnings,omitempty"`                                      // Used to tell gcc which warning level to use.
+	Verbose         bool     `protobuf:"varint,9,opt,name=verbose,proto3" json:"verbose,omitempty"`                                       // Turns on verbose mode.
+	Quiet           bool     `protobuf:"varint,10,opt,name=quiet,proto3" json:"quiet,omitempty"`                                          // Suppresses almost every output.
+	VidPid          string   `protobuf:"bytes,11,opt,name=vid_pid,json=vidPid,proto3" json:"vid_pid,omitempty"`                           // VID/PID specific build properties.
+	ExportFile      string   `protobuf:"bytes,12,opt,name=export_file,json=exportFile,proto3" json:"export_file,omitempty"`               // The compiled binary is written to this file
+}
+
+func (x *CompileReq) Reset() {
+	*x = CompileReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compile_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompileReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompileReq) ProtoMessage() {}
+
+func (x *CompileReq) ProtoReflect() protoreflect.Message {
+	mi := &file_compile_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompileReq.ProtoReflect.Descriptor instead.
+func (*CompileReq) Descriptor() ([]byte, []int) {
+	return file_compile_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CompileReq) GetFqbn() string {
+	if x != nil {
+		return x.Fqbn
+	}
+	return ""
+}
+
+func (x *CompileReq) GetSketchPath() string {
+	if x != nil {
+		return x.SketchPath
+	}
+	return ""
+}
+
+func (x *CompileReq) GetShowProperties() bool {
+	if x != nil {
+		return x.ShowProperties
+	}
+	return false
+}
+
+func (x *CompileReq) GetPreprocess() bool {
+	if x != nil {
+		return x.Preprocess
+	}
+	return false
+}
+
+func (x *CompileReq) GetBuildCachePath() string {
+	if x != nil {
+		return x.BuildCachePath
+	}
+	return ""
+}
+
+func (x *CompileReq) GetBuildPath() string {
+	if x != nil {
+		return x.BuildPath
+	}
+	return ""
+}
+
+func (x *CompileReq) GetBuildProperties() []string {
+	if x != nil {
+		return x.BuildProperties
+	}
+	return nil
+}
+
+func (x *CompileReq) GetWarnings() string {
+	if x != nil {
+		return x.Warnings
+	}
+	return ""
+}
+
+func (x *CompileReq) GetVerbose() bool {
+	if x != nil {
+		return x.Verbose
+	}
+	return false
+}
+
+func (x *CompileReq) GetQuiet() bool {
+	if x != nil {
+		return x.Quiet
+	}
+	return false
+}
+
+func (x *CompileReq) GetVidPid() string {
+	if x != nil {
+		return x.VidPid
+	}
+	return ""
+}
+
+func (x *CompileReq) GetExportFile() string {
+	if x != nil {
+		return x.ExportFile
+	}
+	return ""
+}
+
+type CompileResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutStream []byte `protobuf:"bytes,1,opt,name=out_stream,json=outStream,proto3" json:"out_stream,omitempty"`
+	ErrStream []byte `protobuf:"bytes,2,opt,name=err_stream,json=errStream,proto3" json:"err_stream,omitempty"`
+	// Sent in the last message of a successful build, or of a build failed
+	// because the sketch is too big.
+	Result *CompileResult `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *CompileResp) Reset() {
+	*x = CompileResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compile_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompileResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompileResp) ProtoMessage() {}
+
+func (x *CompileResp) ProtoReflect() protoreflect.Message {
+	mi := &file_compile_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompileResp.ProtoReflect.Descriptor instead.
+func (*CompileResp) Descriptor() ([]byte, []int) {
+	return file_compile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CompileResp) GetOutStream() []byte {
+	if x != nil {
+		return x.OutStream
+	}
+	return nil
+}
+
+func (x *CompileResp) GetErrStream() []byte {
+	if x != nil {
+		return x.ErrStream
+	}
+	return nil
+}
+
+func (x *CompileResp) GetResult() *CompileResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type CompileResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildPath      string         `protobuf:"bytes,1,opt,name=build_path,json=buildPath,proto3" json:"build_path,omitempty"`
+	BuildArtifacts []string       `protobuf:"bytes,2,rep,name=build_artifacts,json=buildArtifacts,proto3" json:"build_artifacts,omitempty"`
+	ExportedFiles  []string       `protobuf:"bytes,3,rep,name=exported_files,json=exportedFiles,proto3" json:"exported_files,omitempty"`
+	BoardPlatform  *BuildPlatform `protobuf:"bytes,4,opt,name=board_platform,json=boardPlatform,proto3" json:"board_platform,omitempty"`
+	CorePlatform   *BuildPlatform `protobuf:"bytes,5,opt,name=core_platform,json=corePlatform,proto3" json:"core_platform,omitempty"` // Set only if different from board_platform.
+	Tools          []*BuildTool   `protobuf:"bytes,6,rep,name=tools,proto3" json:"tools,omitempty"`
+	UsedLibraries  []*Library     `protobuf:"bytes,7,rep,name=used_libraries,json=usedLibraries,proto3" json:"used_libraries,omitempty"`
+	Size           *SizeReport    `protobuf:"bytes,8,opt,name=size,proto3" json:"size,omitempty"` // Not set if the platform doesn't define a size recipe.
+}
+
+func (x *CompileResult) Reset() {
+	*x = CompileResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compile_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompileResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompileResult) ProtoMessage() {}
+
+func (x *CompileResult) ProtoReflect() protoreflect.Message {
+	mi := &file_compile_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompileResult.ProtoReflect.Descriptor instead.
+func (*CompileResult) Descriptor() ([]byte, []int) {
+	return file_compile_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompileResult) GetBuildPath() string {
+	if x != nil {
+		return x.BuildPath
+	}
+	return ""
+}
+
+func (x *CompileResult) GetBuildArtifacts() []string {
+	if x != nil {
+		return x.BuildArtifacts
+	}
+	return nil
+}
+
+func (x *CompileResult) GetExportedFiles() []string {
+	if x != nil {
+		return x.ExportedFiles
+	}
+	return nil
+}
+
+func (x *CompileResult) GetBoardPlatform() *BuildPlatform {
+	if x != nil {
+		return x.BoardPlatform
+	}
+	return nil
+}
+
+func (x *CompileResult) GetCorePlatform() *BuildPlatform {
+	if x != nil {
+		return x.CorePlatform
+	}
+	return nil
+}
+
+func (x *CompileResult) GetTools() []*BuildTool {
+	if x != nil {
+		return x.Tools
+	}
+	return nil
+}
+
+func (x *CompileResult) GetUsedLibraries() []*Library {
+	if x != nil {
+		return x.UsedLibraries
+	}
+	return nil
+}
+
+func (x *CompileResult) GetSize() *SizeReport {
+	if x != nil {
+		return x.Size
+	}
+	return nil
+}
+
+type BuildPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version    string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	InstallDir string `protobuf:"bytes,3,opt,name=install_dir,json=installDir,proto3" json:"install_dir,omitempty"`
+}
+
+func (x *BuildPlatform) Reset() {
+	*x = BuildPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compile_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildPlatform) ProtoMessage() {}
+
+func (x *BuildPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_compile_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildPlatform.ProtoReflect.Descriptor instead.
+func (*BuildPlatform) Descriptor() ([]byte, []int) {
+	return file_compile_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BuildPlatform) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BuildPlatform) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *BuildPlatform) GetInstallDir() string {
+	if x != nil {
+		return x.InstallDir
+	}
+	return ""
+}
+
+type BuildTool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BuildTool) Reset() {
+	*x = BuildTool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compile_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildTool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildTool) ProtoMessage() {}
+
+func (x *BuildTool) ProtoReflect() protoreflect.Message {
+	mi := &file_compile_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildTool.ProtoReflect.Descriptor instead.
+func (*BuildTool) Descriptor() ([]byte, []int) {
+	return file_compile_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BuildTool) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BuildTool) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+type SizeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProgramSize    int64 `protobuf:"varint,1,opt,name=program_size,json=programSize,proto3" json:"program_size,omitempty"`
+	ProgramMaxSize int64 `protobuf:"varint,2,opt,name=program_max_size,json=programMaxSize,proto3" json:"program_max_size,omitempty"` // 0 if unknown.
+	DataSize       int64 `protobuf:"varint,3,opt,name=data_size,json=dataSize,proto3" json:"data_size,omitempty"`                     // -1 if unknown.
+	DataMaxSize    int64 `protobuf:"varint,4,opt,name=data_max_size,json=dataMaxSize,proto3" json:"data_max_size,omitempty"`          // 0 if unknown.
+	EepromSize     int64 `protobuf:"varint,5,opt,name=eeprom_size,json=eepromSize,proto3" json:"eeprom_size,omitempty"`               // -1 if unknown.
+}
+
+func (x *SizeReport) Reset() {
+	*x = SizeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_compile_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SizeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SizeReport) ProtoMessage() {}
+
+func (x *SizeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_compile_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SizeReport.ProtoReflect.Descriptor instead.
+func (*SizeReport) Descriptor() ([]byte, []int) {
+	return file_compile_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SizeReport) GetProgramSize() int64 {
+	if x != nil {
+		return x.ProgramSize
+	}
+	return 0
+}
+
+func (x *SizeReport) GetProgramMaxSize() int64 {
+	if x != nil {
+		return x.ProgramMaxSize
+	}
+	return 0
+}
+
+func (x *SizeReport) GetDataSize() int64 {
+	if x != nil {
+		return x.DataSize
+	}
+	return 0
+}
+
+func (x *SizeReport) GetDataMaxSize() int64 {
+	if x != nil {
+		return x.DataMaxSize
+	}
+	return 0
+}
+
+func (x *SizeReport) GetEepromSize() int64 {
+	if x != nil {
+		return x.EepromSize
+	}
+	return 0
+}
+
+var File_compile_proto protoreflect.FileDescriptor
+
+var file_compile_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x15, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x1a, 0x09, 0x6c, 0x69, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x84, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x12, 0x12, 0x0a, 0x04, 0x66, 0x71, 0x62, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x66, 0x71, 0x62, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6b, 0x65, 0x74, 0x63, 0x68, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6b, 0x65, 0x74, 0x63,
+	0x68, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x70, 0x72,
+	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
+	0x73, 0x68, 0x6f, 0x77, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x28,
+	0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
+	0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x69, 0x65,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x71, 0x75, 0x69, 0x65, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x76, 0x69, 0x64, 0x5f, 0x70, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x76, 0x69, 0x64, 0x50, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x0b, 0x43, 0x6f, 0x6d,
+	0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x6f, 0x75,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x5f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x65, 0x72, 0x72,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x3c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75,
+	0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xcc, 0x03, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x0e, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x70,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x52, 0x0d, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x49, 0x0a, 0x0d, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x63, 0x2e, 0x61,
+	0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x52,
+	0x0c, 0x63, 0x6f, 0x72, 0x65, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x36, 0x0a,
+	0x05, 0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x52, 0x05,
+	0x74, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69,
+	0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x63, 0x2e, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x52, 0x0d, 0x75,
+	0x73, 0x65, 0x64, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x63, 0x2e,
+	0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2e, 0x63, 0x6c, 0x69, 0x2e, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x04, 0x73,
+	0x69, 0x7a, 0x65, 0x22, 0x5a, 0x0a, 0x0d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x50, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x44, 0x69, 0x72, 0x22,
+	0x35, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x53, 0x69, 0x7a, 0x65, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x72, 0x6f,
+	0x67, 0x72, 0x61, 0x6d, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x67,
+	0x72, 0x61, 0x6d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x4d, 0x61, 0x78, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x22, 0x0a, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x4d, 0x61, 0x78, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x65, 0x70, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x65, 0x65, 0x70, 0x72, 0x6f, 0x6d,
+	0x53, 0x69, 0x7a, 0x65, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x72, 0x64, 0x75, 0x69,
+	0x6e, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x2f, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
+}
+
+var (
+	file_compile_proto_rawDescOnce sync.Once
+	file_compile_proto_rawDescData = file_compile_proto_rawDesc
+)
+
+func file_compile_proto_rawDescGZIP() []byte {
+	file_compile_proto_rawDescOnce.Do(func() {
+		file_compile_proto_rawDescData = protoimpl.X.CompressGZIP(file_compile_proto_rawDescData)
+	})
+	return file_compile_proto_rawDescData
+}
+
+var file_compile_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_compile_proto_goTypes = []interface{}{
+	(*CompileReq)(nil),    // 0: cc.arduino.cli.rpc.v1.CompileReq
+	(*CompileResp)(nil),   // 1: cc.arduino.cli.rpc.v1.CompileResp
+	(*CompileResult)(nil), // 2: cc.arduino.cli.rpc.v1.CompileResult
+	(*BuildPlatform)(nil), // 3: cc.arduino.cli.rpc.v1.BuildPlatform
+	(*BuildTool)(nil),     // 4: cc.arduino.cli.rpc.v1.BuildTool
+	(*SizeReport)(nil),    // 5: cc.arduino.cli.rpc.v1.SizeReport
+	(*Library)(nil),       // 6: cc.arduino.cli.rpc.v1.Library
+}
+var file_compile_proto_depIdxs = []int32{
+	2, // 0: cc.arduino.cli.rpc.v1.CompileResp.result:type_name -> cc.arduino.cli.rpc.v1.CompileResult
+	3, // 1: cc.arduino.cli.rpc.v1.CompileResult.board_platform:type_name -> cc.arduino.cli.rpc.v1.BuildPlatform
+	3, // 2: cc.arduino.cli.rpc.v1.CompileResult.core_platform:type_name -> cc.arduino.cli.rpc.v1.BuildPlatform
+	4, // 3: cc.arduino.cli.rpc.v1.CompileResult.tools:type_name -> cc.arduino.cli.rpc.v1.BuildTool
+	6, // 4: cc.arduino.cli.rpc.v1.CompileResult.used_libraries:type_name -> cc.arduino.cli.rpc.v1.Library
+	5, // 5: cc.arduino.cli.rpc.v1.CompileResult.size:type_name -> cc.arduino.cli.rpc.v1.SizeReport
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_compile_proto_init() }
+func file_compile_proto_init() {
+	if File_compile_proto != nil {
+		return
+	}
+	file_lib_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_compile_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompileReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compile_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompileResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compile_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompileResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compile_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compile_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildTool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_compile_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SizeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_compile_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_compile_proto_goTypes,
+		DependencyIndexes: file_compile_proto_depIdxs,
+		MessageInfos:      file_compile_proto_msgTypes,
+	}.Build()
+	File_compile_proto = out.File
+	file_compile_proto_rawDesc = nil
+	file_compile_proto_goTypes = nil
+	file_compile_proto_depIdxs = nil
+}